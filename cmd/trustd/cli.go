@@ -0,0 +1,557 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/advdv/stdgo/stdenvcfg"
+	"github.com/advdv/trustd/internal/graphio"
+	"github.com/advdv/trustd/internal/rpc"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"github.com/advdv/trustd/internal/rpc/v1/rpcv1connect"
+	"github.com/advdv/trustd/internal/store"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// command is a subcommand that calls the graph service in-process, so it runs without the daemon.
+type command struct {
+	summary string
+	run     func(ctx context.Context, fs *flag.FlagSet, args []string, stdout io.Writer) error
+}
+
+// commands returns the subcommands by name.
+func commands() map[string]command {
+	return map[string]command{
+		"generate":   {"generate a graph and write it as json, graphml, gexf, dot or edgelist", generate},
+		"walk":       {"walk a graph and write the walks as csv or json", walk},
+		"layout":     {"lay out a graph and write the node positions as csv or json", layout},
+		"export":     {"convert a graph file, or a generated graph, to another format", export},
+		"experiment": {"run an experiment and write its results as csv or json", experiment},
+	}
+}
+
+// run runs the subcommand named by the first argument.
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	cmd, ok := commands()[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q, expected serve, generate, walk, layout, export or experiment", args[0])
+	}
+
+	fs := flag.NewFlagSet("trustd "+args[0], flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: trustd %s [flags]\n\n%s.\n\n", args[0], cmd.summary)
+		fs.PrintDefaults()
+	}
+
+	if err := cmd.run(ctx, fs, args[1:], stdout); err != nil && !errors.Is(err, flag.ErrHelp) {
+		return err
+	}
+	return nil
+}
+
+// output holds the flags that select where, and in which format, a command writes its output.
+type output struct {
+	out    string
+	format string
+}
+
+// register registers the flags of the output, formats lists the supported formats.
+func (o *output) register(fs *flag.FlagSet, defaultFormat, formats string) {
+	fs.StringVar(&o.out, "out", "-", "path of the output file, - writes to stdout")
+	fs.StringVar(&o.format, "format", defaultFormat, "output format: "+formats)
+}
+
+// write writes the output to stdout, or to the output file.
+func (o *output) write(stdout io.Writer, write func(w io.Writer) error) error {
+	if o.out == "" || o.out == "-" {
+		return write(stdout)
+	}
+
+	file, err := os.Create(o.out)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// graphFlags holds the flags of the commands that build a graph. The request starts out as the request
+// file, if any, and the flags that are set override its fields.
+type graphFlags struct {
+	output
+	request, graph, walkMode, layout string
+	nodes, k, walkLength, numWalks   int64
+	iterations                       int64
+	beta, area                       float64
+	seed, walkSeed                   uint64
+}
+
+// register registers the flags of the graph.
+func (o *graphFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.request, "request", "", "path of a JSON encoded RandomGraphRequest to start from, - reads stdin")
+	fs.StringVar(&o.graph, "graph", "", "path of a graph file to use instead of generating a graph")
+	fs.Int64Var(&o.nodes, "nodes", 0, "number of nodes of the Watts–Strogatz graph")
+	fs.Int64Var(&o.k, "k", 0, "number of ring neighbors each node starts out with")
+	fs.Float64Var(&o.beta, "beta", 0, "rewiring probability")
+	fs.Uint64Var(&o.seed, "seed", 0, "seed of the graph")
+	fs.Int64Var(&o.walkLength, "walk-length", 0, "number of steps of every walk")
+	fs.Int64Var(&o.numWalks, "walks", 0, "number of walks of both Bob and Ada")
+	fs.StringVar(&o.walkMode, "walk-mode", "", "walk mode: random, weighted or route")
+	fs.Uint64Var(&o.walkSeed, "walk-seed", 0, "seed of the walks")
+	fs.StringVar(&o.layout, "layout", "", "layout: force, circular, spectral, kamada-kawai or hierarchical")
+	fs.Int64Var(&o.iterations, "iterations", 0, "iterations of the force-directed layout")
+	fs.Float64Var(&o.area, "area", 0, "area of the force-directed layout")
+}
+
+// newRequest reads the request file and applies the flags that are set.
+func (o *graphFlags) newRequest(fs *flag.FlagSet) (*rpcv1.RandomGraphRequest, error) {
+	req := &rpcv1.RandomGraphRequest{}
+	if err := readRequest(o.request, req); err != nil {
+		return nil, err
+	}
+
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "nodes":
+			req.SetNumNodes(o.nodes)
+		case "k":
+			req.SetInitialConnected(o.k)
+		case "beta":
+			req.SetRewiringProbability(o.beta)
+		case "seed":
+			req.SetSeed1(o.seed)
+		case "walk-length":
+			req.SetWalkLength(o.walkLength)
+		case "walks":
+			req.SetNumWalks(o.numWalks)
+		case "walk-seed":
+			req.SetSeed3(o.walkSeed)
+		case "iterations":
+			req.SetLayoutIterations(o.iterations)
+		case "area":
+			req.SetLayoutArea(o.area)
+		case "walk-mode":
+			err = errors.Join(err, setWalkMode(req, o.walkMode))
+		case "layout":
+			err = errors.Join(err, setLayout(req, o.layout))
+		}
+	})
+	return req, err
+}
+
+// setWalkMode selects the walk mode by its name.
+func setWalkMode(req *rpcv1.RandomGraphRequest, name string) error {
+	switch name {
+	case "random":
+		req.SetWalkMode(rpcv1.WalkMode_WALK_MODE_RANDOM_WALK)
+	case "weighted":
+		req.SetWalkMode(rpcv1.WalkMode_WALK_MODE_WEIGHTED)
+	case "route":
+		req.SetWalkMode(rpcv1.WalkMode_WALK_MODE_RANDOM_ROUTE)
+	default:
+		return fmt.Errorf("unknown walk mode: %q", name)
+	}
+	return nil
+}
+
+// setLayout selects the layout by its name, with its default parameters.
+func setLayout(req *rpcv1.RandomGraphRequest, name string) error {
+	switch name {
+	case "force":
+		req.ClearLayout()
+	case "circular":
+		req.SetCircular(&rpcv1.CircularParams{})
+	case "spectral":
+		req.SetSpectral(&rpcv1.SpectralParams{})
+	case "kamada-kawai":
+		req.SetKamadaKawai(&rpcv1.KamadaKawaiParams{})
+	case "hierarchical":
+		req.SetHierarchical(&rpcv1.HierarchicalParams{})
+	default:
+		return fmt.Errorf("unknown layout: %q", name)
+	}
+	return nil
+}
+
+// prepare returns the request of the graph. A graph file is loaded into the service, and the request
+// refers to it.
+func (o *graphFlags) prepare(
+	ctx context.Context, svc rpcv1connect.GraphServiceHandler, fs *flag.FlagSet,
+) (*rpcv1.RandomGraphRequest, error) {
+	req, err := o.newRequest(fs)
+	if err != nil || o.graph == "" {
+		return req, err
+	}
+
+	loadReq := &rpcv1.LoadGraphRequest{}
+	loadReq.SetFileName(filepath.Base(o.graph))
+	loaded, err := svc.LoadGraph(ctx, connect.NewRequest(loadReq))
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetGraphId(loaded.Msg.GetGraphId())
+	return req, nil
+}
+
+// build builds the graph through the service, like the GUI does.
+func (o *graphFlags) build(
+	ctx context.Context, svc rpcv1connect.GraphServiceHandler, fs *flag.FlagSet,
+) (*rpcv1.RandomGraphResponse, error) {
+	req, err := o.prepare(ctx, svc, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := svc.RandomGraph(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// openService inits the service with the configuration and the store of the environment, like the
+// daemon does, graph files are loaded from the graph directory if one is given. The returned function
+// closes the store.
+func openService(ctx context.Context, graphDir string) (rpcv1connect.GraphServiceHandler, func() error, error) {
+	var cfg rpc.Config
+	var st store.Store
+	app := fx.New(fx.NopLogger,
+		stdenvcfg.Provide[rpc.Config]("RPC_"),
+		stdenvcfg.Provide[store.Config]("STORE_"),
+		fx.Provide(store.New),
+		fx.Populate(&cfg, &st))
+	if err := app.Start(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to init service: %w", err)
+	}
+
+	if graphDir != "" {
+		cfg.GraphDir = graphDir
+	}
+	return rpc.NewService(cfg, st, rpc.Version(version)), func() error { return app.Stop(context.WithoutCancel(ctx)) }, nil
+}
+
+// openService opens the service for the graph flags.
+func (o *graphFlags) openService(ctx context.Context) (rpcv1connect.GraphServiceHandler, func() error, error) {
+	if o.graph == "" {
+		return openService(ctx, "")
+	}
+	return openService(ctx, filepath.Dir(o.graph))
+}
+
+// readRequest reads the JSON encoded request from the file, - reads stdin. Without a file the request
+// is left as is.
+func readRequest(path string, req proto.Message) error {
+	var data []byte
+	var err error
+	switch path {
+	case "":
+		return nil
+	case "-":
+		data, err = io.ReadAll(os.Stdin)
+	default:
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read request: %w", err)
+	}
+
+	if err := protojson.Unmarshal(data, req); err != nil {
+		return fmt.Errorf("failed to decode request: %w", err)
+	}
+	return nil
+}
+
+// writeJSON writes the value as indented JSON.
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// writeCSV writes the header and the records as CSV.
+func writeCSV(w io.Writer, header []string, records [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+	if err := cw.WriteAll(records); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+	return nil
+}
+
+// graphFormat parses the name of a graph format.
+func graphFormat(name string) (graphio.Format, error) {
+	format := graphio.ParseFormat(name)
+	if format == graphio.FormatUnknown {
+		return format, fmt.Errorf("unknown format: %q", name)
+	}
+	return format, nil
+}
+
+// generate writes a generated graph.
+func generate(ctx context.Context, fs *flag.FlagSet, args []string, stdout io.Writer) (err error) {
+	var o graphFlags
+	o.register(fs)
+	o.output.register(fs, "json", "json, graphml, gexf, dot or edgelist")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	format, err := graphFormat(o.format)
+	if err != nil {
+		return err
+	}
+
+	svc, closeService, err := o.openService(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, closeService()) }()
+
+	graph, err := o.build(ctx, svc, fs)
+	if err != nil {
+		return err
+	}
+
+	return o.write(stdout, func(w io.Writer) error { return graphio.Write(w, graph, format) })
+}
+
+// walk writes the walks over a graph, csv has a row for every step of every walk.
+func walk(ctx context.Context, fs *flag.FlagSet, args []string, stdout io.Writer) (err error) {
+	var o graphFlags
+	o.register(fs)
+	o.output.register(fs, "csv", "csv or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	svc, closeService, err := o.openService(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, closeService()) }()
+
+	graph, err := o.build(ctx, svc, fs)
+	if err != nil {
+		return err
+	}
+
+	return o.write(stdout, func(w io.Writer) error {
+		switch o.format {
+		case "json":
+			doc := graphio.ToJSON(graph)
+			return writeJSON(w, struct {
+				Walks        []graphio.JSONWalk        `json:"walks"`
+				Intersection *graphio.JSONIntersection `json:"intersection,omitempty"`
+			}{doc.Walks, doc.Intersection})
+		case "csv":
+			var records [][]string
+			for _, walk := range graph.GetWalks() {
+				owner := strings.ToLower(strings.TrimPrefix(walk.GetOwner().String(), "PARTY_"))
+				for step, nodeID := range walk.GetNodeIds() {
+					var edgeID string
+					if step > 0 {
+						edgeID = walk.GetEdgeIds()[step-1]
+					}
+					records = append(records, []string{
+						owner, strconv.FormatInt(walk.GetInstance(), 10), strconv.Itoa(step), nodeID, edgeID,
+					})
+				}
+			}
+			return writeCSV(w, []string{"owner", "instance", "step", "node_id", "edge_id"}, records)
+		default:
+			return fmt.Errorf("unknown format: %q", o.format)
+		}
+	})
+}
+
+// layout writes the positions of the nodes of a laid out graph, json writes the whole graph.
+func layout(ctx context.Context, fs *flag.FlagSet, args []string, stdout io.Writer) (err error) {
+	var o graphFlags
+	o.register(fs)
+	o.output.register(fs, "csv", "csv or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	svc, closeService, err := o.openService(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, closeService()) }()
+
+	graph, err := o.build(ctx, svc, fs)
+	if err != nil {
+		return err
+	}
+
+	return o.write(stdout, func(w io.Writer) error {
+		switch o.format {
+		case "json":
+			return graphio.Write(w, graph, graphio.FormatJSON)
+		case "csv":
+			records := make([][]string, 0, len(graph.GetNodes()))
+			for _, node := range graph.GetNodes() {
+				records = append(records, []string{
+					node.GetId(), node.GetType(),
+					strconv.FormatInt(node.GetPosition().GetX(), 10), strconv.FormatInt(node.GetPosition().GetY(), 10),
+				})
+			}
+			return writeCSV(w, []string{"node_id", "type", "x", "y"}, records)
+		default:
+			return fmt.Errorf("unknown format: %q", o.format)
+		}
+	})
+}
+
+// export writes a graph through the export of the service. Unlike generate, a graph file is written as
+// it is, without assigning parties or applying a layout.
+func export(ctx context.Context, fs *flag.FlagSet, args []string, stdout io.Writer) (err error) {
+	var o graphFlags
+	o.register(fs)
+	o.output.register(fs, "graphml", "json, graphml, gexf, dot or edgelist")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	format, err := graphFormat(o.format)
+	if err != nil {
+		return err
+	}
+
+	svc, closeService, err := o.openService(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, closeService()) }()
+
+	req, err := o.prepare(ctx, svc, fs)
+	if err != nil {
+		return err
+	}
+
+	src := &rpcv1.GraphSource{}
+	if req.HasGraphId() {
+		src.SetGraphId(req.GetGraphId())
+	} else {
+		src.SetGenerate(req)
+	}
+
+	exportReq := &rpcv1.ExportGraphRequest{}
+	exportReq.SetSource(src)
+	exportReq.SetFormat(exportFormat(format))
+	resp, err := svc.ExportGraph(ctx, connect.NewRequest(exportReq))
+	if err != nil {
+		return err
+	}
+
+	return o.write(stdout, func(w io.Writer) error {
+		_, err := w.Write(resp.Msg.GetContent())
+		return err
+	})
+}
+
+// exportFormat maps the graphio format onto the protobuf format.
+func exportFormat(format graphio.Format) rpcv1.GraphFormat {
+	switch format {
+	case graphio.FormatEdgeList:
+		return rpcv1.GraphFormat_GRAPH_FORMAT_EDGE_LIST
+	case graphio.FormatGraphML:
+		return rpcv1.GraphFormat_GRAPH_FORMAT_GRAPHML
+	case graphio.FormatGEXF:
+		return rpcv1.GraphFormat_GRAPH_FORMAT_GEXF
+	case graphio.FormatDOT:
+		return rpcv1.GraphFormat_GRAPH_FORMAT_DOT
+	case graphio.FormatJSON:
+		return rpcv1.GraphFormat_GRAPH_FORMAT_JSON
+	default:
+		return rpcv1.GraphFormat_GRAPH_FORMAT_UNSPECIFIED
+	}
+}
+
+// experiment writes the results of an experiment, csv has a row for every configuration.
+func experiment(ctx context.Context, fs *flag.FlagSet, args []string, stdout io.Writer) (err error) {
+	var o output
+	var request string
+	var seeds int64
+	fs.StringVar(&request, "request", "", "path of a JSON encoded RunExperimentRequest, - reads stdin")
+	fs.Int64Var(&seeds, "seeds", 0, "run every configuration with the seeds 1 up to and including this number")
+	o.register(fs, "csv", "csv or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	req := &rpcv1.RunExperimentRequest{}
+	if err := readRequest(request, req); err != nil {
+		return err
+	}
+	if seeds > 0 {
+		sweep := &rpcv1.Int64Sweep{}
+		sweep.SetStart(1)
+		sweep.SetStop(seeds)
+		sweep.SetStep(1)
+		req.SetSeeds(sweep)
+	}
+
+	svc, closeService, err := openService(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, closeService()) }()
+
+	resp, err := svc.RunExperiment(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	return o.write(stdout, func(w io.Writer) error {
+		switch o.format {
+		case "json":
+			data, err := protojson.MarshalOptions{Multiline: true}.Marshal(resp.Msg)
+			if err != nil {
+				return fmt.Errorf("failed to encode results: %w", err)
+			}
+			_, err = w.Write(append(data, '\n'))
+			return err
+		case "csv":
+			records := make([][]string, 0, len(resp.Msg.GetResults()))
+			for _, res := range resp.Msg.GetResults() {
+				records = append(records, []string{
+					strconv.FormatInt(res.GetNumNodes(), 10),
+					strconv.FormatInt(res.GetInitialConnected(), 10),
+					strconv.FormatFloat(res.GetRewiringProbability(), 'g', -1, 64),
+					strconv.FormatInt(res.GetWalkLength(), 10),
+					strconv.FormatInt(res.GetNumWalks(), 10),
+					strconv.FormatInt(res.GetRuns(), 10),
+					strconv.FormatFloat(res.GetIntersectionRate(), 'g', -1, 64),
+					strconv.FormatFloat(res.GetMeanMeetingStep(), 'g', -1, 64),
+					strconv.FormatFloat(res.GetEscapeProbability(), 'g', -1, 64),
+				})
+			}
+			return writeCSV(w, []string{
+				"num_nodes", "initial_connected", "rewiring_probability", "walk_length", "num_walks",
+				"runs", "intersection_rate", "mean_meeting_step", "escape_probability",
+			}, records)
+		default:
+			return fmt.Errorf("unknown format: %q", o.format)
+		}
+	})
+}
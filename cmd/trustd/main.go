@@ -1,20 +1,44 @@
-// Package main holds the main daemons entrypoint.
+// Package main holds the main daemons entrypoint, and the commands that run trustd without the daemon.
 package main
 
 import (
+	"context"
+	"fmt"
+	"os"
+
 	"github.com/advdv/stdgo/fx/stdhttpserverfx"
 	"github.com/advdv/stdgo/fx/stdzapfx"
 	"github.com/advdv/trustd/internal/rpc"
+	"github.com/advdv/trustd/internal/store"
 	"github.com/advdv/trustd/internal/web"
 	"go.uber.org/fx"
 )
 
+// version is the build version, it is set at build time with -ldflags "-X main.version=...".
+var version = "dev"
+
 func main() {
+	args := os.Args[1:]
+	if len(args) == 0 || args[0] == "serve" {
+		serve()
+		return
+	}
+
+	if err := run(context.Background(), args, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "trustd:", err)
+		os.Exit(1)
+	}
+}
+
+// serve runs the daemon, which serves the rpc and the GUI over HTTP.
+func serve() {
 	fx.New(
 		stdzapfx.Provide(),
 		stdzapfx.Fx(),
 		stdhttpserverfx.Provide(),
+		fx.Supply(rpc.Version(version)),
 
+		store.Provide(),
 		rpc.Provide(),
 		web.Provide(),
 	).Run()
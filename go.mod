@@ -3,12 +3,14 @@ module github.com/advdv/trustd
 go 1.24.1
 
 require (
+	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.10-20250912141014-52f32327d4b0.1
 	connectrpc.com/connect v1.18.1
 	github.com/advdv/stdgo v0.0.112
 	github.com/rs/cors v1.11.1
+	go.etcd.io/bbolt v1.4.0
 	go.uber.org/fx v1.23.0
 	go.uber.org/zap v1.27.0
-	google.golang.org/protobuf v1.36.5
+	google.golang.org/protobuf v1.36.10
 )
 
 require (
@@ -4,7 +4,7 @@ import {
   Node as RpcNode,
   Edge as RpcEdge,
 } from "./proto/internal/rpc/v1/rpc_pb";
-import { Node as RFNode, Edge as RFEdge } from "@xyflow/react";
+import { Node as RFNode, Edge as RFEdge, MarkerType } from "@xyflow/react";
 
 /**
  * Convert a RandomGraphResponse from the server
@@ -31,13 +31,16 @@ export function convertRandomGraphResponse(response: RandomGraphResponse): {
     };
   });
 
-  // Convert each RPC Edge to a React Flow Edge
+  // Convert each RPC Edge to a React Flow Edge, directed edges get an arrow at their target.
   const flowEdges: RFEdge[] = response.edges.map((edge: RpcEdge) => {
     return {
       id: edge.id,
       source: edge.source,
       target: edge.target,
       type: edge.type,
+      markerEnd: response.directed
+        ? { type: MarkerType.ArrowClosed }
+        : undefined,
     };
   });
 
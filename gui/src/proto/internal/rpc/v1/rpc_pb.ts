@@ -2,15 +2,16 @@
 // @generated from file internal/rpc/v1/rpc.proto (package internal.rpc.v1, edition 2023)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file internal/rpc/v1/rpc.proto.
  */
 export const file_internal_rpc_v1_rpc: GenFile = /*@__PURE__*/
-  fileDesc("ChlpbnRlcm5hbC9ycGMvdjEvcnBjLnByb3RvEg9pbnRlcm5hbC5ycGMudjEiIAoIUG9zaXRpb24SCQoBeBgBIAEoAxIJCgF5GAIgASgDIhkKCE5vZGVEYXRhEg0KBWxhYmVsGAEgASgJInYKBE5vZGUSCgoCaWQYASABKAkSKwoIcG9zaXRpb24YAiABKAsyGS5pbnRlcm5hbC5ycGMudjEuUG9zaXRpb24SJwoEZGF0YRgDIAEoCzIZLmludGVybmFsLnJwYy52MS5Ob2RlRGF0YRIMCgR0eXBlGAQgASgJIkAKBEVkZ2USCgoCaWQYASABKAkSDgoGc291cmNlGAIgASgJEg4KBnRhcmdldBgDIAEoCRIMCgR0eXBlGAQgASgJIvQBChJSYW5kb21HcmFwaFJlcXVlc3QSDQoFc2VlZDEYASABKAQSDQoFc2VlZDIYAiABKAQSEQoJbnVtX25vZGVzGAMgASgDEhkKEWluaXRpYWxfY29ubmVjdGVkGAQgASgDEhwKFHJld2lyaW5nX3Byb2JhYmlsaXR5GAUgASgBEhkKEWxheW91dF9pdGVyYXRpb25zGAYgASgDEhMKC2xheW91dF9hcmVhGAcgASgBEhMKC3dhbGtfbGVuZ3RoGAggASgDEhEKCW51bV93YWxrcxgJIAEoAxINCgVzZWVkMxgKIAEoBBINCgVzZWVkNBgLIAEoBCJhChNSYW5kb21HcmFwaFJlc3BvbnNlEiQKBW5vZGVzGAEgAygLMhUuaW50ZXJuYWwucnBjLnYxLk5vZGUSJAoFZWRnZXMYAiADKAsyFS5pbnRlcm5hbC5ycGMudjEuRWRnZTJoCgxHcmFwaFNlcnZpY2USWAoLUmFuZG9tR3JhcGgSIy5pbnRlcm5hbC5ycGMudjEuUmFuZG9tR3JhcGhSZXF1ZXN0GiQuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVzcG9uc2VCrAEKE2NvbS5pbnRlcm5hbC5ycGMudjFCCFJwY1Byb3RvUAFaLWdpdGh1Yi5jb20vYWR2ZHYvdHJ1c3RkL2ludGVybmFsL3JwYy92MTtycGN2MaICA0lSWKoCD0ludGVybmFsLlJwYy5WMcoCD0ludGVybmFsXFJwY1xWMeICG0ludGVybmFsXFJwY1xWMVxHUEJNZXRhZGF0YeoCEUludGVybmFsOjpScGM6OlYxYghlZGl0aW9uc3DoBw");
+  fileDesc("ChlpbnRlcm5hbC9ycGMvdjEvcnBjLnByb3RvEg9pbnRlcm5hbC5ycGMudjEaG2J1Zi92YWxpZGF0ZS92YWxpZGF0ZS5wcm90byIgCghQb3NpdGlvbhIJCgF4GAEgASgDEgkKAXkYAiABKAMiGQoITm9kZURhdGESDQoFbGFiZWwYASABKAkidgoETm9kZRIKCgJpZBgBIAEoCRIrCghwb3NpdGlvbhgCIAEoCzIZLmludGVybmFsLnJwYy52MS5Qb3NpdGlvbhInCgRkYXRhGAMgASgLMhkuaW50ZXJuYWwucnBjLnYxLk5vZGVEYXRhEgwKBHR5cGUYBCABKAkiUAoERWRnZRIKCgJpZBgBIAEoCRIOCgZzb3VyY2UYAiABKAkSDgoGdGFyZ2V0GAMgASgJEgwKBHR5cGUYBCABKAkSDgoGd2VpZ2h0GAUgASgBIp0BCgRXYWxrEiUKBW93bmVyGAEgASgOMhYuaW50ZXJuYWwucnBjLnYxLlBhcnR5EhIKCnN0YXJ0X25vZGUYAiABKAkSEAoIbm9kZV9pZHMYAyADKAkSEAoIZWRnZV9pZHMYBCADKAkSEAoIaW5zdGFuY2UYBSABKAMSDwoHZXNjYXBlZBgGIAEoCBITCgtlc2NhcGVfc3RlcBgHIAEoAyKqAQoQV2Fsa0ludGVyc2VjdGlvbhIQCghib2Jfd2FsaxgBIAEoAxIQCghhZGFfd2FsaxgCIAEoAxIPCgdub2RlX2lkGAMgASgJEg8KB2VkZ2VfaWQYBCABKAkSEAoIYm9iX3N0ZXAYBSABKAMSEAoIYWRhX3N0ZXAYBiABKAMSFQoNcGF0aF9ub2RlX2lkcxgHIAMoCRIVCg1wYXRoX2VkZ2VfaWRzGAggAygJIowBChNXYXR0c1N0cm9nYXR6UGFyYW1zEhoKCW51bV9ub2RlcxgBIAEoA0IHukgEIgIoABIiChFpbml0aWFsX2Nvbm5lY3RlZBgCIAEoA0IHukgEIgIoABI1ChRyZXdpcmluZ19wcm9iYWJpbGl0eRgDIAEoAUIXukgUEhIZAAAAAAAA8D8pAAAAAAAAAAAiYQoQRXJkb3NSZW55aVBhcmFtcxIaCgludW1fbm9kZXMYASABKANCB7pIBCICKAASMQoQZWRnZV9wcm9iYWJpbGl0eRgCIAEoAUIXukgUEhIZAAAAAAAA8D8pAAAAAAAAAAAiWQoUQmFyYWJhc2lBbGJlcnRQYXJhbXMSHQoJbnVtX25vZGVzGAEgASgDQgq6SAfIAQEiAigCEiIKDmVkZ2VzX3Blcl9ub2RlGAIgASgDQgq6SAfIAQEiAigBIokBChVTdG9jaGFzdGljQmxvY2tQYXJhbXMSIQoLYmxvY2tfc2l6ZXMYASADKANCDLpICZIBBiIEIgIoABIlCgRwX2luGAIgASgBQhe6SBQSEhkAAAAAAADwPykAAAAAAAAAABImCgVwX291dBgDIAEoAUIXukgUEhIZAAAAAAAA8D8pAAAAAAAAAAAiSgoTUmFuZG9tUmVndWxhclBhcmFtcxIaCgludW1fbm9kZXMYASABKANCB7pIBCICKAASFwoGZGVncmVlGAIgASgDQge6SAQiAigAIlIKDUxhdHRpY2VQYXJhbXMSFQoEcm93cxgBIAEoA0IHukgEIgIoABIYCgdjb2x1bW5zGAIgASgDQge6SAQiAigAEhAKCHBlcmlvZGljGAMgASgIIosCCgtFZGdlV2VpZ2h0cxI5CgxkaXN0cmlidXRpb24YASABKA4yIy5pbnRlcm5hbC5ycGMudjEuV2VpZ2h0RGlzdHJpYnV0aW9uEhQKA21pbhgCIAEoAUIHukgEEgJAARIUCgNtYXgYAyABKAFCB7pIBBICQAESHgoEbWVhbhgEIAEoAUIQukgNEgtAASEAAAAAAAAAABITCgJtdRgFIAEoAUIHukgEEgJAARIfCgVzaWdtYRgGIAEoAUIQukgNEgtAASkAAAAAAAAAABIfCgVhbHBoYRgHIAEoAUIQukgNEgtAASEAAAAAAAAAABIeCgRiZXRhGAggASgBQhC6SA0SC0ABIQAAAAAAAAAAIqMBCgtTeWJpbFJlZ2lvbhIaCgludW1fbm9kZXMYASABKANCB7pIBCICKAASIgoRaW5pdGlhbF9jb25uZWN0ZWQYAiABKANCB7pIBCICKAASNQoUcmV3aXJpbmdfcHJvYmFiaWxpdHkYAyABKAFCF7pIFBISGQAAAAAAAPA/KQAAAAAAAAAAEh0KDGF0dGFja19lZGdlcxgEIAEoA0IHukgEIgIoACKDAgoTRm9yY2VEaXJlY3RlZFBhcmFtcxIbCgppdGVyYXRpb25zGAEgASgDQge6SAQiAiAAEh4KBGFyZWEYAiABKAFCELpIDRILQAEhAAAAAAAAAAASNgoJcmVwdWxzaW9uGAMgASgOMiMuaW50ZXJuYWwucnBjLnYxLlJlcHVsc2lvbkFsZ29yaXRobRIfCgV0aGV0YRgEIAEoAUIQukgNEgtAASkAAAAAAAAAABIxCgdjb29saW5nGAUgASgOMiAuaW50ZXJuYWwucnBjLnYxLkNvb2xpbmdTY2hlZHVsZRIjCgl0b2xlcmFuY2UYBiABKAFCELpIDRILQAEpAAAAAAAAAAAiMgoOQ2lyY3VsYXJQYXJhbXMSIAoGcmFkaXVzGAEgASgBQhC6SA0SC0ABKQAAAAAAAAAAIjEKDlNwZWN0cmFsUGFyYW1zEh8KBXNjYWxlGAEgASgBQhC6SA0SC0ABKQAAAAAAAAAAIlcKEUthbWFkYUthd2FpUGFyYW1zEhsKCml0ZXJhdGlvbnMYASABKANCB7pIBCICIAASJQoLZWRnZV9sZW5ndGgYAiABKAFCELpIDRILQAEpAAAAAAAAAAAiZQoSSGllcmFyY2hpY2FsUGFyYW1zEicKDWxheWVyX3NwYWNpbmcYASABKAFCELpIDRILQAEpAAAAAAAAAAASJgoMbm9kZV9zcGFjaW5nGAIgASgBQhC6SA0SC0ABKQAAAAAAAAAAIvILChJSYW5kb21HcmFwaFJlcXVlc3QSDQoFc2VlZDEYASABKAQSDQoFc2VlZDIYAiABKAQSGgoJbnVtX25vZGVzGAMgASgDQge6SAQiAigAEiIKEWluaXRpYWxfY29ubmVjdGVkGAQgASgDQge6SAQiAigAEjUKFHJld2lyaW5nX3Byb2JhYmlsaXR5GAUgASgBQhe6SBQSEhkAAAAAAADwPykAAAAAAAAAABIiChFsYXlvdXRfaXRlcmF0aW9ucxgGIAEoA0IHukgEIgIgABIlCgtsYXlvdXRfYXJlYRgHIAEoAUIQukgNEgtAASEAAAAAAAAAABIcCgt3YWxrX2xlbmd0aBgIIAEoA0IHukgEIgIoABIaCgludW1fd2Fsa3MYCSABKANCB7pIBCICKAASDQoFc2VlZDMYCiABKAQSDQoFc2VlZDQYCyABKAQSLAoJd2Fsa19tb2RlGAwgASgOMhkuaW50ZXJuYWwucnBjLnYxLldhbGtNb2RlEjIKDHN5YmlsX3JlZ2lvbhgNIAEoCzIcLmludGVybmFsLnJwYy52MS5TeWJpbFJlZ2lvbhI+Cg53YXR0c19zdHJvZ2F0ehgOIAEoCzIkLmludGVybmFsLnJwYy52MS5XYXR0c1N0cm9nYXR6UGFyYW1zSAASOAoLZXJkb3NfcmVueWkYDyABKAsyIS5pbnRlcm5hbC5ycGMudjEuRXJkb3NSZW55aVBhcmFtc0gAEkAKD2JhcmFiYXNpX2FsYmVydBgQIAEoCzIlLmludGVybmFsLnJwYy52MS5CYXJhYmFzaUFsYmVydFBhcmFtc0gAEkIKEHN0b2NoYXN0aWNfYmxvY2sYESABKAsyJi5pbnRlcm5hbC5ycGMudjEuU3RvY2hhc3RpY0Jsb2NrUGFyYW1zSAASPgoOcmFuZG9tX3JlZ3VsYXIYEiABKAsyJC5pbnRlcm5hbC5ycGMudjEuUmFuZG9tUmVndWxhclBhcmFtc0gAEjEKB2xhdHRpY2UYEyABKAsyHi5pbnRlcm5hbC5ycGMudjEuTGF0dGljZVBhcmFtc0gAEhIKCGdyYXBoX2lkGBQgASgJSAASPQoQbGF5b3V0X3JlcHVsc2lvbhgVIAEoDjIjLmludGVybmFsLnJwYy52MS5SZXB1bHNpb25BbGdvcml0aG0SJgoMbGF5b3V0X3RoZXRhGBYgASgBQhC6SA0SC0ABKQAAAAAAAAAAEjgKDmxheW91dF9jb29saW5nGBcgASgOMiAuaW50ZXJuYWwucnBjLnYxLkNvb2xpbmdTY2hlZHVsZRIqChBsYXlvdXRfdG9sZXJhbmNlGBggASgBQhC6SA0SC0ABKQAAAAAAAAAAEj4KDmZvcmNlX2RpcmVjdGVkGBkgASgLMiQuaW50ZXJuYWwucnBjLnYxLkZvcmNlRGlyZWN0ZWRQYXJhbXNIARIzCghjaXJjdWxhchgaIAEoCzIfLmludGVybmFsLnJwYy52MS5DaXJjdWxhclBhcmFtc0gBEjMKCHNwZWN0cmFsGBsgASgLMh8uaW50ZXJuYWwucnBjLnYxLlNwZWN0cmFsUGFyYW1zSAESOgoMa2FtYWRhX2thd2FpGBwgASgLMiIuaW50ZXJuYWwucnBjLnYxLkthbWFkYUthd2FpUGFyYW1zSAESOwoMaGllcmFyY2hpY2FsGB0gASgLMiMuaW50ZXJuYWwucnBjLnYxLkhpZXJhcmNoaWNhbFBhcmFtc0gBEjIKDGVkZ2Vfd2VpZ2h0cxgeIAEoCzIcLmludGVybmFsLnJwYy52MS5FZGdlV2VpZ2h0cxIpCghsYXppbmVzcxgfIAEoAUIXukgUEhIZAAAAAAAA8D8pAAAAAAAAAAASEAoIZGlyZWN0ZWQYICABKAgSLAoLcmVjaXByb2NpdHkYISABKAFCF7pIFBISGQAAAAAAAPA/KQAAAAAAAAAAEhUKDWluY2x1ZGVfc3RhdHMYIiABKAhCCwoJZ2VuZXJhdG9yQggKBmxheW91dCJECgtMYXlvdXRTdGF0cxISCgppdGVyYXRpb25zGAEgASgDEg4KBmVuZXJneRgCIAEoARIRCgljb252ZXJnZWQYAyABKAgiiAMKE1JhbmRvbUdyYXBoUmVzcG9uc2USJAoFbm9kZXMYASADKAsyFS5pbnRlcm5hbC5ycGMudjEuTm9kZRIkCgVlZGdlcxgCIAMoCzIVLmludGVybmFsLnJwYy52MS5FZGdlEiQKBXdhbGtzGAMgAygLMhUuaW50ZXJuYWwucnBjLnYxLldhbGsSNwoMaW50ZXJzZWN0aW9uGAQgASgLMiEuaW50ZXJuYWwucnBjLnYxLldhbGtJbnRlcnNlY3Rpb24SLAoGbGF5b3V0GAUgASgLMhwuaW50ZXJuYWwucnBjLnYxLkxheW91dFN0YXRzEhAKCGRpcmVjdGVkGAYgASgIEioKBXN0YXRzGAcgASgLMhsuaW50ZXJuYWwucnBjLnYxLkdyYXBoU3RhdHMSMAoIbWFuaWZlc3QYCCABKAsyHi5pbnRlcm5hbC5ycGMudjEuR3JhcGhNYW5pZmVzdBITCgtib2Jfbm9kZV9pZBgJIAEoCRITCgthZGFfbm9kZV9pZBgKIAEoCSJXCglTdGFnZVNlZWQSLAoFc3RhZ2UYASABKAlCHbpIGnIYUgVncmFwaFIIcmVsYXlvdXRSBXdhbGtzEg0KBXNlZWQxGAIgASgEEg0KBXNlZWQyGAMgASgEIjEKEEFsZ29yaXRobVZlcnNpb24SDAoEbmFtZRgBIAEoCRIPCgd2ZXJzaW9uGAIgASgDIvYBCg1HcmFwaE1hbmlmZXN0EjQKB3JlcXVlc3QYASABKAsyIy5pbnRlcm5hbC5ycGMudjEuUmFuZG9tR3JhcGhSZXF1ZXN0EjUKCHJlbGF5b3V0GAIgASgLMiMuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVxdWVzdBIpCgVzZWVkcxgDIAMoCzIaLmludGVybmFsLnJwYy52MS5TdGFnZVNlZWQSNQoKYWxnb3JpdGhtcxgEIAMoCzIhLmludGVybmFsLnJwYy52MS5BbGdvcml0aG1WZXJzaW9uEhYKDnRydXN0ZF92ZXJzaW9uGAUgASgJIkEKDVJlcGxheVJlcXVlc3QSMAoIbWFuaWZlc3QYASABKAsyHi5pbnRlcm5hbC5ycGMudjEuR3JhcGhNYW5pZmVzdCLBAgoKR3JhcGhTdGF0cxIRCgludW1fbm9kZXMYASABKAMSEQoJbnVtX2VkZ2VzGAIgASgDEhIKCm1pbl9kZWdyZWUYAyABKAMSEgoKbWF4X2RlZ3JlZRgEIAEoAxITCgttZWFuX2RlZ3JlZRgFIAEoARIYChBkZWdyZWVfaGlzdG9ncmFtGAYgAygDEhoKEmF2ZXJhZ2VfY2x1c3RlcmluZxgHIAEoARIbChNhdmVyYWdlX3BhdGhfbGVuZ3RoGAggASgBEhAKCGRpYW1ldGVyGAkgASgDEhYKDm51bV9jb21wb25lbnRzGAogASgDEh4KFmxhcmdlc3RfY29tcG9uZW50X3NpemUYCyABKAMSFQoNYXNzb3J0YXRpdml0eRgMIAEoARINCgVzaWdtYRgNIAEoARINCgVvbWVnYRgOIAEoASJkCgtHcmFwaFNvdXJjZRISCghncmFwaF9pZBgBIAEoCUgAEjcKCGdlbmVyYXRlGAIgASgLMiMuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVxdWVzdEgAQggKBnNvdXJjZSJTChBMb2FkR3JhcGhSZXF1ZXN0EhEKCWZpbGVfbmFtZRgBIAEoCRIsCgZmb3JtYXQYAiABKA4yHC5pbnRlcm5hbC5ycGMudjEuR3JhcGhGb3JtYXQiSwoRTG9hZEdyYXBoUmVzcG9uc2USEAoIZ3JhcGhfaWQYASABKAkSEQoJbnVtX25vZGVzGAIgASgDEhEKCW51bV9lZGdlcxgDIAEoAyJwChJFeHBvcnRHcmFwaFJlcXVlc3QSLAoGc291cmNlGAEgASgLMhwuaW50ZXJuYWwucnBjLnYxLkdyYXBoU291cmNlEiwKBmZvcm1hdBgCIAEoDjIcLmludGVybmFsLnJwYy52MS5HcmFwaEZvcm1hdCJPChNFeHBvcnRHcmFwaFJlc3BvbnNlEg8KB2NvbnRlbnQYASABKAwSFAoMY29udGVudF90eXBlGAIgASgJEhEKCWZpbGVfbmFtZRgDIAEoCSLNAQoOUGFnZVJhbmtQYXJhbXMSKAoHZGFtcGluZxgBIAEoAUIXukgUEhIRAAAAAAAA8D8pAAAAAAAAAAASLwoGbWV0aG9kGAIgASgOMh8uaW50ZXJuYWwucnBjLnYxLlBhZ2VSYW5rTWV0aG9kEh8KDm1heF9pdGVyYXRpb25zGAMgASgDQge6SAQiAiAAEiMKCXRvbGVyYW5jZRgEIAEoAUIQukgNEgtAASkAAAAAAAAAABIaCgludW1fd2Fsa3MYBSABKANCB7pIBCICKAAipgEKEEVpZ2VuVHJ1c3RQYXJhbXMSMQoQcHJlX3RydXN0X3dlaWdodBgBIAEoAUIXukgUEhIZAAAAAAAA8D8hAAAAAAAAAAASGQoRdW5pZm9ybV9wcmVfdHJ1c3QYAiABKAgSHwoObWF4X2l0ZXJhdGlvbnMYAyABKANCB7pIBCICIAASIwoJdG9sZXJhbmNlGAQgASgBQhC6SA0SC0ABKQAAAAAAAAAAIhIKEFRpZGFsVHJ1c3RQYXJhbXMiMgoOQWR2b2dhdG9QYXJhbXMSIAoKY2FwYWNpdGllcxgBIAMoA0IMukgJkgEGIgQiAigAItcCChNDb21wdXRlVHJ1c3RSZXF1ZXN0EiwKBnNvdXJjZRgBIAEoCzIcLmludGVybmFsLnJwYy52MS5HcmFwaFNvdXJjZRINCgVzZWVkMRgCIAEoBBINCgVzZWVkMhgDIAEoBBJBChZwZXJzb25hbGl6ZWRfcGFnZV9yYW5rGAQgASgLMh8uaW50ZXJuYWwucnBjLnYxLlBhZ2VSYW5rUGFyYW1zSAASOAoLZWlnZW5fdHJ1c3QYBSABKAsyIS5pbnRlcm5hbC5ycGMudjEuRWlnZW5UcnVzdFBhcmFtc0gAEjgKC3RpZGFsX3RydXN0GAYgASgLMiEuaW50ZXJuYWwucnBjLnYxLlRpZGFsVHJ1c3RQYXJhbXNIABIzCghhZHZvZ2F0bxgHIAEoCzIfLmludGVybmFsLnJwYy52MS5BZHZvZ2F0b1BhcmFtc0gAQggKBm1ldHJpYyKDAgoUQ29tcHV0ZVRydXN0UmVzcG9uc2USQQoGc2NvcmVzGAEgAygLMjEuaW50ZXJuYWwucnBjLnYxLkNvbXB1dGVUcnVzdFJlc3BvbnNlLlNjb3Jlc0VudHJ5EhYKDnNvdXJjZV9ub2RlX2lkGAIgASgJEhYKDnRhcmdldF9ub2RlX2lkGAMgASgJEhQKDHRhcmdldF9zY29yZRgEIAEoARITCgt0YXJnZXRfcmFuaxgFIAEoAxISCgppdGVyYXRpb25zGAYgASgDGjkKC1Njb3Jlc0VudHJ5EhAKA2tleRgBIAEoCVIDa2V5EhQKBXZhbHVlGAIgASgBUgV2YWx1ZToCOAEibwoOTWF4Rmxvd1JlcXVlc3QSLAoGc291cmNlGAEgASgLMhwuaW50ZXJuYWwucnBjLnYxLkdyYXBoU291cmNlEi8KCGNhcGFjaXR5GAIgASgOMh0uaW50ZXJuYWwucnBjLnYxLkZsb3dDYXBhY2l0eSKbAQoPTWF4Rmxvd1Jlc3BvbnNlEhYKDnNvdXJjZV9ub2RlX2lkGAEgASgJEhYKDnRhcmdldF9ub2RlX2lkGAIgASgJEg0KBXZhbHVlGAMgASgBEhQKDGN1dF9lZGdlX2lkcxgEIAMoCRIzCgVncmFwaBgFIAEoCzIkLmludGVybmFsLnJwYy52MS5SYW5kb21HcmFwaFJlc3BvbnNlIoEBChRTaG9ydGVzdFBhdGhzUmVxdWVzdBIsCgZzb3VyY2UYASABKAsyHC5pbnRlcm5hbC5ycGMudjEuR3JhcGhTb3VyY2USJwoEY29zdBgCIAEoDjIZLmludGVybmFsLnJwYy52MS5QYXRoQ29zdBISCgFrGAMgASgDQge6SAQiAigAIj0KCVRydXN0UGF0aBIQCghub2RlX2lkcxgBIAMoCRIQCghlZGdlX2lkcxgCIAMoCRIMCgRjb3N0GAMgASgBIqcBChVTaG9ydGVzdFBhdGhzUmVzcG9uc2USFgoOc291cmNlX25vZGVfaWQYASABKAkSFgoOdGFyZ2V0X25vZGVfaWQYAiABKAkSKQoFcGF0aHMYAyADKAsyGi5pbnRlcm5hbC5ycGMudjEuVHJ1c3RQYXRoEjMKBWdyYXBoGAQgASgLMiQuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVzcG9uc2UiQQoRR3JhcGhTdGF0c1JlcXVlc3QSLAoGc291cmNlGAEgASgLMhwuaW50ZXJuYWwucnBjLnYxLkdyYXBoU291cmNlIkAKEkdyYXBoU3RhdHNSZXNwb25zZRIqCgVzdGF0cxgBIAEoCzIbLmludGVybmFsLnJwYy52MS5HcmFwaFN0YXRzIlIKCkludDY0U3dlZXASFgoFc3RhcnQYASABKANCB7pIBCICKAASFQoEc3RvcBgCIAEoA0IHukgEIgIoABIVCgRzdGVwGAMgASgDQge6SAQiAigAIm4KC0RvdWJsZVN3ZWVwEh8KBXN0YXJ0GAEgASgBQhC6SA0SC0ABKQAAAAAAAAAAEh4KBHN0b3AYAiABKAFCELpIDRILQAEpAAAAAAAAAAASHgoEc3RlcBgDIAEoAUIQukgNEgtAASkAAAAAAAAAACL7AgoUUnVuRXhwZXJpbWVudFJlcXVlc3QSMQoEYmFzZRgBIAEoCzIjLmludGVybmFsLnJwYy52MS5SYW5kb21HcmFwaFJlcXVlc3QSLgoJbnVtX25vZGVzGAIgASgLMhsuaW50ZXJuYWwucnBjLnYxLkludDY0U3dlZXASNgoRaW5pdGlhbF9jb25uZWN0ZWQYAyABKAsyGy5pbnRlcm5hbC5ycGMudjEuSW50NjRTd2VlcBI6ChRyZXdpcmluZ19wcm9iYWJpbGl0eRgEIAEoCzIcLmludGVybmFsLnJwYy52MS5Eb3VibGVTd2VlcBIwCgt3YWxrX2xlbmd0aBgFIAEoCzIbLmludGVybmFsLnJwYy52MS5JbnQ2NFN3ZWVwEi4KCW51bV93YWxrcxgGIAEoCzIbLmludGVybmFsLnJwYy52MS5JbnQ2NFN3ZWVwEioKBXNlZWRzGAcgASgLMhsuaW50ZXJuYWwucnBjLnYxLkludDY0U3dlZXAi5gEKEEV4cGVyaW1lbnRSZXN1bHQSEQoJbnVtX25vZGVzGAEgASgDEhkKEWluaXRpYWxfY29ubmVjdGVkGAIgASgDEhwKFHJld2lyaW5nX3Byb2JhYmlsaXR5GAMgASgBEhMKC3dhbGtfbGVuZ3RoGAQgASgDEhEKCW51bV93YWxrcxgFIAEoAxIMCgRydW5zGAYgASgDEhkKEWludGVyc2VjdGlvbl9yYXRlGAcgASgBEhkKEW1lYW5fbWVldGluZ19zdGVwGAggASgBEhoKEmVzY2FwZV9wcm9iYWJpbGl0eRgJIAEoASJiChVSdW5FeHBlcmltZW50UmVzcG9uc2USMgoHcmVzdWx0cxgBIAMoCzIhLmludGVybmFsLnJwYy52MS5FeHBlcmltZW50UmVzdWx0EhUKDWV4cGVyaW1lbnRfaWQYAiABKAkiSQoSQ3JlYXRlR3JhcGhSZXF1ZXN0EjMKBnBhcmFtcxgBIAEoCzIjLmludGVybmFsLnJwYy52MS5SYW5kb21HcmFwaFJlcXVlc3QiXAoTQ3JlYXRlR3JhcGhSZXNwb25zZRIQCghncmFwaF9pZBgBIAEoCRIzCgVncmFwaBgCIAEoCzIkLmludGVybmFsLnJwYy52MS5SYW5kb21HcmFwaFJlc3BvbnNlIlgKD1J1bldhbGtzUmVxdWVzdBIQCghncmFwaF9pZBgBIAEoCRIzCgZwYXJhbXMYAiABKAsyIy5pbnRlcm5hbC5ycGMudjEuUmFuZG9tR3JhcGhSZXF1ZXN0IkcKEFJ1bldhbGtzUmVzcG9uc2USMwoFZ3JhcGgYASABKAsyJC5pbnRlcm5hbC5ycGMudjEuUmFuZG9tR3JhcGhSZXNwb25zZSJYCg9SZWxheW91dFJlcXVlc3QSEAoIZ3JhcGhfaWQYASABKAkSMwoGcGFyYW1zGAIgASgLMiMuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVxdWVzdCJHChBSZWxheW91dFJlc3BvbnNlEjMKBWdyYXBoGAEgASgLMiQuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVzcG9uc2UidwoTU3RyZWFtTGF5b3V0UmVxdWVzdBIQCghncmFwaF9pZBgBIAEoCRIzCgZwYXJhbXMYAiABKAsyIy5pbnRlcm5hbC5ycGMudjEuUmFuZG9tR3JhcGhSZXF1ZXN0EhkKEXNuYXBzaG90X2ludGVydmFsGAMgASgDIkwKDE5vZGVQb3NpdGlvbhIPCgdub2RlX2lkGAEgASgJEisKCHBvc2l0aW9uGAIgASgLMhkuaW50ZXJuYWwucnBjLnYxLlBvc2l0aW9uIqoBChRTdHJlYW1MYXlvdXRSZXNwb25zZRIrCgVzdGF0cxgBIAEoCzIcLmludGVybmFsLnJwYy52MS5MYXlvdXRTdGF0cxIwCglwb3NpdGlvbnMYAiADKAsyHS5pbnRlcm5hbC5ycGMudjEuTm9kZVBvc2l0aW9uEjMKBWdyYXBoGAMgASgLMiQuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVzcG9uc2UikwMKDUdyYXBoTWV0YWRhdGESEAoIZ3JhcGhfaWQYASABKAkSKQoEa2luZBgCIAEoDjIbLmludGVybmFsLnJwYy52MS5SZWNvcmRLaW5kEhIKCmNyZWF0ZWRfYXQYAyABKAMSEgoKdXBkYXRlZF9hdBgEIAEoAxIRCgludW1fbm9kZXMYBSABKAMSEQoJbnVtX2VkZ2VzGAYgASgDEjMKBnBhcmFtcxgHIAEoCzIjLmludGVybmFsLnJwYy52MS5SYW5kb21HcmFwaFJlcXVlc3QSEQoJZmlsZV9uYW1lGAggASgJEjgKC3dhbGtfcGFyYW1zGAkgASgLMiMuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVxdWVzdBI6Cg1sYXlvdXRfcGFyYW1zGAogASgLMiMuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVxdWVzdBI5CgpleHBlcmltZW50GAsgASgLMiUuaW50ZXJuYWwucnBjLnYxLlJ1bkV4cGVyaW1lbnRSZXF1ZXN0IuEBCgtHcmFwaFJlY29yZBIwCghtZXRhZGF0YRgBIAEoCzIeLmludGVybmFsLnJwYy52MS5HcmFwaE1ldGFkYXRhEjIKBGJhc2UYAiABKAsyJC5pbnRlcm5hbC5ycGMudjEuUmFuZG9tR3JhcGhSZXNwb25zZRIzCgVncmFwaBgDIAEoCzIkLmludGVybmFsLnJwYy52MS5SYW5kb21HcmFwaFJlc3BvbnNlEjcKB3Jlc3VsdHMYBCABKAsyJi5pbnRlcm5hbC5ycGMudjEuUnVuRXhwZXJpbWVudFJlc3BvbnNlIj4KEUxpc3RHcmFwaHNSZXF1ZXN0EikKBGtpbmQYASABKA4yGy5pbnRlcm5hbC5ycGMudjEuUmVjb3JkS2luZCJEChJMaXN0R3JhcGhzUmVzcG9uc2USLgoGZ3JhcGhzGAEgAygLMh4uaW50ZXJuYWwucnBjLnYxLkdyYXBoTWV0YWRhdGEiIwoPR2V0R3JhcGhSZXF1ZXN0EhAKCGdyYXBoX2lkGAEgASgJIrIBChBHZXRHcmFwaFJlc3BvbnNlEjMKBWdyYXBoGAEgASgLMiQuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVzcG9uc2USMAoIbWV0YWRhdGEYAiABKAsyHi5pbnRlcm5hbC5ycGMudjEuR3JhcGhNZXRhZGF0YRI3CgdyZXN1bHRzGAMgASgLMiYuaW50ZXJuYWwucnBjLnYxLlJ1bkV4cGVyaW1lbnRSZXNwb25zZSImChJEZWxldGVHcmFwaFJlcXVlc3QSEAoIZ3JhcGhfaWQYASABKAkiFQoTRGVsZXRlR3JhcGhSZXNwb25zZSJGCg5GaWVsZFZpb2xhdGlvbhISCgpmaWVsZF9wYXRoGAEgASgJEg8KB3J1bGVfaWQYAiABKAkSDwoHbWVzc2FnZRgDIAEoCSJBCgpWaW9sYXRpb25zEjMKCnZpb2xhdGlvbnMYASADKAsyHy5pbnRlcm5hbC5ycGMudjEuRmllbGRWaW9sYXRpb24qPAoFUGFydHkSFQoRUEFSVFlfVU5TUEVDSUZJRUQQABINCglQQVJUWV9CT0IQARINCglQQVJUWV9BREEQAip0CghXYWxrTW9kZRIZChVXQUxLX01PREVfVU5TUEVDSUZJRUQQABIZChVXQUxLX01PREVfUkFORE9NX1dBTEsQARIaChZXQUxLX01PREVfUkFORE9NX1JPVVRFEAISFgoSV0FMS19NT0RFX1dFSUdIVEVEEAMqwQEKEldlaWdodERpc3RyaWJ1dGlvbhIjCh9XRUlHSFRfRElTVFJJQlVUSU9OX1VOU1BFQ0lGSUVEEAASHwobV0VJR0hUX0RJU1RSSUJVVElPTl9VTklGT1JNEAESIwofV0VJR0hUX0RJU1RSSUJVVElPTl9FWFBPTkVOVElBTBACEiIKHldFSUdIVF9ESVNUUklCVVRJT05fTE9HX05PUk1BTBADEhwKGFdFSUdIVF9ESVNUUklCVVRJT05fQkVUQRAEKnwKElJlcHVsc2lvbkFsZ29yaXRobRIjCh9SRVBVTFNJT05fQUxHT1JJVEhNX1VOU1BFQ0lGSUVEEAASHQoZUkVQVUxTSU9OX0FMR09SSVRITV9FWEFDVBABEiIKHlJFUFVMU0lPTl9BTEdPUklUSE1fQkFSTkVTX0hVVBACKpEBCg9Db29saW5nU2NoZWR1bGUSIAocQ09PTElOR19TQ0hFRFVMRV9VTlNQRUNJRklFRBAAEhsKF0NPT0xJTkdfU0NIRURVTEVfTElORUFSEAESIAocQ09PTElOR19TQ0hFRFVMRV9FWFBPTkVOVElBTBACEh0KGUNPT0xJTkdfU0NIRURVTEVfQURBUFRJVkUQAyqlAQoLR3JhcGhGb3JtYXQSHAoYR1JBUEhfRk9STUFUX1VOU1BFQ0lGSUVEEAASGgoWR1JBUEhfRk9STUFUX0VER0VfTElTVBABEhgKFEdSQVBIX0ZPUk1BVF9HUkFQSE1MEAISFQoRR1JBUEhfRk9STUFUX0dFWEYQAxIUChBHUkFQSF9GT1JNQVRfRE9UEAQSFQoRR1JBUEhfRk9STUFUX0pTT04QBSp6Cg5QYWdlUmFua01ldGhvZBIgChxQQUdFX1JBTktfTUVUSE9EX1VOU1BFQ0lGSUVEEAASJAogUEFHRV9SQU5LX01FVEhPRF9QT1dFUl9JVEVSQVRJT04QARIgChxQQUdFX1JBTktfTUVUSE9EX01PTlRFX0NBUkxPEAIqXgoMRmxvd0NhcGFjaXR5Eh0KGUZMT1dfQ0FQQUNJVFlfVU5TUEVDSUZJRUQQABIWChJGTE9XX0NBUEFDSVRZX1VOSVQQARIXChNGTE9XX0NBUEFDSVRZX1RSVVNUEAIqTgoIUGF0aENvc3QSGQoVUEFUSF9DT1NUX1VOU1BFQ0lGSUVEEAASEgoOUEFUSF9DT1NUX0hPUFMQARITCg9QQVRIX0NPU1RfVFJVU1QQAip6CgpSZWNvcmRLaW5kEhsKF1JFQ09SRF9LSU5EX1VOU1BFQ0lGSUVEEAASGQoVUkVDT1JEX0tJTkRfR0VORVJBVEVEEAESGAoUUkVDT1JEX0tJTkRfSU1QT1JURUQQAhIaChZSRUNPUkRfS0lORF9FWFBFUklNRU5UEAMyhQsKDEdyYXBoU2VydmljZRJYCgtSYW5kb21HcmFwaBIjLmludGVybmFsLnJwYy52MS5SYW5kb21HcmFwaFJlcXVlc3QaJC5pbnRlcm5hbC5ycGMudjEuUmFuZG9tR3JhcGhSZXNwb25zZRJSCglMb2FkR3JhcGgSIS5pbnRlcm5hbC5ycGMudjEuTG9hZEdyYXBoUmVxdWVzdBoiLmludGVybmFsLnJwYy52MS5Mb2FkR3JhcGhSZXNwb25zZRJYCgtFeHBvcnRHcmFwaBIjLmludGVybmFsLnJwYy52MS5FeHBvcnRHcmFwaFJlcXVlc3QaJC5pbnRlcm5hbC5ycGMudjEuRXhwb3J0R3JhcGhSZXNwb25zZRJYCgtDcmVhdGVHcmFwaBIjLmludGVybmFsLnJwYy52MS5DcmVhdGVHcmFwaFJlcXVlc3QaJC5pbnRlcm5hbC5ycGMudjEuQ3JlYXRlR3JhcGhSZXNwb25zZRJPCghSdW5XYWxrcxIgLmludGVybmFsLnJwYy52MS5SdW5XYWxrc1JlcXVlc3QaIS5pbnRlcm5hbC5ycGMudjEuUnVuV2Fsa3NSZXNwb25zZRJPCghSZWxheW91dBIgLmludGVybmFsLnJwYy52MS5SZWxheW91dFJlcXVlc3QaIS5pbnRlcm5hbC5ycGMudjEuUmVsYXlvdXRSZXNwb25zZRJdCgxTdHJlYW1MYXlvdXQSJC5pbnRlcm5hbC5ycGMudjEuU3RyZWFtTGF5b3V0UmVxdWVzdBolLmludGVybmFsLnJwYy52MS5TdHJlYW1MYXlvdXRSZXNwb25zZTABElUKCkxpc3RHcmFwaHMSIi5pbnRlcm5hbC5ycGMudjEuTGlzdEdyYXBoc1JlcXVlc3QaIy5pbnRlcm5hbC5ycGMudjEuTGlzdEdyYXBoc1Jlc3BvbnNlEk8KCEdldEdyYXBoEiAuaW50ZXJuYWwucnBjLnYxLkdldEdyYXBoUmVxdWVzdBohLmludGVybmFsLnJwYy52MS5HZXRHcmFwaFJlc3BvbnNlElgKC0RlbGV0ZUdyYXBoEiMuaW50ZXJuYWwucnBjLnYxLkRlbGV0ZUdyYXBoUmVxdWVzdBokLmludGVybmFsLnJwYy52MS5EZWxldGVHcmFwaFJlc3BvbnNlElsKDENvbXB1dGVUcnVzdBIkLmludGVybmFsLnJwYy52MS5Db21wdXRlVHJ1c3RSZXF1ZXN0GiUuaW50ZXJuYWwucnBjLnYxLkNvbXB1dGVUcnVzdFJlc3BvbnNlEkwKB01heEZsb3cSHy5pbnRlcm5hbC5ycGMudjEuTWF4Rmxvd1JlcXVlc3QaIC5pbnRlcm5hbC5ycGMudjEuTWF4Rmxvd1Jlc3BvbnNlEl4KDVNob3J0ZXN0UGF0aHMSJS5pbnRlcm5hbC5ycGMudjEuU2hvcnRlc3RQYXRoc1JlcXVlc3QaJi5pbnRlcm5hbC5ycGMudjEuU2hvcnRlc3RQYXRoc1Jlc3BvbnNlElUKCkdyYXBoU3RhdHMSIi5pbnRlcm5hbC5ycGMudjEuR3JhcGhTdGF0c1JlcXVlc3QaIy5pbnRlcm5hbC5ycGMudjEuR3JhcGhTdGF0c1Jlc3BvbnNlEl4KDVJ1bkV4cGVyaW1lbnQSJS5pbnRlcm5hbC5ycGMudjEuUnVuRXhwZXJpbWVudFJlcXVlc3QaJi5pbnRlcm5hbC5ycGMudjEuUnVuRXhwZXJpbWVudFJlc3BvbnNlEk4KBlJlcGxheRIeLmludGVybmFsLnJwYy52MS5SZXBsYXlSZXF1ZXN0GiQuaW50ZXJuYWwucnBjLnYxLlJhbmRvbUdyYXBoUmVzcG9uc2VCrAEKE2NvbS5pbnRlcm5hbC5ycGMudjFCCFJwY1Byb3RvUAFaLWdpdGh1Yi5jb20vYWR2ZHYvdHJ1c3RkL2ludGVybmFsL3JwYy92MTtycGN2MaICA0lSWKoCD0ludGVybmFsLlJwYy5WMcoCD0ludGVybmFsXFJwY1xWMeICG0ludGVybmFsXFJwY1xWMVxHUEJNZXRhZGF0YeoCEUludGVybmFsOjpScGM6OlYxYghlZGl0aW9uc3DoBw", [file_buf_validate_validate]);
 
 /**
  * @generated from message internal.rpc.v1.Position
@@ -106,6 +107,13 @@ export type Edge = Message<"internal.rpc.v1.Edge"> & {
    * @generated from field: string type = 4;
    */
   type: string;
+
+  /**
+   * weight is the trust score of the edge, weighted walks treat edges without a weight as weight 1.
+   *
+   * @generated from field: double weight = 5;
+   */
+  weight: number;
 };
 
 /**
@@ -116,104 +124,2970 @@ export const EdgeSchema: GenMessage<Edge> = /*@__PURE__*/
   messageDesc(file_internal_rpc_v1_rpc, 3);
 
 /**
- * @generated from message internal.rpc.v1.RandomGraphRequest
+ * Walk describes a single random walk over the graph.
+ *
+ * @generated from message internal.rpc.v1.Walk
  */
-export type RandomGraphRequest = Message<"internal.rpc.v1.RandomGraphRequest"> & {
+export type Walk = Message<"internal.rpc.v1.Walk"> & {
   /**
-   * @generated from field: uint64 seed1 = 1;
+   * owner is the party that performed the walk.
+   *
+   * @generated from field: internal.rpc.v1.Party owner = 1;
    */
-  seed1: bigint;
+  owner: Party;
 
   /**
-   * @generated from field: uint64 seed2 = 2;
+   * start_node is the id of the node the walk started from.
+   *
+   * @generated from field: string start_node = 2;
    */
-  seed2: bigint;
+  startNode: string;
 
   /**
-   * @generated from field: int64 num_nodes = 3;
+   * node_ids holds the visited nodes in order, starting with the start node.
+   *
+   * @generated from field: repeated string node_ids = 3;
+   */
+  nodeIds: string[];
+
+  /**
+   * edge_ids holds the traversed edges in order, edge i connects node i and i+1. It is empty when a lazy
+   * walk stayed at node i.
+   *
+   * @generated from field: repeated string edge_ids = 4;
+   */
+  edgeIds: string[];
+
+  /**
+   * instance is the index of the walk among the walks of its owner. Random routes of the same
+   * instance share their routing tables.
+   *
+   * @generated from field: int64 instance = 5;
+   */
+  instance: bigint;
+
+  /**
+   * escaped is set when the walk entered the Sybil region.
+   *
+   * @generated from field: bool escaped = 6;
+   */
+  escaped: boolean;
+
+  /**
+   * escape_step is the step at which the walk first entered the Sybil region.
+   *
+   * @generated from field: int64 escape_step = 7;
+   */
+  escapeStep: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.Walk.
+ * Use `create(WalkSchema)` to create a new message.
+ */
+export const WalkSchema: GenMessage<Walk> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 4);
+
+/**
+ * WalkIntersection describes where a walk of Bob first meets a walk of Ada.
+ *
+ * @generated from message internal.rpc.v1.WalkIntersection
+ */
+export type WalkIntersection = Message<"internal.rpc.v1.WalkIntersection"> & {
+  /**
+   * bob_walk is the index of Bob's walk in the response's walks.
+   *
+   * @generated from field: int64 bob_walk = 1;
+   */
+  bobWalk: bigint;
+
+  /**
+   * ada_walk is the index of Ada's walk in the response's walks.
+   *
+   * @generated from field: int64 ada_walk = 2;
+   */
+  adaWalk: bigint;
+
+  /**
+   * node_id is the node where the walks meet.
+   *
+   * @generated from field: string node_id = 3;
+   */
+  nodeId: string;
+
+  /**
+   * edge_id is set when both walks entered the meeting node over the same edge.
+   *
+   * @generated from field: string edge_id = 4;
+   */
+  edgeId: string;
+
+  /**
+   * bob_step is the step at which Bob's walk reached the meeting node.
+   *
+   * @generated from field: int64 bob_step = 5;
+   */
+  bobStep: bigint;
+
+  /**
+   * ada_step is the step at which Ada's walk reached the meeting node.
+   *
+   * @generated from field: int64 ada_step = 6;
+   */
+  adaStep: bigint;
+
+  /**
+   * path_node_ids is the combined trust path from Bob, through the meeting node, to Ada.
+   *
+   * @generated from field: repeated string path_node_ids = 7;
+   */
+  pathNodeIds: string[];
+
+  /**
+   * path_edge_ids holds the edges of the combined trust path in order.
+   *
+   * @generated from field: repeated string path_edge_ids = 8;
+   */
+  pathEdgeIds: string[];
+};
+
+/**
+ * Describes the message internal.rpc.v1.WalkIntersection.
+ * Use `create(WalkIntersectionSchema)` to create a new message.
+ */
+export const WalkIntersectionSchema: GenMessage<WalkIntersection> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 5);
+
+/**
+ * WattsStrogatzParams configures a Watts–Strogatz small-world graph.
+ *
+ * @generated from message internal.rpc.v1.WattsStrogatzParams
+ */
+export type WattsStrogatzParams = Message<"internal.rpc.v1.WattsStrogatzParams"> & {
+  /**
+   * @generated from field: int64 num_nodes = 1;
    */
   numNodes: bigint;
 
   /**
-   * @generated from field: int64 initial_connected = 4;
+   * initial_connected is the number of ring neighbors each node starts out with.
+   *
+   * @generated from field: int64 initial_connected = 2;
    */
   initialConnected: bigint;
 
   /**
-   * @generated from field: double rewiring_probability = 5;
+   * @generated from field: double rewiring_probability = 3;
    */
   rewiringProbability: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.WattsStrogatzParams.
+ * Use `create(WattsStrogatzParamsSchema)` to create a new message.
+ */
+export const WattsStrogatzParamsSchema: GenMessage<WattsStrogatzParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 6);
 
+/**
+ * ErdosRenyiParams configures a G(n, p) Erdős–Rényi graph.
+ *
+ * @generated from message internal.rpc.v1.ErdosRenyiParams
+ */
+export type ErdosRenyiParams = Message<"internal.rpc.v1.ErdosRenyiParams"> & {
   /**
-   * @generated from field: int64 layout_iterations = 6;
+   * @generated from field: int64 num_nodes = 1;
    */
-  layoutIterations: bigint;
+  numNodes: bigint;
 
   /**
-   * @generated from field: double layout_area = 7;
+   * @generated from field: double edge_probability = 2;
    */
-  layoutArea: number;
+  edgeProbability: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ErdosRenyiParams.
+ * Use `create(ErdosRenyiParamsSchema)` to create a new message.
+ */
+export const ErdosRenyiParamsSchema: GenMessage<ErdosRenyiParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 7);
 
+/**
+ * BarabasiAlbertParams configures a Barabási–Albert preferential attachment graph.
+ *
+ * @generated from message internal.rpc.v1.BarabasiAlbertParams
+ */
+export type BarabasiAlbertParams = Message<"internal.rpc.v1.BarabasiAlbertParams"> & {
   /**
-   * @generated from field: int64 walk_length = 8;
+   * @generated from field: int64 num_nodes = 1;
    */
-  walkLength: bigint;
+  numNodes: bigint;
 
   /**
-   * @generated from field: int64 num_walks = 9;
+   * edges_per_node is the number of edges each new node attaches with.
+   *
+   * @generated from field: int64 edges_per_node = 2;
    */
-  numWalks: bigint;
+  edgesPerNode: bigint;
+};
 
+/**
+ * Describes the message internal.rpc.v1.BarabasiAlbertParams.
+ * Use `create(BarabasiAlbertParamsSchema)` to create a new message.
+ */
+export const BarabasiAlbertParamsSchema: GenMessage<BarabasiAlbertParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 8);
+
+/**
+ * StochasticBlockParams configures a stochastic block model with uniform in- and out-block probabilities.
+ *
+ * @generated from message internal.rpc.v1.StochasticBlockParams
+ */
+export type StochasticBlockParams = Message<"internal.rpc.v1.StochasticBlockParams"> & {
   /**
-   * @generated from field: uint64 seed3 = 10;
+   * @generated from field: repeated int64 block_sizes = 1;
    */
-  seed3: bigint;
+  blockSizes: bigint[];
 
   /**
-   * @generated from field: uint64 seed4 = 11;
+   * p_in is the edge probability between nodes of the same block.
+   *
+   * @generated from field: double p_in = 2;
    */
-  seed4: bigint;
+  pIn: number;
+
+  /**
+   * p_out is the edge probability between nodes of different blocks.
+   *
+   * @generated from field: double p_out = 3;
+   */
+  pOut: number;
 };
 
 /**
- * Describes the message internal.rpc.v1.RandomGraphRequest.
- * Use `create(RandomGraphRequestSchema)` to create a new message.
+ * Describes the message internal.rpc.v1.StochasticBlockParams.
+ * Use `create(StochasticBlockParamsSchema)` to create a new message.
  */
-export const RandomGraphRequestSchema: GenMessage<RandomGraphRequest> = /*@__PURE__*/
-  messageDesc(file_internal_rpc_v1_rpc, 4);
+export const StochasticBlockParamsSchema: GenMessage<StochasticBlockParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 9);
 
 /**
- * @generated from message internal.rpc.v1.RandomGraphResponse
+ * RandomRegularParams configures a random graph where every node has the same degree.
+ *
+ * @generated from message internal.rpc.v1.RandomRegularParams
  */
-export type RandomGraphResponse = Message<"internal.rpc.v1.RandomGraphResponse"> & {
+export type RandomRegularParams = Message<"internal.rpc.v1.RandomRegularParams"> & {
   /**
-   * @generated from field: repeated internal.rpc.v1.Node nodes = 1;
+   * @generated from field: int64 num_nodes = 1;
    */
-  nodes: Node[];
+  numNodes: bigint;
 
   /**
-   * @generated from field: repeated internal.rpc.v1.Edge edges = 2;
+   * @generated from field: int64 degree = 2;
    */
-  edges: Edge[];
+  degree: bigint;
 };
 
 /**
- * Describes the message internal.rpc.v1.RandomGraphResponse.
- * Use `create(RandomGraphResponseSchema)` to create a new message.
+ * Describes the message internal.rpc.v1.RandomRegularParams.
+ * Use `create(RandomRegularParamsSchema)` to create a new message.
  */
-export const RandomGraphResponseSchema: GenMessage<RandomGraphResponse> = /*@__PURE__*/
-  messageDesc(file_internal_rpc_v1_rpc, 5);
+export const RandomRegularParamsSchema: GenMessage<RandomRegularParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 10);
 
 /**
- * @generated from service internal.rpc.v1.GraphService
+ * LatticeParams configures a 2D grid graph.
+ *
+ * @generated from message internal.rpc.v1.LatticeParams
  */
-export const GraphService: GenService<{
+export type LatticeParams = Message<"internal.rpc.v1.LatticeParams"> & {
   /**
-   * @generated from rpc internal.rpc.v1.GraphService.RandomGraph
+   * @generated from field: int64 rows = 1;
    */
-  randomGraph: {
-    methodKind: "unary";
-    input: typeof RandomGraphRequestSchema;
+  rows: bigint;
+
+  /**
+   * @generated from field: int64 columns = 2;
+   */
+  columns: bigint;
+
+  /**
+   * periodic wraps the grid around into a torus.
+   *
+   * @generated from field: bool periodic = 3;
+   */
+  periodic: boolean;
+};
+
+/**
+ * Describes the message internal.rpc.v1.LatticeParams.
+ * Use `create(LatticeParamsSchema)` to create a new message.
+ */
+export const LatticeParamsSchema: GenMessage<LatticeParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 11);
+
+/**
+ * EdgeWeights configures the weights that are assigned to the edges of the graph that don't have one.
+ *
+ * @generated from message internal.rpc.v1.EdgeWeights
+ */
+export type EdgeWeights = Message<"internal.rpc.v1.EdgeWeights"> & {
+  /**
+   * @generated from field: internal.rpc.v1.WeightDistribution distribution = 1;
+   */
+  distribution: WeightDistribution;
+
+  /**
+   * min defaults to 0.
+   *
+   * @generated from field: double min = 2;
+   */
+  min: number;
+
+  /**
+   * max defaults to 1.
+   *
+   * @generated from field: double max = 3;
+   */
+  max: number;
+
+  /**
+   * mean defaults to 1.
+   *
+   * @generated from field: double mean = 4;
+   */
+  mean: number;
+
+  /**
+   * mu defaults to 0.
+   *
+   * @generated from field: double mu = 5;
+   */
+  mu: number;
+
+  /**
+   * sigma defaults to 1.
+   *
+   * @generated from field: double sigma = 6;
+   */
+  sigma: number;
+
+  /**
+   * alpha defaults to 1.
+   *
+   * @generated from field: double alpha = 7;
+   */
+  alpha: number;
+
+  /**
+   * beta defaults to 1.
+   *
+   * @generated from field: double beta = 8;
+   */
+  beta: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.EdgeWeights.
+ * Use `create(EdgeWeightsSchema)` to create a new message.
+ */
+export const EdgeWeightsSchema: GenMessage<EdgeWeights> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 12);
+
+/**
+ * SybilRegion configures a region of Sybil nodes that is attached to the honest graph.
+ *
+ * @generated from message internal.rpc.v1.SybilRegion
+ */
+export type SybilRegion = Message<"internal.rpc.v1.SybilRegion"> & {
+  /**
+   * num_nodes is the number of Sybil nodes, no region is attached when it is zero.
+   *
+   * @generated from field: int64 num_nodes = 1;
+   */
+  numNodes: bigint;
+
+  /**
+   * initial_connected configures the Watts–Strogatz topology inside the region.
+   *
+   * @generated from field: int64 initial_connected = 2;
+   */
+  initialConnected: bigint;
+
+  /**
+   * rewiring_probability configures the Watts–Strogatz topology inside the region.
+   *
+   * @generated from field: double rewiring_probability = 3;
+   */
+  rewiringProbability: number;
+
+  /**
+   * attack_edges is the number of edges between the Sybil region and the honest region, at most the
+   * configured maximum number of nodes.
+   *
+   * @generated from field: int64 attack_edges = 4;
+   */
+  attackEdges: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.SybilRegion.
+ * Use `create(SybilRegionSchema)` to create a new message.
+ */
+export const SybilRegionSchema: GenMessage<SybilRegion> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 13);
+
+/**
+ * ForceDirectedParams configures the Fruchterman–Reingold force-directed layout.
+ *
+ * @generated from message internal.rpc.v1.ForceDirectedParams
+ */
+export type ForceDirectedParams = Message<"internal.rpc.v1.ForceDirectedParams"> & {
+  /**
+   * @generated from field: int64 iterations = 1;
+   */
+  iterations: bigint;
+
+  /**
+   * @generated from field: double area = 2;
+   */
+  area: number;
+
+  /**
+   * @generated from field: internal.rpc.v1.RepulsionAlgorithm repulsion = 3;
+   */
+  repulsion: RepulsionAlgorithm;
+
+  /**
+   * theta is the Barnes–Hut opening angle, lower is more accurate. Defaults to 0.8.
+   *
+   * @generated from field: double theta = 4;
+   */
+  theta: number;
+
+  /**
+   * @generated from field: internal.rpc.v1.CoolingSchedule cooling = 5;
+   */
+  cooling: CoolingSchedule;
+
+  /**
+   * tolerance stops the layout early once the nodes move less than this fraction of the ideal edge length
+   * on average. Zero runs all iterations.
+   *
+   * @generated from field: double tolerance = 6;
+   */
+  tolerance: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ForceDirectedParams.
+ * Use `create(ForceDirectedParamsSchema)` to create a new message.
+ */
+export const ForceDirectedParamsSchema: GenMessage<ForceDirectedParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 14);
+
+/**
+ * CircularParams configures a layout that places the nodes on a circle, in the order of the graph.
+ *
+ * @generated from message internal.rpc.v1.CircularParams
+ */
+export type CircularParams = Message<"internal.rpc.v1.CircularParams"> & {
+  /**
+   * radius of the circle, defaults to 300.
+   *
+   * @generated from field: double radius = 1;
+   */
+  radius: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.CircularParams.
+ * Use `create(CircularParamsSchema)` to create a new message.
+ */
+export const CircularParamsSchema: GenMessage<CircularParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 15);
+
+/**
+ * SpectralParams configures a layout that positions the nodes by the eigenvectors of the graph Laplacian
+ * with the two smallest non-zero eigenvalues.
+ *
+ * @generated from message internal.rpc.v1.SpectralParams
+ */
+export type SpectralParams = Message<"internal.rpc.v1.SpectralParams"> & {
+  /**
+   * scale is the largest coordinate of the layout, defaults to 300.
+   *
+   * @generated from field: double scale = 1;
+   */
+  scale: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.SpectralParams.
+ * Use `create(SpectralParamsSchema)` to create a new message.
+ */
+export const SpectralParamsSchema: GenMessage<SpectralParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 16);
+
+/**
+ * KamadaKawaiParams configures a layout that minimizes the Kamada–Kawai stress: the difference between
+ * the distance of the nodes in the layout and their shortest path distance in the graph.
+ *
+ * @generated from message internal.rpc.v1.KamadaKawaiParams
+ */
+export type KamadaKawaiParams = Message<"internal.rpc.v1.KamadaKawaiParams"> & {
+  /**
+   * iterations is the maximum number of stress majorization iterations, defaults to 100.
+   *
+   * @generated from field: int64 iterations = 1;
+   */
+  iterations: bigint;
+
+  /**
+   * edge_length is the ideal length of an edge, defaults to 50.
+   *
+   * @generated from field: double edge_length = 2;
+   */
+  edgeLength: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.KamadaKawaiParams.
+ * Use `create(KamadaKawaiParamsSchema)` to create a new message.
+ */
+export const KamadaKawaiParamsSchema: GenMessage<KamadaKawaiParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 17);
+
+/**
+ * HierarchicalParams configures a layout that places the nodes in layers by their breadth-first distance
+ * from Bob.
+ *
+ * @generated from message internal.rpc.v1.HierarchicalParams
+ */
+export type HierarchicalParams = Message<"internal.rpc.v1.HierarchicalParams"> & {
+  /**
+   * layer_spacing is the vertical distance between layers, defaults to 100.
+   *
+   * @generated from field: double layer_spacing = 1;
+   */
+  layerSpacing: number;
+
+  /**
+   * node_spacing is the horizontal distance between nodes in a layer, defaults to 50.
+   *
+   * @generated from field: double node_spacing = 2;
+   */
+  nodeSpacing: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.HierarchicalParams.
+ * Use `create(HierarchicalParamsSchema)` to create a new message.
+ */
+export const HierarchicalParamsSchema: GenMessage<HierarchicalParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 18);
+
+/**
+ * RandomGraphRequest generates a graph and walks it. Besides the buf.validate rules of the fields,
+ * Watts–Strogatz graphs need more nodes than initial_connected and the server caps the number of nodes,
+ * the iterations and the walk steps. Invalid requests fail with an invalid argument error that carries a
+ * Violations detail.
+ *
+ * @generated from message internal.rpc.v1.RandomGraphRequest
+ */
+export type RandomGraphRequest = Message<"internal.rpc.v1.RandomGraphRequest"> & {
+  /**
+   * @generated from field: uint64 seed1 = 1;
+   */
+  seed1: bigint;
+
+  /**
+   * @generated from field: uint64 seed2 = 2;
+   */
+  seed2: bigint;
+
+  /**
+   * @generated from field: int64 num_nodes = 3;
+   */
+  numNodes: bigint;
+
+  /**
+   * @generated from field: int64 initial_connected = 4;
+   */
+  initialConnected: bigint;
+
+  /**
+   * @generated from field: double rewiring_probability = 5;
+   */
+  rewiringProbability: number;
+
+  /**
+   * @generated from field: int64 layout_iterations = 6;
+   */
+  layoutIterations: bigint;
+
+  /**
+   * @generated from field: double layout_area = 7;
+   */
+  layoutArea: number;
+
+  /**
+   * @generated from field: int64 walk_length = 8;
+   */
+  walkLength: bigint;
+
+  /**
+   * @generated from field: int64 num_walks = 9;
+   */
+  numWalks: bigint;
+
+  /**
+   * @generated from field: uint64 seed3 = 10;
+   */
+  seed3: bigint;
+
+  /**
+   * @generated from field: uint64 seed4 = 11;
+   */
+  seed4: bigint;
+
+  /**
+   * @generated from field: internal.rpc.v1.WalkMode walk_mode = 12;
+   */
+  walkMode: WalkMode;
+
+  /**
+   * @generated from field: internal.rpc.v1.SybilRegion sybil_region = 13;
+   */
+  sybilRegion?: SybilRegion;
+
+  /**
+   * generator selects the graph model, when unset a Watts–Strogatz graph is generated from
+   * num_nodes, initial_connected and rewiring_probability.
+   *
+   * @generated from oneof internal.rpc.v1.RandomGraphRequest.generator
+   */
+  generator: {
+    /**
+     * @generated from field: internal.rpc.v1.WattsStrogatzParams watts_strogatz = 14;
+     */
+    value: WattsStrogatzParams;
+    case: "wattsStrogatz";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.ErdosRenyiParams erdos_renyi = 15;
+     */
+    value: ErdosRenyiParams;
+    case: "erdosRenyi";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.BarabasiAlbertParams barabasi_albert = 16;
+     */
+    value: BarabasiAlbertParams;
+    case: "barabasiAlbert";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.StochasticBlockParams stochastic_block = 17;
+     */
+    value: StochasticBlockParams;
+    case: "stochasticBlock";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.RandomRegularParams random_regular = 18;
+     */
+    value: RandomRegularParams;
+    case: "randomRegular";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.LatticeParams lattice = 19;
+     */
+    value: LatticeParams;
+    case: "lattice";
+  } | {
+    /**
+     * graph_id uses a stored graph. The Sybil region, orientation and edge weights of the request only
+     * apply to imported graphs, generated graphs keep those of the request that created them.
+     *
+     * @generated from field: string graph_id = 20;
+     */
+    value: string;
+    case: "graphId";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * @generated from field: internal.rpc.v1.RepulsionAlgorithm layout_repulsion = 21;
+   */
+  layoutRepulsion: RepulsionAlgorithm;
+
+  /**
+   * layout_theta is the Barnes–Hut opening angle, lower is more accurate. Defaults to 0.8.
+   *
+   * @generated from field: double layout_theta = 22;
+   */
+  layoutTheta: number;
+
+  /**
+   * @generated from field: internal.rpc.v1.CoolingSchedule layout_cooling = 23;
+   */
+  layoutCooling: CoolingSchedule;
+
+  /**
+   * layout_tolerance stops the layout early once the nodes move less than this fraction of the ideal edge
+   * length on average. Zero runs all iterations.
+   *
+   * @generated from field: double layout_tolerance = 24;
+   */
+  layoutTolerance: number;
+
+  /**
+   * layout selects the layout engine, when unset a force-directed layout is configured from the
+   * top-level layout fields.
+   *
+   * @generated from oneof internal.rpc.v1.RandomGraphRequest.layout
+   */
+  layout: {
+    /**
+     * @generated from field: internal.rpc.v1.ForceDirectedParams force_directed = 25;
+     */
+    value: ForceDirectedParams;
+    case: "forceDirected";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.CircularParams circular = 26;
+     */
+    value: CircularParams;
+    case: "circular";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.SpectralParams spectral = 27;
+     */
+    value: SpectralParams;
+    case: "spectral";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.KamadaKawaiParams kamada_kawai = 28;
+     */
+    value: KamadaKawaiParams;
+    case: "kamadaKawai";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.HierarchicalParams hierarchical = 29;
+     */
+    value: HierarchicalParams;
+    case: "hierarchical";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * edge_weights assigns weights to the edges, including those of the Sybil region.
+   *
+   * @generated from field: internal.rpc.v1.EdgeWeights edge_weights = 30;
+   */
+  edgeWeights?: EdgeWeights;
+
+  /**
+   * laziness is the probability that a weighted walk stays at its node for a step.
+   *
+   * @generated from field: double laziness = 31;
+   */
+  laziness: number;
+
+  /**
+   * directed orients the edges of the graph, walks then only follow outgoing edges.
+   *
+   * @generated from field: bool directed = 32;
+   */
+  directed: boolean;
+
+  /**
+   * reciprocity is the probability that an edge of a directed graph is reciprocated by an edge in the
+   * opposite direction, otherwise the edge points in a random direction.
+   *
+   * @generated from field: double reciprocity = 33;
+   */
+  reciprocity: number;
+
+  /**
+   * include_stats reports the structure of the graph in the response.
+   *
+   * @generated from field: bool include_stats = 34;
+   */
+  includeStats: boolean;
+};
+
+/**
+ * Describes the message internal.rpc.v1.RandomGraphRequest.
+ * Use `create(RandomGraphRequestSchema)` to create a new message.
+ */
+export const RandomGraphRequestSchema: GenMessage<RandomGraphRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 19);
+
+/**
+ * LayoutStats reports how an iterative layout went, it is only set by the force-directed and Kamada–Kawai
+ * layouts.
+ *
+ * @generated from message internal.rpc.v1.LayoutStats
+ */
+export type LayoutStats = Message<"internal.rpc.v1.LayoutStats"> & {
+  /**
+   * iterations is the number of iterations that were run.
+   *
+   * @generated from field: int64 iterations = 1;
+   */
+  iterations: bigint;
+
+  /**
+   * energy is the sum of the squared forces on the nodes in the last iteration, or the stress for the
+   * Kamada–Kawai layout.
+   *
+   * @generated from field: double energy = 2;
+   */
+  energy: number;
+
+  /**
+   * converged is true when the layout stopped early because the nodes settled.
+   *
+   * @generated from field: bool converged = 3;
+   */
+  converged: boolean;
+};
+
+/**
+ * Describes the message internal.rpc.v1.LayoutStats.
+ * Use `create(LayoutStatsSchema)` to create a new message.
+ */
+export const LayoutStatsSchema: GenMessage<LayoutStats> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 20);
+
+/**
+ * @generated from message internal.rpc.v1.RandomGraphResponse
+ */
+export type RandomGraphResponse = Message<"internal.rpc.v1.RandomGraphResponse"> & {
+  /**
+   * @generated from field: repeated internal.rpc.v1.Node nodes = 1;
+   */
+  nodes: Node[];
+
+  /**
+   * @generated from field: repeated internal.rpc.v1.Edge edges = 2;
+   */
+  edges: Edge[];
+
+  /**
+   * @generated from field: repeated internal.rpc.v1.Walk walks = 3;
+   */
+  walks: Walk[];
+
+  /**
+   * @generated from field: internal.rpc.v1.WalkIntersection intersection = 4;
+   */
+  intersection?: WalkIntersection;
+
+  /**
+   * @generated from field: internal.rpc.v1.LayoutStats layout = 5;
+   */
+  layout?: LayoutStats;
+
+  /**
+   * directed is set when the edges point from their source to their target.
+   *
+   * @generated from field: bool directed = 6;
+   */
+  directed: boolean;
+
+  /**
+   * stats describes the structure of the graph, if requested.
+   *
+   * @generated from field: internal.rpc.v1.GraphStats stats = 7;
+   */
+  stats?: GraphStats;
+
+  /**
+   * manifest records how the graph was generated, so Replay can generate it again. Imported graphs have
+   * none.
+   *
+   * @generated from field: internal.rpc.v1.GraphManifest manifest = 8;
+   */
+  manifest?: GraphManifest;
+
+  /**
+   * bob_node_id and ada_node_id identify the parties. Their nodes keep the bobNode and adaNode types,
+   * but the types are for display only.
+   *
+   * @generated from field: string bob_node_id = 9;
+   */
+  bobNodeId: string;
+
+  /**
+   * @generated from field: string ada_node_id = 10;
+   */
+  adaNodeId: string;
+};
+
+/**
+ * Describes the message internal.rpc.v1.RandomGraphResponse.
+ * Use `create(RandomGraphResponseSchema)` to create a new message.
+ */
+export const RandomGraphResponseSchema: GenMessage<RandomGraphResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 21);
+
+/**
+ * StageSeed is the seed of the PCG source of a stage of generating a graph.
+ *
+ * @generated from message internal.rpc.v1.StageSeed
+ */
+export type StageSeed = Message<"internal.rpc.v1.StageSeed"> & {
+  /**
+   * stage is "graph" for generating the graph up to and including its layout, "relayout" for laying it
+   * out again and "walks" for walking it.
+   *
+   * @generated from field: string stage = 1;
+   */
+  stage: string;
+
+  /**
+   * @generated from field: uint64 seed1 = 2;
+   */
+  seed1: bigint;
+
+  /**
+   * @generated from field: uint64 seed2 = 3;
+   */
+  seed2: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.StageSeed.
+ * Use `create(StageSeedSchema)` to create a new message.
+ */
+export const StageSeedSchema: GenMessage<StageSeed> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 22);
+
+/**
+ * AlgorithmVersion is the version of the algorithms of a stage. It changes whenever the algorithms
+ * produce a different graph for the same parameters and seeds.
+ *
+ * @generated from message internal.rpc.v1.AlgorithmVersion
+ */
+export type AlgorithmVersion = Message<"internal.rpc.v1.AlgorithmVersion"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 version = 2;
+   */
+  version: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.AlgorithmVersion.
+ * Use `create(AlgorithmVersionSchema)` to create a new message.
+ */
+export const AlgorithmVersionSchema: GenMessage<AlgorithmVersion> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 23);
+
+/**
+ * GraphManifest records everything that determines a generated graph. The stages that ran are listed
+ * in seeds, their seeds take precedence over the seeds of the requests.
+ *
+ * A request with a graph_id refers to a stored graph rather than embedding it, so the manifest of such
+ * a graph can only be replayed by the server that stores that graph, and only while it does. Replays
+ * fail with a not found error otherwise.
+ *
+ * @generated from message internal.rpc.v1.GraphManifest
+ */
+export type GraphManifest = Message<"internal.rpc.v1.GraphManifest"> & {
+  /**
+   * request holds all parameters of the graph, its layout and its walks.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest request = 1;
+   */
+  request?: RandomGraphRequest;
+
+  /**
+   * relayout holds the layout parameters of the last Relayout or StreamLayout of a stored graph.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest relayout = 2;
+   */
+  relayout?: RandomGraphRequest;
+
+  /**
+   * @generated from field: repeated internal.rpc.v1.StageSeed seeds = 3;
+   */
+  seeds: StageSeed[];
+
+  /**
+   * @generated from field: repeated internal.rpc.v1.AlgorithmVersion algorithms = 4;
+   */
+  algorithms: AlgorithmVersion[];
+
+  /**
+   * trustd_version is the build version of the server that generated the graph.
+   *
+   * @generated from field: string trustd_version = 5;
+   */
+  trustdVersion: string;
+};
+
+/**
+ * Describes the message internal.rpc.v1.GraphManifest.
+ * Use `create(GraphManifestSchema)` to create a new message.
+ */
+export const GraphManifestSchema: GenMessage<GraphManifest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 24);
+
+/**
+ * ReplayRequest generates the graph of a manifest again. Manifests of other algorithm versions are
+ * refused with a failed precondition error, as they wouldn't produce the same graph.
+ *
+ * @generated from message internal.rpc.v1.ReplayRequest
+ */
+export type ReplayRequest = Message<"internal.rpc.v1.ReplayRequest"> & {
+  /**
+   * @generated from field: internal.rpc.v1.GraphManifest manifest = 1;
+   */
+  manifest?: GraphManifest;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ReplayRequest.
+ * Use `create(ReplayRequestSchema)` to create a new message.
+ */
+export const ReplayRequestSchema: GenMessage<ReplayRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 25);
+
+/**
+ * GraphStats describes the structure of a graph, measured as a simple undirected graph. Statistics that
+ * are undefined for the graph are 0.
+ *
+ * @generated from message internal.rpc.v1.GraphStats
+ */
+export type GraphStats = Message<"internal.rpc.v1.GraphStats"> & {
+  /**
+   * @generated from field: int64 num_nodes = 1;
+   */
+  numNodes: bigint;
+
+  /**
+   * @generated from field: int64 num_edges = 2;
+   */
+  numEdges: bigint;
+
+  /**
+   * @generated from field: int64 min_degree = 3;
+   */
+  minDegree: bigint;
+
+  /**
+   * @generated from field: int64 max_degree = 4;
+   */
+  maxDegree: bigint;
+
+  /**
+   * @generated from field: double mean_degree = 5;
+   */
+  meanDegree: number;
+
+  /**
+   * degree_histogram holds the number of nodes of every degree, indexed by degree.
+   *
+   * @generated from field: repeated int64 degree_histogram = 6;
+   */
+  degreeHistogram: bigint[];
+
+  /**
+   * average_clustering is the mean local clustering coefficient, nodes with fewer than two neighbors count
+   * as 0.
+   *
+   * @generated from field: double average_clustering = 7;
+   */
+  averageClustering: number;
+
+  /**
+   * average_path_length and diameter are the mean and longest hop count between nodes of the largest
+   * component.
+   *
+   * @generated from field: double average_path_length = 8;
+   */
+  averagePathLength: number;
+
+  /**
+   * @generated from field: int64 diameter = 9;
+   */
+  diameter: bigint;
+
+  /**
+   * @generated from field: int64 num_components = 10;
+   */
+  numComponents: bigint;
+
+  /**
+   * @generated from field: int64 largest_component_size = 11;
+   */
+  largestComponentSize: bigint;
+
+  /**
+   * assortativity is the correlation between the degrees at either end of the edges.
+   *
+   * @generated from field: double assortativity = 12;
+   */
+  assortativity: number;
+
+  /**
+   * sigma and omega are the small-world coefficients, relative to the analytic clustering and path length
+   * of a random graph and a ring lattice with the same mean degree. Sigma above 1 and omega near 0
+   * indicate a small world, omega near -1 a lattice and near 1 a random graph.
+   *
+   * @generated from field: double sigma = 13;
+   */
+  sigma: number;
+
+  /**
+   * @generated from field: double omega = 14;
+   */
+  omega: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.GraphStats.
+ * Use `create(GraphStatsSchema)` to create a new message.
+ */
+export const GraphStatsSchema: GenMessage<GraphStats> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 26);
+
+/**
+ * GraphSource refers to a graph, either one that is stored or one that is generated on the fly.
+ *
+ * @generated from message internal.rpc.v1.GraphSource
+ */
+export type GraphSource = Message<"internal.rpc.v1.GraphSource"> & {
+  /**
+   * @generated from oneof internal.rpc.v1.GraphSource.source
+   */
+  source: {
+    /**
+     * @generated from field: string graph_id = 1;
+     */
+    value: string;
+    case: "graphId";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.RandomGraphRequest generate = 2;
+     */
+    value: RandomGraphRequest;
+    case: "generate";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message internal.rpc.v1.GraphSource.
+ * Use `create(GraphSourceSchema)` to create a new message.
+ */
+export const GraphSourceSchema: GenMessage<GraphSource> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 27);
+
+/**
+ * LoadGraphRequest imports a graph file. The server caps the size of the file and the number of nodes of
+ * the graph.
+ *
+ * @generated from message internal.rpc.v1.LoadGraphRequest
+ */
+export type LoadGraphRequest = Message<"internal.rpc.v1.LoadGraphRequest"> & {
+  /**
+   * file_name is the path of the file, relative to the configured graph directory.
+   *
+   * @generated from field: string file_name = 1;
+   */
+  fileName: string;
+
+  /**
+   * format of the file, determined from the file extension when unspecified.
+   *
+   * @generated from field: internal.rpc.v1.GraphFormat format = 2;
+   */
+  format: GraphFormat;
+};
+
+/**
+ * Describes the message internal.rpc.v1.LoadGraphRequest.
+ * Use `create(LoadGraphRequestSchema)` to create a new message.
+ */
+export const LoadGraphRequestSchema: GenMessage<LoadGraphRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 28);
+
+/**
+ * @generated from message internal.rpc.v1.LoadGraphResponse
+ */
+export type LoadGraphResponse = Message<"internal.rpc.v1.LoadGraphResponse"> & {
+  /**
+   * graph_id refers to the loaded graph in later calls.
+   *
+   * @generated from field: string graph_id = 1;
+   */
+  graphId: string;
+
+  /**
+   * @generated from field: int64 num_nodes = 2;
+   */
+  numNodes: bigint;
+
+  /**
+   * @generated from field: int64 num_edges = 3;
+   */
+  numEdges: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.LoadGraphResponse.
+ * Use `create(LoadGraphResponseSchema)` to create a new message.
+ */
+export const LoadGraphResponseSchema: GenMessage<LoadGraphResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 29);
+
+/**
+ * @generated from message internal.rpc.v1.ExportGraphRequest
+ */
+export type ExportGraphRequest = Message<"internal.rpc.v1.ExportGraphRequest"> & {
+  /**
+   * @generated from field: internal.rpc.v1.GraphSource source = 1;
+   */
+  source?: GraphSource;
+
+  /**
+   * @generated from field: internal.rpc.v1.GraphFormat format = 2;
+   */
+  format: GraphFormat;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ExportGraphRequest.
+ * Use `create(ExportGraphRequestSchema)` to create a new message.
+ */
+export const ExportGraphRequestSchema: GenMessage<ExportGraphRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 30);
+
+/**
+ * @generated from message internal.rpc.v1.ExportGraphResponse
+ */
+export type ExportGraphResponse = Message<"internal.rpc.v1.ExportGraphResponse"> & {
+  /**
+   * @generated from field: bytes content = 1;
+   */
+  content: Uint8Array;
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+
+  /**
+   * file_name is a suggested name for the file.
+   *
+   * @generated from field: string file_name = 3;
+   */
+  fileName: string;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ExportGraphResponse.
+ * Use `create(ExportGraphResponseSchema)` to create a new message.
+ */
+export const ExportGraphResponseSchema: GenMessage<ExportGraphResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 31);
+
+/**
+ * PageRankParams configures personalized PageRank, where the random surfer always teleports back to the
+ * source node. Nodes without outgoing edges teleport back to the source as well.
+ *
+ * @generated from message internal.rpc.v1.PageRankParams
+ */
+export type PageRankParams = Message<"internal.rpc.v1.PageRankParams"> & {
+  /**
+   * damping is the probability that the surfer follows an edge rather than teleporting, defaults to 0.85.
+   *
+   * @generated from field: double damping = 1;
+   */
+  damping: number;
+
+  /**
+   * @generated from field: internal.rpc.v1.PageRankMethod method = 2;
+   */
+  method: PageRankMethod;
+
+  /**
+   * max_iterations bounds the power iteration, defaults to 100.
+   *
+   * @generated from field: int64 max_iterations = 3;
+   */
+  maxIterations: bigint;
+
+  /**
+   * tolerance is the L1 change in scores below which the power iteration stops, defaults to 1e-9.
+   *
+   * @generated from field: double tolerance = 4;
+   */
+  tolerance: number;
+
+  /**
+   * num_walks is the number of Monte Carlo walks, defaults to 10000.
+   *
+   * @generated from field: int64 num_walks = 5;
+   */
+  numWalks: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.PageRankParams.
+ * Use `create(PageRankParamsSchema)` to create a new message.
+ */
+export const PageRankParamsSchema: GenMessage<PageRankParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 32);
+
+/**
+ * EigenTrustParams configure EigenTrust, a global metric that computes the principal eigenvector of the
+ * normalized edge weights, mixed with the pre-trusted distribution.
+ *
+ * @generated from message internal.rpc.v1.EigenTrustParams
+ */
+export type EigenTrustParams = Message<"internal.rpc.v1.EigenTrustParams"> & {
+  /**
+   * pre_trust_weight is the weight of the pre-trusted distribution in every iteration, defaults to 0.15.
+   *
+   * @generated from field: double pre_trust_weight = 1;
+   */
+  preTrustWeight: number;
+
+  /**
+   * uniform_pre_trust pre-trusts all nodes equally, instead of the source node alone.
+   *
+   * @generated from field: bool uniform_pre_trust = 2;
+   */
+  uniformPreTrust: boolean;
+
+  /**
+   * max_iterations bounds the power iteration, defaults to 100.
+   *
+   * @generated from field: int64 max_iterations = 3;
+   */
+  maxIterations: bigint;
+
+  /**
+   * tolerance is the L1 change in scores below which the power iteration stops, defaults to 1e-9.
+   *
+   * @generated from field: double tolerance = 4;
+   */
+  tolerance: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.EigenTrustParams.
+ * Use `create(EigenTrustParamsSchema)` to create a new message.
+ */
+export const EigenTrustParamsSchema: GenMessage<EigenTrustParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 33);
+
+/**
+ * TidalTrustParams configure TidalTrust, a local metric that infers trust over the strongest shortest
+ * paths from the source, using edge weights as ratings.
+ *
+ * @generated from message internal.rpc.v1.TidalTrustParams
+ */
+export type TidalTrustParams = Message<"internal.rpc.v1.TidalTrustParams"> & {
+};
+
+/**
+ * Describes the message internal.rpc.v1.TidalTrustParams.
+ * Use `create(TidalTrustParamsSchema)` to create a new message.
+ */
+export const TidalTrustParamsSchema: GenMessage<TidalTrustParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 34);
+
+/**
+ * AdvogatoParams configure Advogato, a group metric that accepts the nodes reached by a maximum flow
+ * from the source, with node capacities decreasing by distance. Accepted nodes score 1, others 0.
+ *
+ * @generated from message internal.rpc.v1.AdvogatoParams
+ */
+export type AdvogatoParams = Message<"internal.rpc.v1.AdvogatoParams"> & {
+  /**
+   * capacities holds the node capacity at every distance from the source, the last one applies to all
+   * nodes further away. Defaults to 800, 200, 50, 12, 4, 2, 1.
+   *
+   * @generated from field: repeated int64 capacities = 1;
+   */
+  capacities: bigint[];
+};
+
+/**
+ * Describes the message internal.rpc.v1.AdvogatoParams.
+ * Use `create(AdvogatoParamsSchema)` to create a new message.
+ */
+export const AdvogatoParamsSchema: GenMessage<AdvogatoParams> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 35);
+
+/**
+ * @generated from message internal.rpc.v1.ComputeTrustRequest
+ */
+export type ComputeTrustRequest = Message<"internal.rpc.v1.ComputeTrustRequest"> & {
+  /**
+   * @generated from field: internal.rpc.v1.GraphSource source = 1;
+   */
+  source?: GraphSource;
+
+  /**
+   * seed1 and seed2 seed the randomized metrics.
+   *
+   * @generated from field: uint64 seed1 = 2;
+   */
+  seed1: bigint;
+
+  /**
+   * @generated from field: uint64 seed2 = 3;
+   */
+  seed2: bigint;
+
+  /**
+   * metric selects the trust metric, when unset personalized PageRank with its defaults is used.
+   *
+   * @generated from oneof internal.rpc.v1.ComputeTrustRequest.metric
+   */
+  metric: {
+    /**
+     * @generated from field: internal.rpc.v1.PageRankParams personalized_page_rank = 4;
+     */
+    value: PageRankParams;
+    case: "personalizedPageRank";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.EigenTrustParams eigen_trust = 5;
+     */
+    value: EigenTrustParams;
+    case: "eigenTrust";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.TidalTrustParams tidal_trust = 6;
+     */
+    value: TidalTrustParams;
+    case: "tidalTrust";
+  } | {
+    /**
+     * @generated from field: internal.rpc.v1.AdvogatoParams advogato = 7;
+     */
+    value: AdvogatoParams;
+    case: "advogato";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message internal.rpc.v1.ComputeTrustRequest.
+ * Use `create(ComputeTrustRequestSchema)` to create a new message.
+ */
+export const ComputeTrustRequestSchema: GenMessage<ComputeTrustRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 36);
+
+/**
+ * @generated from message internal.rpc.v1.ComputeTrustResponse
+ */
+export type ComputeTrustResponse = Message<"internal.rpc.v1.ComputeTrustResponse"> & {
+  /**
+   * scores holds the trust of Bob in every node, by node id.
+   *
+   * @generated from field: map<string, double> scores = 1;
+   */
+  scores: { [key: string]: number };
+
+  /**
+   * source_node_id is the node that trusts, Bob.
+   *
+   * @generated from field: string source_node_id = 2;
+   */
+  sourceNodeId: string;
+
+  /**
+   * target_node_id is the node that is trusted, Ada.
+   *
+   * @generated from field: string target_node_id = 3;
+   */
+  targetNodeId: string;
+
+  /**
+   * @generated from field: double target_score = 4;
+   */
+  targetScore: number;
+
+  /**
+   * target_rank is the position of Ada when all nodes except Bob are ordered by decreasing score,
+   * starting at 1. Nodes with the same score share their rank.
+   *
+   * @generated from field: int64 target_rank = 5;
+   */
+  targetRank: bigint;
+
+  /**
+   * iterations is the number of power iterations, or the number of walks for Monte Carlo. It is 0 for
+   * metrics that don't iterate.
+   *
+   * @generated from field: int64 iterations = 6;
+   */
+  iterations: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ComputeTrustResponse.
+ * Use `create(ComputeTrustResponseSchema)` to create a new message.
+ */
+export const ComputeTrustResponseSchema: GenMessage<ComputeTrustResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 37);
+
+/**
+ * @generated from message internal.rpc.v1.MaxFlowRequest
+ */
+export type MaxFlowRequest = Message<"internal.rpc.v1.MaxFlowRequest"> & {
+  /**
+   * @generated from field: internal.rpc.v1.GraphSource source = 1;
+   */
+  source?: GraphSource;
+
+  /**
+   * @generated from field: internal.rpc.v1.FlowCapacity capacity = 2;
+   */
+  capacity: FlowCapacity;
+};
+
+/**
+ * Describes the message internal.rpc.v1.MaxFlowRequest.
+ * Use `create(MaxFlowRequestSchema)` to create a new message.
+ */
+export const MaxFlowRequestSchema: GenMessage<MaxFlowRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 38);
+
+/**
+ * @generated from message internal.rpc.v1.MaxFlowResponse
+ */
+export type MaxFlowResponse = Message<"internal.rpc.v1.MaxFlowResponse"> & {
+  /**
+   * source_node_id is where the flow starts, Bob.
+   *
+   * @generated from field: string source_node_id = 1;
+   */
+  sourceNodeId: string;
+
+  /**
+   * target_node_id is where the flow ends, Ada.
+   *
+   * @generated from field: string target_node_id = 2;
+   */
+  targetNodeId: string;
+
+  /**
+   * value is the maximum flow, which equals the capacity of the minimum cut.
+   *
+   * @generated from field: double value = 3;
+   */
+  value: number;
+
+  /**
+   * cut_edge_ids holds the edges of a minimum cut, the ones on Bob's side of it that lead to Ada's side.
+   *
+   * @generated from field: repeated string cut_edge_ids = 4;
+   */
+  cutEdgeIds: string[];
+
+  /**
+   * graph is the graph with the cut edges typed "cutEdge".
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphResponse graph = 5;
+   */
+  graph?: RandomGraphResponse;
+};
+
+/**
+ * Describes the message internal.rpc.v1.MaxFlowResponse.
+ * Use `create(MaxFlowResponseSchema)` to create a new message.
+ */
+export const MaxFlowResponseSchema: GenMessage<MaxFlowResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 39);
+
+/**
+ * @generated from message internal.rpc.v1.ShortestPathsRequest
+ */
+export type ShortestPathsRequest = Message<"internal.rpc.v1.ShortestPathsRequest"> & {
+  /**
+   * @generated from field: internal.rpc.v1.GraphSource source = 1;
+   */
+  source?: GraphSource;
+
+  /**
+   * @generated from field: internal.rpc.v1.PathCost cost = 2;
+   */
+  cost: PathCost;
+
+  /**
+   * k is the number of loopless paths to find with Yen's algorithm, defaults to 1.
+   *
+   * @generated from field: int64 k = 3;
+   */
+  k: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ShortestPathsRequest.
+ * Use `create(ShortestPathsRequestSchema)` to create a new message.
+ */
+export const ShortestPathsRequestSchema: GenMessage<ShortestPathsRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 40);
+
+/**
+ * TrustPath is a path from Bob to Ada.
+ *
+ * @generated from message internal.rpc.v1.TrustPath
+ */
+export type TrustPath = Message<"internal.rpc.v1.TrustPath"> & {
+  /**
+   * @generated from field: repeated string node_ids = 1;
+   */
+  nodeIds: string[];
+
+  /**
+   * @generated from field: repeated string edge_ids = 2;
+   */
+  edgeIds: string[];
+
+  /**
+   * @generated from field: double cost = 3;
+   */
+  cost: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.TrustPath.
+ * Use `create(TrustPathSchema)` to create a new message.
+ */
+export const TrustPathSchema: GenMessage<TrustPath> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 41);
+
+/**
+ * @generated from message internal.rpc.v1.ShortestPathsResponse
+ */
+export type ShortestPathsResponse = Message<"internal.rpc.v1.ShortestPathsResponse"> & {
+  /**
+   * source_node_id is where the paths start, Bob.
+   *
+   * @generated from field: string source_node_id = 1;
+   */
+  sourceNodeId: string;
+
+  /**
+   * target_node_id is where the paths end, Ada.
+   *
+   * @generated from field: string target_node_id = 2;
+   */
+  targetNodeId: string;
+
+  /**
+   * paths holds the paths in order of increasing cost, there are fewer than k if there aren't as many.
+   *
+   * @generated from field: repeated internal.rpc.v1.TrustPath paths = 3;
+   */
+  paths: TrustPath[];
+
+  /**
+   * graph is the graph with the edges of the paths typed "pathEdge" and the nodes between Bob and Ada
+   * typed "pathNode".
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphResponse graph = 4;
+   */
+  graph?: RandomGraphResponse;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ShortestPathsResponse.
+ * Use `create(ShortestPathsResponseSchema)` to create a new message.
+ */
+export const ShortestPathsResponseSchema: GenMessage<ShortestPathsResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 42);
+
+/**
+ * @generated from message internal.rpc.v1.GraphStatsRequest
+ */
+export type GraphStatsRequest = Message<"internal.rpc.v1.GraphStatsRequest"> & {
+  /**
+   * @generated from field: internal.rpc.v1.GraphSource source = 1;
+   */
+  source?: GraphSource;
+};
+
+/**
+ * Describes the message internal.rpc.v1.GraphStatsRequest.
+ * Use `create(GraphStatsRequestSchema)` to create a new message.
+ */
+export const GraphStatsRequestSchema: GenMessage<GraphStatsRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 43);
+
+/**
+ * @generated from message internal.rpc.v1.GraphStatsResponse
+ */
+export type GraphStatsResponse = Message<"internal.rpc.v1.GraphStatsResponse"> & {
+  /**
+   * @generated from field: internal.rpc.v1.GraphStats stats = 1;
+   */
+  stats?: GraphStats;
+};
+
+/**
+ * Describes the message internal.rpc.v1.GraphStatsResponse.
+ * Use `create(GraphStatsResponseSchema)` to create a new message.
+ */
+export const GraphStatsResponseSchema: GenMessage<GraphStatsResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 44);
+
+/**
+ * Int64Sweep sweeps an integer parameter from start up to and including stop, in increments of step.
+ * Without a step the sweep only takes the start value.
+ *
+ * @generated from message internal.rpc.v1.Int64Sweep
+ */
+export type Int64Sweep = Message<"internal.rpc.v1.Int64Sweep"> & {
+  /**
+   * @generated from field: int64 start = 1;
+   */
+  start: bigint;
+
+  /**
+   * @generated from field: int64 stop = 2;
+   */
+  stop: bigint;
+
+  /**
+   * @generated from field: int64 step = 3;
+   */
+  step: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.Int64Sweep.
+ * Use `create(Int64SweepSchema)` to create a new message.
+ */
+export const Int64SweepSchema: GenMessage<Int64Sweep> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 45);
+
+/**
+ * DoubleSweep sweeps a real parameter from start up to and including stop, in increments of step.
+ * Without a step the sweep only takes the start value.
+ *
+ * @generated from message internal.rpc.v1.DoubleSweep
+ */
+export type DoubleSweep = Message<"internal.rpc.v1.DoubleSweep"> & {
+  /**
+   * @generated from field: double start = 1;
+   */
+  start: number;
+
+  /**
+   * @generated from field: double stop = 2;
+   */
+  stop: number;
+
+  /**
+   * @generated from field: double step = 3;
+   */
+  step: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.DoubleSweep.
+ * Use `create(DoubleSweepSchema)` to create a new message.
+ */
+export const DoubleSweepSchema: GenMessage<DoubleSweep> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 46);
+
+/**
+ * RunExperimentRequest runs the walks of Bob and Ada for every combination of the swept parameters, and
+ * for every seed. The graphs are Watts–Strogatz graphs, the generator and layout of the base are ignored.
+ *
+ * @generated from message internal.rpc.v1.RunExperimentRequest
+ */
+export type RunExperimentRequest = Message<"internal.rpc.v1.RunExperimentRequest"> & {
+  /**
+   * base configures everything that isn't swept, such as the walk mode and the Sybil region. Parameters
+   * that aren't swept take their value from the base.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest base = 1;
+   */
+  base?: RandomGraphRequest;
+
+  /**
+   * @generated from field: internal.rpc.v1.Int64Sweep num_nodes = 2;
+   */
+  numNodes?: Int64Sweep;
+
+  /**
+   * @generated from field: internal.rpc.v1.Int64Sweep initial_connected = 3;
+   */
+  initialConnected?: Int64Sweep;
+
+  /**
+   * @generated from field: internal.rpc.v1.DoubleSweep rewiring_probability = 4;
+   */
+  rewiringProbability?: DoubleSweep;
+
+  /**
+   * @generated from field: internal.rpc.v1.Int64Sweep walk_length = 5;
+   */
+  walkLength?: Int64Sweep;
+
+  /**
+   * @generated from field: internal.rpc.v1.Int64Sweep num_walks = 6;
+   */
+  numWalks?: Int64Sweep;
+
+  /**
+   * seeds sweeps the seeds of the runs of every configuration: the run with seed s uses it as seed1 and
+   * seed3 of the base, and keeps its seed2 and seed4.
+   *
+   * @generated from field: internal.rpc.v1.Int64Sweep seeds = 7;
+   */
+  seeds?: Int64Sweep;
+};
+
+/**
+ * Describes the message internal.rpc.v1.RunExperimentRequest.
+ * Use `create(RunExperimentRequestSchema)` to create a new message.
+ */
+export const RunExperimentRequestSchema: GenMessage<RunExperimentRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 47);
+
+/**
+ * ExperimentResult aggregates the runs of a single configuration of an experiment.
+ *
+ * @generated from message internal.rpc.v1.ExperimentResult
+ */
+export type ExperimentResult = Message<"internal.rpc.v1.ExperimentResult"> & {
+  /**
+   * @generated from field: int64 num_nodes = 1;
+   */
+  numNodes: bigint;
+
+  /**
+   * @generated from field: int64 initial_connected = 2;
+   */
+  initialConnected: bigint;
+
+  /**
+   * @generated from field: double rewiring_probability = 3;
+   */
+  rewiringProbability: number;
+
+  /**
+   * @generated from field: int64 walk_length = 4;
+   */
+  walkLength: bigint;
+
+  /**
+   * @generated from field: int64 num_walks = 5;
+   */
+  numWalks: bigint;
+
+  /**
+   * runs is the number of seeds the configuration ran with.
+   *
+   * @generated from field: int64 runs = 6;
+   */
+  runs: bigint;
+
+  /**
+   * intersection_rate is the fraction of the runs in which a walk of Bob met a walk of Ada.
+   *
+   * @generated from field: double intersection_rate = 7;
+   */
+  intersectionRate: number;
+
+  /**
+   * mean_meeting_step is the mean of the combined steps of Bob and Ada to their meeting node, over the
+   * runs in which they met.
+   *
+   * @generated from field: double mean_meeting_step = 8;
+   */
+  meanMeetingStep: number;
+
+  /**
+   * escape_probability is the fraction of all walks that entered the Sybil region.
+   *
+   * @generated from field: double escape_probability = 9;
+   */
+  escapeProbability: number;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ExperimentResult.
+ * Use `create(ExperimentResultSchema)` to create a new message.
+ */
+export const ExperimentResultSchema: GenMessage<ExperimentResult> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 48);
+
+/**
+ * @generated from message internal.rpc.v1.RunExperimentResponse
+ */
+export type RunExperimentResponse = Message<"internal.rpc.v1.RunExperimentResponse"> & {
+  /**
+   * results holds a result per configuration, the last swept parameter varies fastest.
+   *
+   * @generated from field: repeated internal.rpc.v1.ExperimentResult results = 1;
+   */
+  results: ExperimentResult[];
+
+  /**
+   * experiment_id refers to the stored experiment in later calls.
+   *
+   * @generated from field: string experiment_id = 2;
+   */
+  experimentId: string;
+};
+
+/**
+ * Describes the message internal.rpc.v1.RunExperimentResponse.
+ * Use `create(RunExperimentResponseSchema)` to create a new message.
+ */
+export const RunExperimentResponseSchema: GenMessage<RunExperimentResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 49);
+
+/**
+ * CreateGraphRequest creates a graph that is kept on the server, so it can be walked and laid out again
+ * without being regenerated.
+ *
+ * @generated from message internal.rpc.v1.CreateGraphRequest
+ */
+export type CreateGraphRequest = Message<"internal.rpc.v1.CreateGraphRequest"> & {
+  /**
+   * params configures the graph, its walk related fields are ignored.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest params = 1;
+   */
+  params?: RandomGraphRequest;
+};
+
+/**
+ * Describes the message internal.rpc.v1.CreateGraphRequest.
+ * Use `create(CreateGraphRequestSchema)` to create a new message.
+ */
+export const CreateGraphRequestSchema: GenMessage<CreateGraphRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 50);
+
+/**
+ * @generated from message internal.rpc.v1.CreateGraphResponse
+ */
+export type CreateGraphResponse = Message<"internal.rpc.v1.CreateGraphResponse"> & {
+  /**
+   * @generated from field: string graph_id = 1;
+   */
+  graphId: string;
+
+  /**
+   * @generated from field: internal.rpc.v1.RandomGraphResponse graph = 2;
+   */
+  graph?: RandomGraphResponse;
+};
+
+/**
+ * Describes the message internal.rpc.v1.CreateGraphResponse.
+ * Use `create(CreateGraphResponseSchema)` to create a new message.
+ */
+export const CreateGraphResponseSchema: GenMessage<CreateGraphResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 51);
+
+/**
+ * @generated from message internal.rpc.v1.RunWalksRequest
+ */
+export type RunWalksRequest = Message<"internal.rpc.v1.RunWalksRequest"> & {
+  /**
+   * @generated from field: string graph_id = 1;
+   */
+  graphId: string;
+
+  /**
+   * params configures the walks, only its walk related fields are used.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest params = 2;
+   */
+  params?: RandomGraphRequest;
+};
+
+/**
+ * Describes the message internal.rpc.v1.RunWalksRequest.
+ * Use `create(RunWalksRequestSchema)` to create a new message.
+ */
+export const RunWalksRequestSchema: GenMessage<RunWalksRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 52);
+
+/**
+ * @generated from message internal.rpc.v1.RunWalksResponse
+ */
+export type RunWalksResponse = Message<"internal.rpc.v1.RunWalksResponse"> & {
+  /**
+   * @generated from field: internal.rpc.v1.RandomGraphResponse graph = 1;
+   */
+  graph?: RandomGraphResponse;
+};
+
+/**
+ * Describes the message internal.rpc.v1.RunWalksResponse.
+ * Use `create(RunWalksResponseSchema)` to create a new message.
+ */
+export const RunWalksResponseSchema: GenMessage<RunWalksResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 53);
+
+/**
+ * @generated from message internal.rpc.v1.RelayoutRequest
+ */
+export type RelayoutRequest = Message<"internal.rpc.v1.RelayoutRequest"> & {
+  /**
+   * @generated from field: string graph_id = 1;
+   */
+  graphId: string;
+
+  /**
+   * params configures the layout, only its layout related fields and seed1 and seed2 are used.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest params = 2;
+   */
+  params?: RandomGraphRequest;
+};
+
+/**
+ * Describes the message internal.rpc.v1.RelayoutRequest.
+ * Use `create(RelayoutRequestSchema)` to create a new message.
+ */
+export const RelayoutRequestSchema: GenMessage<RelayoutRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 54);
+
+/**
+ * @generated from message internal.rpc.v1.RelayoutResponse
+ */
+export type RelayoutResponse = Message<"internal.rpc.v1.RelayoutResponse"> & {
+  /**
+   * @generated from field: internal.rpc.v1.RandomGraphResponse graph = 1;
+   */
+  graph?: RandomGraphResponse;
+};
+
+/**
+ * Describes the message internal.rpc.v1.RelayoutResponse.
+ * Use `create(RelayoutResponseSchema)` to create a new message.
+ */
+export const RelayoutResponseSchema: GenMessage<RelayoutResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 55);
+
+/**
+ * @generated from message internal.rpc.v1.StreamLayoutRequest
+ */
+export type StreamLayoutRequest = Message<"internal.rpc.v1.StreamLayoutRequest"> & {
+  /**
+   * @generated from field: string graph_id = 1;
+   */
+  graphId: string;
+
+  /**
+   * params configures the layout, only its force-directed layout fields and seed1 and seed2 are used.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest params = 2;
+   */
+  params?: RandomGraphRequest;
+
+  /**
+   * snapshot_interval is the number of iterations between snapshots, defaults to 10.
+   *
+   * @generated from field: int64 snapshot_interval = 3;
+   */
+  snapshotInterval: bigint;
+};
+
+/**
+ * Describes the message internal.rpc.v1.StreamLayoutRequest.
+ * Use `create(StreamLayoutRequestSchema)` to create a new message.
+ */
+export const StreamLayoutRequestSchema: GenMessage<StreamLayoutRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 56);
+
+/**
+ * NodePosition is the position of a single node.
+ *
+ * @generated from message internal.rpc.v1.NodePosition
+ */
+export type NodePosition = Message<"internal.rpc.v1.NodePosition"> & {
+  /**
+   * @generated from field: string node_id = 1;
+   */
+  nodeId: string;
+
+  /**
+   * @generated from field: internal.rpc.v1.Position position = 2;
+   */
+  position?: Position;
+};
+
+/**
+ * Describes the message internal.rpc.v1.NodePosition.
+ * Use `create(NodePositionSchema)` to create a new message.
+ */
+export const NodePositionSchema: GenMessage<NodePosition> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 57);
+
+/**
+ * @generated from message internal.rpc.v1.StreamLayoutResponse
+ */
+export type StreamLayoutResponse = Message<"internal.rpc.v1.StreamLayoutResponse"> & {
+  /**
+   * stats of the layout so far.
+   *
+   * @generated from field: internal.rpc.v1.LayoutStats stats = 1;
+   */
+  stats?: LayoutStats;
+
+  /**
+   * positions of all nodes, not set on the last message.
+   *
+   * @generated from field: repeated internal.rpc.v1.NodePosition positions = 2;
+   */
+  positions: NodePosition[];
+
+  /**
+   * graph with the final layout, only set on the last message.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphResponse graph = 3;
+   */
+  graph?: RandomGraphResponse;
+};
+
+/**
+ * Describes the message internal.rpc.v1.StreamLayoutResponse.
+ * Use `create(StreamLayoutResponseSchema)` to create a new message.
+ */
+export const StreamLayoutResponseSchema: GenMessage<StreamLayoutResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 58);
+
+/**
+ * GraphMetadata describes a record of the graph store, with what is needed to reproduce it.
+ *
+ * @generated from message internal.rpc.v1.GraphMetadata
+ */
+export type GraphMetadata = Message<"internal.rpc.v1.GraphMetadata"> & {
+  /**
+   * @generated from field: string graph_id = 1;
+   */
+  graphId: string;
+
+  /**
+   * @generated from field: internal.rpc.v1.RecordKind kind = 2;
+   */
+  kind: RecordKind;
+
+  /**
+   * created_at is the unix time in milliseconds at which the record was created.
+   *
+   * @generated from field: int64 created_at = 3;
+   */
+  createdAt: bigint;
+
+  /**
+   * updated_at is the unix time in milliseconds at which the graph was last walked or laid out.
+   *
+   * @generated from field: int64 updated_at = 4;
+   */
+  updatedAt: bigint;
+
+  /**
+   * @generated from field: int64 num_nodes = 5;
+   */
+  numNodes: bigint;
+
+  /**
+   * @generated from field: int64 num_edges = 6;
+   */
+  numEdges: bigint;
+
+  /**
+   * params is the request that generated the graph, including its seeds.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest params = 7;
+   */
+  params?: RandomGraphRequest;
+
+  /**
+   * file_name is the file that an imported graph was loaded from.
+   *
+   * @generated from field: string file_name = 8;
+   */
+  fileName: string;
+
+  /**
+   * walk_params configured the latest walks over the graph.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest walk_params = 9;
+   */
+  walkParams?: RandomGraphRequest;
+
+  /**
+   * layout_params configured the latest layout of the graph.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphRequest layout_params = 10;
+   */
+  layoutParams?: RandomGraphRequest;
+
+  /**
+   * experiment is the request of an experiment.
+   *
+   * @generated from field: internal.rpc.v1.RunExperimentRequest experiment = 11;
+   */
+  experiment?: RunExperimentRequest;
+};
+
+/**
+ * Describes the message internal.rpc.v1.GraphMetadata.
+ * Use `create(GraphMetadataSchema)` to create a new message.
+ */
+export const GraphMetadataSchema: GenMessage<GraphMetadata> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 59);
+
+/**
+ * GraphRecord is a record of the graph store.
+ *
+ * @generated from message internal.rpc.v1.GraphRecord
+ */
+export type GraphRecord = Message<"internal.rpc.v1.GraphRecord"> & {
+  /**
+   * @generated from field: internal.rpc.v1.GraphMetadata metadata = 1;
+   */
+  metadata?: GraphMetadata;
+
+  /**
+   * base is the graph before any walks.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphResponse base = 2;
+   */
+  base?: RandomGraphResponse;
+
+  /**
+   * graph is the graph as of the latest walks.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphResponse graph = 3;
+   */
+  graph?: RandomGraphResponse;
+
+  /**
+   * results holds the results of an experiment.
+   *
+   * @generated from field: internal.rpc.v1.RunExperimentResponse results = 4;
+   */
+  results?: RunExperimentResponse;
+};
+
+/**
+ * Describes the message internal.rpc.v1.GraphRecord.
+ * Use `create(GraphRecordSchema)` to create a new message.
+ */
+export const GraphRecordSchema: GenMessage<GraphRecord> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 60);
+
+/**
+ * @generated from message internal.rpc.v1.ListGraphsRequest
+ */
+export type ListGraphsRequest = Message<"internal.rpc.v1.ListGraphsRequest"> & {
+  /**
+   * kind only lists the records of the kind, if set.
+   *
+   * @generated from field: internal.rpc.v1.RecordKind kind = 1;
+   */
+  kind: RecordKind;
+};
+
+/**
+ * Describes the message internal.rpc.v1.ListGraphsRequest.
+ * Use `create(ListGraphsRequestSchema)` to create a new message.
+ */
+export const ListGraphsRequestSchema: GenMessage<ListGraphsRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 61);
+
+/**
+ * @generated from message internal.rpc.v1.ListGraphsResponse
+ */
+export type ListGraphsResponse = Message<"internal.rpc.v1.ListGraphsResponse"> & {
+  /**
+   * graphs holds the metadata of the records, most recently created first.
+   *
+   * @generated from field: repeated internal.rpc.v1.GraphMetadata graphs = 1;
+   */
+  graphs: GraphMetadata[];
+};
+
+/**
+ * Describes the message internal.rpc.v1.ListGraphsResponse.
+ * Use `create(ListGraphsResponseSchema)` to create a new message.
+ */
+export const ListGraphsResponseSchema: GenMessage<ListGraphsResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 62);
+
+/**
+ * @generated from message internal.rpc.v1.GetGraphRequest
+ */
+export type GetGraphRequest = Message<"internal.rpc.v1.GetGraphRequest"> & {
+  /**
+   * @generated from field: string graph_id = 1;
+   */
+  graphId: string;
+};
+
+/**
+ * Describes the message internal.rpc.v1.GetGraphRequest.
+ * Use `create(GetGraphRequestSchema)` to create a new message.
+ */
+export const GetGraphRequestSchema: GenMessage<GetGraphRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 63);
+
+/**
+ * @generated from message internal.rpc.v1.GetGraphResponse
+ */
+export type GetGraphResponse = Message<"internal.rpc.v1.GetGraphResponse"> & {
+  /**
+   * graph as of the latest walks, experiments have no graph.
+   *
+   * @generated from field: internal.rpc.v1.RandomGraphResponse graph = 1;
+   */
+  graph?: RandomGraphResponse;
+
+  /**
+   * @generated from field: internal.rpc.v1.GraphMetadata metadata = 2;
+   */
+  metadata?: GraphMetadata;
+
+  /**
+   * results holds the results of an experiment.
+   *
+   * @generated from field: internal.rpc.v1.RunExperimentResponse results = 3;
+   */
+  results?: RunExperimentResponse;
+};
+
+/**
+ * Describes the message internal.rpc.v1.GetGraphResponse.
+ * Use `create(GetGraphResponseSchema)` to create a new message.
+ */
+export const GetGraphResponseSchema: GenMessage<GetGraphResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 64);
+
+/**
+ * @generated from message internal.rpc.v1.DeleteGraphRequest
+ */
+export type DeleteGraphRequest = Message<"internal.rpc.v1.DeleteGraphRequest"> & {
+  /**
+   * @generated from field: string graph_id = 1;
+   */
+  graphId: string;
+};
+
+/**
+ * Describes the message internal.rpc.v1.DeleteGraphRequest.
+ * Use `create(DeleteGraphRequestSchema)` to create a new message.
+ */
+export const DeleteGraphRequestSchema: GenMessage<DeleteGraphRequest> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 65);
+
+/**
+ * @generated from message internal.rpc.v1.DeleteGraphResponse
+ */
+export type DeleteGraphResponse = Message<"internal.rpc.v1.DeleteGraphResponse"> & {
+};
+
+/**
+ * Describes the message internal.rpc.v1.DeleteGraphResponse.
+ * Use `create(DeleteGraphResponseSchema)` to create a new message.
+ */
+export const DeleteGraphResponseSchema: GenMessage<DeleteGraphResponse> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 66);
+
+/**
+ * FieldViolation describes a field of a request that violates its constraints, like the violations
+ * reported by protovalidate.
+ *
+ * @generated from message internal.rpc.v1.FieldViolation
+ */
+export type FieldViolation = Message<"internal.rpc.v1.FieldViolation"> & {
+  /**
+   * field_path is the path of the field within the request, such as "sybil_region.num_nodes".
+   *
+   * @generated from field: string field_path = 1;
+   */
+  fieldPath: string;
+
+  /**
+   * rule_id identifies the constraint, such as "int64.gte" for the buf.validate rules of the fields or
+   * "trustd.max_nodes" for the caps of the server and the constraints between fields.
+   *
+   * @generated from field: string rule_id = 2;
+   */
+  ruleId: string;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message internal.rpc.v1.FieldViolation.
+ * Use `create(FieldViolationSchema)` to create a new message.
+ */
+export const FieldViolationSchema: GenMessage<FieldViolation> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 67);
+
+/**
+ * Violations is attached as a detail to the invalid argument errors of requests that fail validation.
+ *
+ * @generated from message internal.rpc.v1.Violations
+ */
+export type Violations = Message<"internal.rpc.v1.Violations"> & {
+  /**
+   * @generated from field: repeated internal.rpc.v1.FieldViolation violations = 1;
+   */
+  violations: FieldViolation[];
+};
+
+/**
+ * Describes the message internal.rpc.v1.Violations.
+ * Use `create(ViolationsSchema)` to create a new message.
+ */
+export const ViolationsSchema: GenMessage<Violations> = /*@__PURE__*/
+  messageDesc(file_internal_rpc_v1_rpc, 68);
+
+/**
+ * Party identifies one of the two highlighted participants in the graph.
+ *
+ * @generated from enum internal.rpc.v1.Party
+ */
+export enum Party {
+  /**
+   * @generated from enum value: PARTY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PARTY_BOB = 1;
+   */
+  BOB = 1,
+
+  /**
+   * @generated from enum value: PARTY_ADA = 2;
+   */
+  ADA = 2,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.Party.
+ */
+export const PartySchema: GenEnum<Party> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 0);
+
+/**
+ * WalkMode selects how walks move through the graph.
+ *
+ * @generated from enum internal.rpc.v1.WalkMode
+ */
+export enum WalkMode {
+  /**
+   * @generated from enum value: WALK_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * WALK_MODE_RANDOM_WALK picks a uniformly random neighbor on every step.
+   *
+   * @generated from enum value: WALK_MODE_RANDOM_WALK = 1;
+   */
+  RANDOM_WALK = 1,
+
+  /**
+   * WALK_MODE_RANDOM_ROUTE follows SybilGuard/SybilLimit style random routes.
+   *
+   * @generated from enum value: WALK_MODE_RANDOM_ROUTE = 2;
+   */
+  RANDOM_ROUTE = 2,
+
+  /**
+   * WALK_MODE_WEIGHTED picks a neighbor with a probability proportional to the weight of the edge.
+   *
+   * @generated from enum value: WALK_MODE_WEIGHTED = 3;
+   */
+  WEIGHTED = 3,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.WalkMode.
+ */
+export const WalkModeSchema: GenEnum<WalkMode> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 1);
+
+/**
+ * WeightDistribution selects the distribution edge weights are drawn from.
+ *
+ * @generated from enum internal.rpc.v1.WeightDistribution
+ */
+export enum WeightDistribution {
+  /**
+   * WEIGHT_DISTRIBUTION_UNSPECIFIED assigns no weights.
+   *
+   * @generated from enum value: WEIGHT_DISTRIBUTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * WEIGHT_DISTRIBUTION_UNIFORM draws weights uniformly from [min, max).
+   *
+   * @generated from enum value: WEIGHT_DISTRIBUTION_UNIFORM = 1;
+   */
+  UNIFORM = 1,
+
+  /**
+   * WEIGHT_DISTRIBUTION_EXPONENTIAL draws weights from an exponential distribution with the given mean.
+   *
+   * @generated from enum value: WEIGHT_DISTRIBUTION_EXPONENTIAL = 2;
+   */
+  EXPONENTIAL = 2,
+
+  /**
+   * WEIGHT_DISTRIBUTION_LOG_NORMAL draws weights whose logarithm is normally distributed with mu and sigma.
+   *
+   * @generated from enum value: WEIGHT_DISTRIBUTION_LOG_NORMAL = 3;
+   */
+  LOG_NORMAL = 3,
+
+  /**
+   * WEIGHT_DISTRIBUTION_BETA draws weights in [0, 1] from a beta distribution with alpha and beta.
+   *
+   * @generated from enum value: WEIGHT_DISTRIBUTION_BETA = 4;
+   */
+  BETA = 4,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.WeightDistribution.
+ */
+export const WeightDistributionSchema: GenEnum<WeightDistribution> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 2);
+
+/**
+ * RepulsionAlgorithm selects how the repulsive forces of the force-directed layout are computed.
+ *
+ * @generated from enum internal.rpc.v1.RepulsionAlgorithm
+ */
+export enum RepulsionAlgorithm {
+  /**
+   * @generated from enum value: REPULSION_ALGORITHM_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * REPULSION_ALGORITHM_EXACT computes the forces between every pair of nodes, it is the default.
+   *
+   * @generated from enum value: REPULSION_ALGORITHM_EXACT = 1;
+   */
+  EXACT = 1,
+
+  /**
+   * REPULSION_ALGORITHM_BARNES_HUT approximates the forces with a quadtree, for large graphs.
+   *
+   * @generated from enum value: REPULSION_ALGORITHM_BARNES_HUT = 2;
+   */
+  BARNES_HUT = 2,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.RepulsionAlgorithm.
+ */
+export const RepulsionAlgorithmSchema: GenEnum<RepulsionAlgorithm> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 3);
+
+/**
+ * CoolingSchedule selects how the temperature of the force-directed layout, the maximum distance a node
+ * moves per iteration, decreases over the iterations.
+ *
+ * @generated from enum internal.rpc.v1.CoolingSchedule
+ */
+export enum CoolingSchedule {
+  /**
+   * COOLING_SCHEDULE_UNSPECIFIED keeps the maximum step fixed at 10 units, the layout doesn't cool down.
+   *
+   * @generated from enum value: COOLING_SCHEDULE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * COOLING_SCHEDULE_LINEAR decreases the temperature linearly to zero at the last iteration.
+   *
+   * @generated from enum value: COOLING_SCHEDULE_LINEAR = 1;
+   */
+  LINEAR = 1,
+
+  /**
+   * COOLING_SCHEDULE_EXPONENTIAL multiplies the temperature by 0.95 every iteration.
+   *
+   * @generated from enum value: COOLING_SCHEDULE_EXPONENTIAL = 2;
+   */
+  EXPONENTIAL = 2,
+
+  /**
+   * COOLING_SCHEDULE_ADAPTIVE cools down while the energy doesn't decrease, and heats up again after
+   * several iterations in which it did (Hu, 2005).
+   *
+   * @generated from enum value: COOLING_SCHEDULE_ADAPTIVE = 3;
+   */
+  ADAPTIVE = 3,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.CoolingSchedule.
+ */
+export const CoolingScheduleSchema: GenEnum<CoolingSchedule> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 4);
+
+/**
+ * GraphFormat identifies a graph file format.
+ *
+ * @generated from enum internal.rpc.v1.GraphFormat
+ */
+export enum GraphFormat {
+  /**
+   * @generated from enum value: GRAPH_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: GRAPH_FORMAT_EDGE_LIST = 1;
+   */
+  EDGE_LIST = 1,
+
+  /**
+   * @generated from enum value: GRAPH_FORMAT_GRAPHML = 2;
+   */
+  GRAPHML = 2,
+
+  /**
+   * @generated from enum value: GRAPH_FORMAT_GEXF = 3;
+   */
+  GEXF = 3,
+
+  /**
+   * GRAPH_FORMAT_DOT is the Graphviz DOT language, it is only supported for exports.
+   *
+   * @generated from enum value: GRAPH_FORMAT_DOT = 4;
+   */
+  DOT = 4,
+
+  /**
+   * GRAPH_FORMAT_JSON is trustd's stable JSON schema, it is only supported for exports.
+   *
+   * @generated from enum value: GRAPH_FORMAT_JSON = 5;
+   */
+  JSON = 5,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.GraphFormat.
+ */
+export const GraphFormatSchema: GenEnum<GraphFormat> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 5);
+
+/**
+ * PageRankMethod selects how personalized PageRank is computed.
+ *
+ * @generated from enum internal.rpc.v1.PageRankMethod
+ */
+export enum PageRankMethod {
+  /**
+   * PAGE_RANK_METHOD_UNSPECIFIED uses power iteration.
+   *
+   * @generated from enum value: PAGE_RANK_METHOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * PAGE_RANK_METHOD_POWER_ITERATION iterates the PageRank equation until the scores converge.
+   *
+   * @generated from enum value: PAGE_RANK_METHOD_POWER_ITERATION = 1;
+   */
+  POWER_ITERATION = 1,
+
+  /**
+   * PAGE_RANK_METHOD_MONTE_CARLO estimates the scores by the end points of random walks from the source.
+   *
+   * @generated from enum value: PAGE_RANK_METHOD_MONTE_CARLO = 2;
+   */
+  MONTE_CARLO = 2,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.PageRankMethod.
+ */
+export const PageRankMethodSchema: GenEnum<PageRankMethod> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 6);
+
+/**
+ * FlowCapacity selects how much flow every edge can carry.
+ *
+ * @generated from enum internal.rpc.v1.FlowCapacity
+ */
+export enum FlowCapacity {
+  /**
+   * UNSPECIFIED defaults to unit capacities.
+   *
+   * @generated from enum value: FLOW_CAPACITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * UNIT gives every edge a capacity of 1, so the flow is the edge connectivity.
+   *
+   * @generated from enum value: FLOW_CAPACITY_UNIT = 1;
+   */
+  UNIT = 1,
+
+  /**
+   * TRUST uses the edge weights as capacities, edges without a weight carry 1.
+   *
+   * @generated from enum value: FLOW_CAPACITY_TRUST = 2;
+   */
+  TRUST = 2,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.FlowCapacity.
+ */
+export const FlowCapacitySchema: GenEnum<FlowCapacity> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 7);
+
+/**
+ * PathCost selects what makes a path short.
+ *
+ * @generated from enum internal.rpc.v1.PathCost
+ */
+export enum PathCost {
+  /**
+   * UNSPECIFIED defaults to hop counts.
+   *
+   * @generated from enum value: PATH_COST_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * HOPS counts the edges along the path.
+   *
+   * @generated from enum value: PATH_COST_HOPS = 1;
+   */
+  HOPS = 1,
+
+  /**
+   * TRUST sums -log(trust) over the edges, with the edge weight as trust, so the shortest path is the one
+   * whose trust product is highest. Edges without a weight have a trust of 1, trust above 1 is capped at
+   * 1 and edges with a trust of 0 or less are never followed.
+   *
+   * @generated from enum value: PATH_COST_TRUST = 2;
+   */
+  TRUST = 2,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.PathCost.
+ */
+export const PathCostSchema: GenEnum<PathCost> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 8);
+
+/**
+ * RecordKind tells what a record of the graph store holds.
+ *
+ * @generated from enum internal.rpc.v1.RecordKind
+ */
+export enum RecordKind {
+  /**
+   * @generated from enum value: RECORD_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * RECORD_KIND_GENERATED is a graph that was created from a RandomGraphRequest.
+   *
+   * @generated from enum value: RECORD_KIND_GENERATED = 1;
+   */
+  GENERATED = 1,
+
+  /**
+   * RECORD_KIND_IMPORTED is a graph that was loaded from a file.
+   *
+   * @generated from enum value: RECORD_KIND_IMPORTED = 2;
+   */
+  IMPORTED = 2,
+
+  /**
+   * RECORD_KIND_EXPERIMENT holds the results of an experiment, instead of a graph.
+   *
+   * @generated from enum value: RECORD_KIND_EXPERIMENT = 3;
+   */
+  EXPERIMENT = 3,
+}
+
+/**
+ * Describes the enum internal.rpc.v1.RecordKind.
+ */
+export const RecordKindSchema: GenEnum<RecordKind> = /*@__PURE__*/
+  enumDesc(file_internal_rpc_v1_rpc, 9);
+
+/**
+ * @generated from service internal.rpc.v1.GraphService
+ */
+export const GraphService: GenService<{
+  /**
+   * @generated from rpc internal.rpc.v1.GraphService.RandomGraph
+   */
+  randomGraph: {
+    methodKind: "unary";
+    input: typeof RandomGraphRequestSchema;
+    output: typeof RandomGraphResponseSchema;
+  },
+  /**
+   * @generated from rpc internal.rpc.v1.GraphService.LoadGraph
+   */
+  loadGraph: {
+    methodKind: "unary";
+    input: typeof LoadGraphRequestSchema;
+    output: typeof LoadGraphResponseSchema;
+  },
+  /**
+   * @generated from rpc internal.rpc.v1.GraphService.ExportGraph
+   */
+  exportGraph: {
+    methodKind: "unary";
+    input: typeof ExportGraphRequestSchema;
+    output: typeof ExportGraphResponseSchema;
+  },
+  /**
+   * @generated from rpc internal.rpc.v1.GraphService.CreateGraph
+   */
+  createGraph: {
+    methodKind: "unary";
+    input: typeof CreateGraphRequestSchema;
+    output: typeof CreateGraphResponseSchema;
+  },
+  /**
+   * @generated from rpc internal.rpc.v1.GraphService.RunWalks
+   */
+  runWalks: {
+    methodKind: "unary";
+    input: typeof RunWalksRequestSchema;
+    output: typeof RunWalksResponseSchema;
+  },
+  /**
+   * @generated from rpc internal.rpc.v1.GraphService.Relayout
+   */
+  relayout: {
+    methodKind: "unary";
+    input: typeof RelayoutRequestSchema;
+    output: typeof RelayoutResponseSchema;
+  },
+  /**
+   * StreamLayout applies a force-directed layout to a stored graph like Relayout, and streams snapshots
+   * of the node positions while it runs.
+   *
+   * @generated from rpc internal.rpc.v1.GraphService.StreamLayout
+   */
+  streamLayout: {
+    methodKind: "server_streaming";
+    input: typeof StreamLayoutRequestSchema;
+    output: typeof StreamLayoutResponseSchema;
+  },
+  /**
+   * ListGraphs lists the graphs and experiments in the store.
+   *
+   * @generated from rpc internal.rpc.v1.GraphService.ListGraphs
+   */
+  listGraphs: {
+    methodKind: "unary";
+    input: typeof ListGraphsRequestSchema;
+    output: typeof ListGraphsResponseSchema;
+  },
+  /**
+   * @generated from rpc internal.rpc.v1.GraphService.GetGraph
+   */
+  getGraph: {
+    methodKind: "unary";
+    input: typeof GetGraphRequestSchema;
+    output: typeof GetGraphResponseSchema;
+  },
+  /**
+   * @generated from rpc internal.rpc.v1.GraphService.DeleteGraph
+   */
+  deleteGraph: {
+    methodKind: "unary";
+    input: typeof DeleteGraphRequestSchema;
+    output: typeof DeleteGraphResponseSchema;
+  },
+  /**
+   * ComputeTrust computes how much Bob trusts every node in the graph.
+   *
+   * @generated from rpc internal.rpc.v1.GraphService.ComputeTrust
+   */
+  computeTrust: {
+    methodKind: "unary";
+    input: typeof ComputeTrustRequestSchema;
+    output: typeof ComputeTrustResponseSchema;
+  },
+  /**
+   * MaxFlow computes the maximum flow from Bob to Ada, and a minimum cut that separates them.
+   *
+   * @generated from rpc internal.rpc.v1.GraphService.MaxFlow
+   */
+  maxFlow: {
+    methodKind: "unary";
+    input: typeof MaxFlowRequestSchema;
+    output: typeof MaxFlowResponseSchema;
+  },
+  /**
+   * ShortestPaths finds the k shortest paths from Bob to Ada.
+   *
+   * @generated from rpc internal.rpc.v1.GraphService.ShortestPaths
+   */
+  shortestPaths: {
+    methodKind: "unary";
+    input: typeof ShortestPathsRequestSchema;
+    output: typeof ShortestPathsResponseSchema;
+  },
+  /**
+   * GraphStats reports the structure of the graph.
+   *
+   * @generated from rpc internal.rpc.v1.GraphService.GraphStats
+   */
+  graphStats: {
+    methodKind: "unary";
+    input: typeof GraphStatsRequestSchema;
+    output: typeof GraphStatsResponseSchema;
+  },
+  /**
+   * RunExperiment runs the walks over sweeps of the graph and walk parameters, and aggregates how often
+   * the walks of Bob and Ada meet.
+   *
+   * @generated from rpc internal.rpc.v1.GraphService.RunExperiment
+   */
+  runExperiment: {
+    methodKind: "unary";
+    input: typeof RunExperimentRequestSchema;
+    output: typeof RunExperimentResponseSchema;
+  },
+  /**
+   * Replay generates a graph again from its manifest, the graph is identical to the original.
+   *
+   * @generated from rpc internal.rpc.v1.GraphService.Replay
+   */
+  replay: {
+    methodKind: "unary";
+    input: typeof ReplayRequestSchema;
     output: typeof RandomGraphResponseSchema;
   },
 }> = /*@__PURE__*/
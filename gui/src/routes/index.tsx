@@ -114,6 +114,63 @@ function AdaWalkNode({ data }: { data: { label: string } }) {
   );
 }
 
+// A node where the walks of bob and ada first meet
+function MeetingNode({ data }: { data: { label: string } }) {
+  return (
+    <>
+      <Handle type="target" position={Position.Top} />
+      <div style={{ backgroundColor: "green", padding: "1em" }}>
+        {data.label}
+      </div>
+      <Handle type="source" position={Position.Bottom} id="a" />
+      <Handle
+        type="source"
+        position={Position.Bottom}
+        id="b"
+        style={{ left: 10 }}
+      />
+    </>
+  );
+}
+
+// A node on one of the shortest paths between bob and ada
+function PathNode({ data }: { data: { label: string } }) {
+  return (
+    <>
+      <Handle type="target" position={Position.Top} />
+      <div style={{ backgroundColor: "purple", padding: "0.1em" }}>
+        {data.label}
+      </div>
+      <Handle type="source" position={Position.Bottom} id="a" />
+      <Handle
+        type="source"
+        position={Position.Bottom}
+        id="b"
+        style={{ left: 10 }}
+      />
+    </>
+  );
+}
+
+// A node that is part of the sybil region
+function SybilNode({ data }: { data: { label: string } }) {
+  return (
+    <>
+      <Handle type="target" position={Position.Top} />
+      <div style={{ backgroundColor: "orange", padding: "0.1em" }}>
+        {data.label}
+      </div>
+      <Handle type="source" position={Position.Bottom} id="a" />
+      <Handle
+        type="source"
+        position={Position.Bottom}
+        id="b"
+        style={{ left: 10 }}
+      />
+    </>
+  );
+}
+
 export function BobWalkEdge({
   sourceX,
   sourceY,
@@ -198,11 +255,127 @@ export function UnwalkedEdge({
   );
 }
 
+export function SybilEdge({
+  sourceX,
+  sourceY,
+  targetX,
+  targetY,
+  ...props
+}: {
+  sourceX: number;
+  sourceY: number;
+  targetX: number;
+  targetY: number;
+}) {
+  const [edgePath] = getSmoothStepPath({
+    sourceX,
+    sourceY,
+    targetX,
+    targetY,
+  });
+
+  return (
+    <BaseEdge
+      path={edgePath}
+      {...props}
+      style={{ strokeWidth: 1, stroke: "orange" }}
+    />
+  );
+}
+
+export function AttackEdge({
+  sourceX,
+  sourceY,
+  targetX,
+  targetY,
+  ...props
+}: {
+  sourceX: number;
+  sourceY: number;
+  targetX: number;
+  targetY: number;
+}) {
+  const [edgePath] = getSmoothStepPath({
+    sourceX,
+    sourceY,
+    targetX,
+    targetY,
+  });
+
+  return (
+    <BaseEdge
+      path={edgePath}
+      {...props}
+      style={{ strokeWidth: 3, stroke: "orange", strokeDasharray: "5 5" }}
+    />
+  );
+}
+
+export function CutEdge({
+  sourceX,
+  sourceY,
+  targetX,
+  targetY,
+  ...props
+}: {
+  sourceX: number;
+  sourceY: number;
+  targetX: number;
+  targetY: number;
+}) {
+  const [edgePath] = getSmoothStepPath({
+    sourceX,
+    sourceY,
+    targetX,
+    targetY,
+  });
+
+  return (
+    <BaseEdge
+      path={edgePath}
+      {...props}
+      style={{ strokeWidth: 4, stroke: "crimson" }}
+    />
+  );
+}
+
+export function PathEdge({
+  sourceX,
+  sourceY,
+  targetX,
+  targetY,
+  ...props
+}: {
+  sourceX: number;
+  sourceY: number;
+  targetX: number;
+  targetY: number;
+}) {
+  const [edgePath] = getSmoothStepPath({
+    sourceX,
+    sourceY,
+    targetX,
+    targetY,
+  });
+
+  return (
+    <BaseEdge
+      path={edgePath}
+      {...props}
+      style={{ strokeWidth: 3, stroke: "purple" }}
+    />
+  );
+}
+
 // custom edge types.
 const edgeTypes = {
   bobWalkEdge: BobWalkEdge,
   adaWalkEdge: AdaWalkEdge,
   unwalkedEdge: UnwalkedEdge,
+  sybilEdge: SybilEdge,
+  attackEdge: AttackEdge,
+  cutEdge: CutEdge,
+  pathEdge: PathEdge,
 };
 
 // Register custom node types
@@ -212,6 +385,9 @@ const nodeTypes = {
   bobWalkNode: BobWalkNode,
   adaNode: AdaNode,
   adaWalkNode: AdaWalkNode,
+  meetingNode: MeetingNode,
+  sybilNode: SybilNode,
+  pathNode: PathNode,
 };
 
 // declare the route for this page.
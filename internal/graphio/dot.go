@@ -0,0 +1,61 @@
+package graphio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// writeDOT writes the graph in the Graphviz DOT language. Positions are pinned with "pos", types are
+// written as the "class" attribute and walk annotations as a "walks" attribute. Weights are quoted, as
+// DOT numerals have no exponents and no infinity.
+func writeDOT(w io.Writer, graph *rpcv1.RandomGraphResponse) error {
+	ann := annotate(graph)
+	bw := bufio.NewWriter(w)
+
+	kind, connector := "graph", "--"
+	if graph.GetDirected() {
+		kind, connector = "digraph", "->"
+	}
+
+	fmt.Fprintf(bw, "%s trustd {\n", kind)
+	for _, node := range graph.GetNodes() {
+		fmt.Fprintf(bw, "  %s [label=%s, class=%s, pos=\"%d,%d!\"",
+			dotQuote(node.GetId()), dotQuote(label(node)), dotQuote(node.GetType()),
+			node.GetPosition().GetX(), node.GetPosition().GetY())
+		if walks := ann.nodes[node.GetId()]; len(walks) > 0 {
+			fmt.Fprintf(bw, ", walks=%s", dotQuote(joinInts(walks)))
+		}
+		fmt.Fprintln(bw, "];")
+	}
+
+	for _, edge := range graph.GetEdges() {
+		fmt.Fprintf(bw, "  %s %s %s [id=%s, class=%s",
+			dotQuote(edge.GetSource()), connector, dotQuote(edge.GetTarget()),
+			dotQuote(edge.GetId()), dotQuote(edge.GetType()))
+		if edge.HasWeight() {
+			fmt.Fprintf(bw, ", weight=%s", dotQuote(formatWeight(edge)))
+		}
+		if walks := ann.edges[edge.GetId()]; len(walks) > 0 {
+			fmt.Fprintf(bw, ", walks=%s", dotQuote(joinInts(walks)))
+		}
+		fmt.Fprintln(bw, "];")
+	}
+	fmt.Fprintln(bw, "}")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write DOT: %w", err)
+	}
+	return nil
+}
+
+// dotEscaper escapes the quotes and backslashes of a quoted DOT ID, DOT has no other escapes.
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// dotQuote returns s as a quoted DOT ID.
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
@@ -0,0 +1,45 @@
+package graphio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// readEdgeList parses lines of whitespace or comma separated "source target" pairs, with an optional third
+// column holding the weight. Any further columns are ignored, as are empty lines and comments starting
+// with '#' or '%' (as used by SNAP and KONECT).
+func readEdgeList(r io.Reader, bld *builder) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		fields := strings.FieldsFunc(line, func(c rune) bool {
+			return c == ',' || c == ' ' || c == '\t' || c == ';'
+		})
+		if len(fields) < 2 {
+			return fmt.Errorf("line %d: expected at least two columns, got: %q", lineNo, line)
+		}
+
+		weight := ""
+		if len(fields) > 2 {
+			weight = fields[2]
+		}
+
+		if err := bld.edge(fields[0], fields[1], weight); err != nil {
+			return fmt.Errorf("line %d: %w", lineNo, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to scan edge list: %w", err)
+	}
+
+	return nil
+}
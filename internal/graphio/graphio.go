@@ -0,0 +1,208 @@
+// Package graphio reads and writes graphs in common graph file formats.
+package graphio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// Format identifies a graph file format.
+type Format int
+
+const (
+	// FormatUnknown is returned when the format cannot be determined.
+	FormatUnknown Format = iota
+	// FormatEdgeList is a plain text file with one "source target" pair per line.
+	FormatEdgeList
+	// FormatGraphML is the XML based GraphML format.
+	FormatGraphML
+	// FormatGEXF is the XML based format of Gephi.
+	FormatGEXF
+	// FormatDOT is the Graphviz DOT language, it can only be written.
+	FormatDOT
+	// FormatJSON is trustd's own JSON schema, it can only be written.
+	FormatJSON
+)
+
+// ParseFormat parses the (lowercase) name of a format.
+func ParseFormat(name string) Format {
+	switch name {
+	case "edgelist":
+		return FormatEdgeList
+	case "graphml":
+		return FormatGraphML
+	case "gexf":
+		return FormatGEXF
+	case "dot":
+		return FormatDOT
+	case "json":
+		return FormatJSON
+	default:
+		return FormatUnknown
+	}
+}
+
+// Extension returns the file extension for the format.
+func (f Format) Extension() string {
+	switch f {
+	case FormatEdgeList:
+		return ".txt"
+	case FormatGraphML:
+		return ".graphml"
+	case FormatGEXF:
+		return ".gexf"
+	case FormatDOT:
+		return ".dot"
+	case FormatJSON:
+		return ".json"
+	case FormatUnknown:
+	}
+	return ""
+}
+
+// ContentType returns the media type for the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatGraphML, FormatGEXF:
+		return "application/xml"
+	case FormatDOT:
+		return "text/vnd.graphviz"
+	case FormatJSON:
+		return "application/json"
+	case FormatEdgeList, FormatUnknown:
+	}
+	return "text/plain; charset=utf-8"
+}
+
+// FormatFromPath determines the format from the file extension.
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".txt", ".csv", ".tsv", ".edges", ".edgelist", ".el":
+		return FormatEdgeList
+	case ".graphml":
+		return FormatGraphML
+	case ".gexf":
+		return FormatGEXF
+	default:
+		return FormatUnknown
+	}
+}
+
+// Read parses a graph in the given format. Edges are treated as undirected, unless a GraphML or GEXF
+// document declares its edges as directed. Duplicate edges and self-loops are dropped. Nodes are
+// positioned on a circle and get the "labelNode" type.
+func Read(r io.Reader, format Format) (*rpcv1.RandomGraphResponse, error) {
+	bld := newBuilder()
+
+	var err error
+	switch format {
+	case FormatEdgeList:
+		err = readEdgeList(r, bld)
+	case FormatGraphML:
+		err = readGraphML(r, bld)
+	case FormatGEXF:
+		err = readGEXF(r, bld)
+	case FormatDOT, FormatJSON, FormatUnknown:
+		return nil, errors.New("unsupported graph format")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bld.build(), nil
+}
+
+// builder collects nodes and edges while parsing.
+type builder struct {
+	nodes    []*rpcv1.Node
+	nodeIx   map[string]int
+	edges    []*rpcv1.Edge
+	seen     map[[2]string]bool
+	directed bool
+}
+
+func newBuilder() *builder {
+	return &builder{nodeIx: map[string]int{}, seen: map[[2]string]bool{}}
+}
+
+// node adds the node if it wasn't added before, the label is set if it is not empty.
+func (b *builder) node(id, label string) {
+	idx, ok := b.nodeIx[id]
+	if !ok {
+		node := &rpcv1.Node{}
+		node.SetId(id)
+		node.SetType("labelNode")
+		idx = len(b.nodes)
+		b.nodeIx[id] = idx
+		b.nodes = append(b.nodes, node)
+	}
+
+	if label != "" {
+		data := &rpcv1.NodeData{}
+		data.SetLabel(label)
+		b.nodes[idx].SetData(data)
+	}
+}
+
+// edge adds the edge, adding its nodes if necessary. The weight is only set if it isn't empty.
+func (b *builder) edge(source, target, weight string) error {
+	var w float64
+	if weight != "" {
+		var err error
+		if w, err = strconv.ParseFloat(weight, 64); err != nil {
+			return fmt.Errorf("invalid weight %q: %w", weight, err)
+		}
+	}
+
+	b.node(source, "")
+	b.node(target, "")
+	if source == target {
+		return nil
+	}
+
+	key := [2]string{source, target}
+	if !b.directed {
+		key = [2]string{min(source, target), max(source, target)}
+	}
+	if b.seen[key] {
+		return nil
+	}
+	b.seen[key] = true
+
+	e := &rpcv1.Edge{}
+	e.SetId(fmt.Sprintf("e-%d", len(b.edges)))
+	e.SetSource(source)
+	e.SetTarget(target)
+	if weight != "" {
+		e.SetWeight(w)
+	}
+	b.edges = append(b.edges, e)
+	return nil
+}
+
+// build positions the nodes on a circle and returns the graph.
+func (b *builder) build() *rpcv1.RandomGraphResponse {
+	radius := 300.0
+	for i, node := range b.nodes {
+		angle := 2.0 * math.Pi * float64(i) / float64(len(b.nodes))
+
+		pos := &rpcv1.Position{}
+		pos.SetX(int64(radius * math.Cos(angle)))
+		pos.SetY(int64(radius * math.Sin(angle)))
+		node.SetPosition(pos)
+	}
+
+	resp := &rpcv1.RandomGraphResponse{}
+	resp.SetNodes(b.nodes)
+	resp.SetEdges(b.edges)
+	resp.SetDirected(b.directed)
+	return resp
+}
@@ -0,0 +1,186 @@
+package graphio_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/advdv/trustd/internal/graphio"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// shape is what must survive a round trip: the direction, the node ids and the edges as
+// "id source target weight", with a weight of "-" for unweighted edges.
+type shape struct {
+	directed bool
+	nodes    []string
+	edges    []string
+}
+
+func edgeShape(id, source, target string, weight *float64) string {
+	w := "-"
+	if weight != nil {
+		w = strconv.FormatFloat(*weight, 'g', -1, 64)
+	}
+	return fmt.Sprintf("%s %s %s %s", id, source, target, w)
+}
+
+func shapeOf(graph *rpcv1.RandomGraphResponse) shape {
+	s := shape{directed: graph.GetDirected()}
+	for _, node := range graph.GetNodes() {
+		s.nodes = append(s.nodes, node.GetId())
+	}
+	for _, edge := range graph.GetEdges() {
+		var weight *float64
+		if edge.HasWeight() {
+			weight = new(float64)
+			*weight = edge.GetWeight()
+		}
+		s.edges = append(s.edges, edgeShape(edge.GetId(), edge.GetSource(), edge.GetTarget(), weight))
+	}
+	return s
+}
+
+// testGraph returns a small graph with a node id that needs escaping, and weights that are written with an
+// exponent, are negative or are missing.
+func testGraph(directed bool) *rpcv1.RandomGraphResponse {
+	graph := &rpcv1.RandomGraphResponse{}
+	graph.SetDirected(directed)
+
+	var nodes []*rpcv1.Node
+	for _, id := range []string{"a", `q"\`, "c"} {
+		node := &rpcv1.Node{}
+		node.SetId(id)
+		nodes = append(nodes, node)
+	}
+	graph.SetNodes(nodes)
+
+	var edges []*rpcv1.Edge
+	for i, e := range []struct {
+		source, target string
+		weight         float64
+	}{{"a", `q"\`, 0.5}, {`q"\`, "c", 1e-05}, {"c", "a", -2}, {"a", "c", 0}} {
+		edge := &rpcv1.Edge{}
+		edge.SetId(fmt.Sprintf("e-%d", i))
+		edge.SetSource(e.source)
+		edge.SetTarget(e.target)
+		if e.weight != 0 {
+			edge.SetWeight(e.weight)
+		}
+		edges = append(edges, edge)
+	}
+	if !directed {
+		// a-c and c-a are the same undirected edge, which the readers would drop.
+		edges = edges[:3]
+	}
+	graph.SetEdges(edges)
+	return graph
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		format   graphio.Format
+		directed bool
+	}{
+		// edge lists don't record the direction, they are read as undirected.
+		{graphio.FormatEdgeList, false},
+		{graphio.FormatGraphML, false},
+		{graphio.FormatGraphML, true},
+		{graphio.FormatGEXF, false},
+		{graphio.FormatGEXF, true},
+	} {
+		t.Run(fmt.Sprintf("%s directed=%v", tt.format.Extension(), tt.directed), func(t *testing.T) {
+			graph := testGraph(tt.directed)
+			var buf bytes.Buffer
+			if err := graphio.Write(&buf, graph, tt.format); err != nil {
+				t.Fatal(err)
+			}
+			read, err := graphio.Read(&buf, tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := shapeOf(read), shapeOf(graph); !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestRoundTripJSON(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		graph := testGraph(directed)
+		var buf bytes.Buffer
+		if err := graphio.Write(&buf, graph, graphio.FormatJSON); err != nil {
+			t.Fatal(err)
+		}
+
+		var doc graphio.JSONGraph
+		if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+			t.Fatal(err)
+		}
+		got := shape{directed: doc.Directed}
+		for _, node := range doc.Nodes {
+			got.nodes = append(got.nodes, node.ID)
+		}
+		for _, edge := range doc.Edges {
+			got.edges = append(got.edges, edgeShape(edge.ID, edge.Source, edge.Target, edge.Weight))
+		}
+		if want := shapeOf(graph); !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %+v, want %+v", got, want)
+		}
+	}
+}
+
+// dotID matches a quoted DOT ID, in which only quotes and backslashes are escaped.
+const dotID = `("(?:[^"\\]|\\.)*")`
+
+var (
+	dotNode = regexp.MustCompile(`^  ` + dotID + ` \[`)
+	dotEdge = regexp.MustCompile(`^  ` + dotID + ` (--|->) ` + dotID + ` \[id=` + dotID + `, class=` + dotID +
+		`(?:, weight=` + dotID + `)?`)
+	dotUnescaper = strings.NewReplacer(`\\`, `\`, `\"`, `"`)
+)
+
+func dotUnquote(id string) string {
+	return dotUnescaper.Replace(id[1 : len(id)-1])
+}
+
+func TestRoundTripDOT(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		graph := testGraph(directed)
+		var buf bytes.Buffer
+		if err := graphio.Write(&buf, graph, graphio.FormatDOT); err != nil {
+			t.Fatal(err)
+		}
+
+		got := shape{directed: strings.HasPrefix(buf.String(), "digraph ")}
+		scanner := bufio.NewScanner(&buf)
+		for scanner.Scan() {
+			if m := dotEdge.FindStringSubmatch(scanner.Text()); m != nil {
+				if (m[2] == "->") != directed {
+					t.Fatalf("edge %s uses the connector %s", m[4], m[2])
+				}
+				var weight *float64
+				if m[6] != "" {
+					w, err := strconv.ParseFloat(dotUnquote(m[6]), 64)
+					if err != nil {
+						t.Fatal(err)
+					}
+					weight = &w
+				}
+				got.edges = append(got.edges, edgeShape(dotUnquote(m[4]), dotUnquote(m[1]), dotUnquote(m[3]), weight))
+			} else if m := dotNode.FindStringSubmatch(scanner.Text()); m != nil {
+				got.nodes = append(got.nodes, dotUnquote(m[1]))
+			}
+		}
+		if want := shapeOf(graph); !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %+v, want %+v", got, want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package graphio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// JSONVersion is the version of the JSON schema, it is increased on every breaking change.
+const JSONVersion = 1
+
+// JSONGraph is the stable JSON representation of a graph. Unlike the protojson encoding of the rpc
+// messages it only changes together with JSONVersion, which makes it suitable for notebooks and scripts.
+type JSONGraph struct {
+	Version      int               `json:"version"`
+	Directed     bool              `json:"directed"`
+	Nodes        []JSONNode        `json:"nodes"`
+	Edges        []JSONEdge        `json:"edges"`
+	Walks        []JSONWalk        `json:"walks"`
+	Intersection *JSONIntersection `json:"intersection,omitempty"`
+}
+
+// JSONNode is a node in the JSON schema.
+type JSONNode struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	X     int64  `json:"x"`
+	Y     int64  `json:"y"`
+	Walks []int  `json:"walks"`
+}
+
+// JSONEdge is an edge in the JSON schema.
+type JSONEdge struct {
+	ID     string `json:"id"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Type   string `json:"type"`
+	// Weight is only set for weighted edges.
+	Weight *float64 `json:"weight,omitempty"`
+	Walks  []int    `json:"walks"`
+}
+
+// JSONWalk is a walk in the JSON schema.
+type JSONWalk struct {
+	Owner    string   `json:"owner"`
+	Instance int64    `json:"instance"`
+	Nodes    []string `json:"nodes"`
+	Edges    []string `json:"edges"`
+}
+
+// JSONIntersection is the meeting point of the walks in the JSON schema.
+type JSONIntersection struct {
+	BobWalk   int64    `json:"bob_walk"`
+	AdaWalk   int64    `json:"ada_walk"`
+	Node      string   `json:"node"`
+	Edge      string   `json:"edge"`
+	BobStep   int64    `json:"bob_step"`
+	AdaStep   int64    `json:"ada_step"`
+	PathNodes []string `json:"path_nodes"`
+	PathEdges []string `json:"path_edges"`
+}
+
+// ToJSON converts the graph to its JSON representation.
+func ToJSON(graph *rpcv1.RandomGraphResponse) JSONGraph {
+	ann := annotate(graph)
+	out := JSONGraph{
+		Version:  JSONVersion,
+		Directed: graph.GetDirected(),
+		Nodes:    make([]JSONNode, 0, len(graph.GetNodes())),
+		Edges:    make([]JSONEdge, 0, len(graph.GetEdges())),
+		Walks:    make([]JSONWalk, 0, len(graph.GetWalks())),
+	}
+
+	for _, node := range graph.GetNodes() {
+		out.Nodes = append(out.Nodes, JSONNode{
+			ID:    node.GetId(),
+			Label: label(node),
+			Type:  node.GetType(),
+			X:     node.GetPosition().GetX(),
+			Y:     node.GetPosition().GetY(),
+			Walks: nonNil(ann.nodes[node.GetId()]),
+		})
+	}
+
+	for _, edge := range graph.GetEdges() {
+		var weight *float64
+		if edge.HasWeight() {
+			weight = new(float64)
+			*weight = edge.GetWeight()
+		}
+
+		out.Edges = append(out.Edges, JSONEdge{
+			ID:     edge.GetId(),
+			Source: edge.GetSource(),
+			Target: edge.GetTarget(),
+			Type:   edge.GetType(),
+			Weight: weight,
+			Walks:  nonNil(ann.edges[edge.GetId()]),
+		})
+	}
+
+	for _, walk := range graph.GetWalks() {
+		out.Walks = append(out.Walks, JSONWalk{
+			Owner:    strings.ToLower(strings.TrimPrefix(walk.GetOwner().String(), "PARTY_")),
+			Instance: walk.GetInstance(),
+			Nodes:    nonNil(walk.GetNodeIds()),
+			Edges:    nonNil(walk.GetEdgeIds()),
+		})
+	}
+
+	if graph.HasIntersection() {
+		isect := graph.GetIntersection()
+		out.Intersection = &JSONIntersection{
+			BobWalk:   isect.GetBobWalk(),
+			AdaWalk:   isect.GetAdaWalk(),
+			Node:      isect.GetNodeId(),
+			Edge:      isect.GetEdgeId(),
+			BobStep:   isect.GetBobStep(),
+			AdaStep:   isect.GetAdaStep(),
+			PathNodes: nonNil(isect.GetPathNodeIds()),
+			PathEdges: nonNil(isect.GetPathEdgeIds()),
+		}
+	}
+
+	return out
+}
+
+// nonNil makes sure empty lists are encoded as [] instead of null.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func writeJSON(w io.Writer, graph *rpcv1.RandomGraphResponse) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(ToJSON(graph)); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return nil
+}
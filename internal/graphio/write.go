@@ -0,0 +1,94 @@
+package graphio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// Write serializes the graph in the given format. Besides the structure of the graph and the edge weights,
+// the node and edge types, node positions and walk annotations are written.
+func Write(w io.Writer, graph *rpcv1.RandomGraphResponse, format Format) error {
+	switch format {
+	case FormatEdgeList:
+		return writeEdgeList(w, graph)
+	case FormatGraphML:
+		return writeGraphML(w, graph)
+	case FormatGEXF:
+		return writeGEXF(w, graph)
+	case FormatDOT:
+		return writeDOT(w, graph)
+	case FormatJSON:
+		return writeJSON(w, graph)
+	case FormatUnknown:
+	}
+	return errors.New("unsupported graph format")
+}
+
+// annotations lists, for every node and edge, the indices of the walks that visited it.
+type annotations struct {
+	nodes map[string][]int
+	edges map[string][]int
+}
+
+func annotate(graph *rpcv1.RandomGraphResponse) annotations {
+	ann := annotations{nodes: map[string][]int{}, edges: map[string][]int{}}
+	add := func(m map[string][]int, id string, walk int) {
+		if ids := m[id]; len(ids) == 0 || ids[len(ids)-1] != walk {
+			m[id] = append(ids, walk)
+		}
+	}
+
+	for i, walk := range graph.GetWalks() {
+		for _, id := range walk.GetNodeIds() {
+			add(ann.nodes, id, i)
+		}
+		for _, id := range walk.GetEdgeIds() {
+			add(ann.edges, id, i)
+		}
+	}
+	return ann
+}
+
+// joinInts formats the walk indices as a comma separated list.
+func joinInts(ints []int) string {
+	strs := make([]string, 0, len(ints))
+	for _, i := range ints {
+		strs = append(strs, strconv.Itoa(i))
+	}
+	return strings.Join(strs, ",")
+}
+
+// label returns the node's label, which defaults to its id.
+func label(node *rpcv1.Node) string {
+	if l := node.GetData().GetLabel(); l != "" {
+		return l
+	}
+	return node.GetId()
+}
+
+// formatWeight formats the edge's weight, it is empty if the edge has no weight.
+func formatWeight(edge *rpcv1.Edge) string {
+	if !edge.HasWeight() {
+		return ""
+	}
+	return strconv.FormatFloat(edge.GetWeight(), 'g', -1, 64)
+}
+
+func writeEdgeList(w io.Writer, graph *rpcv1.RandomGraphResponse) error {
+	for _, edge := range graph.GetEdges() {
+		line := edge.GetSource() + " " + edge.GetTarget()
+		if edge.HasWeight() {
+			line += " " + formatWeight(edge)
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return fmt.Errorf("failed to write edge: %w", err)
+		}
+	}
+	return nil
+}
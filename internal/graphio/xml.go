@@ -0,0 +1,320 @@
+package graphio
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// graphML mirrors the parts of a GraphML document we care about.
+type graphML struct {
+	Keys []struct {
+		ID       string `xml:"id,attr"`
+		For      string `xml:"for,attr"`
+		AttrName string `xml:"attr.name,attr"`
+	} `xml:"key"`
+	Graph struct {
+		EdgeDefault string `xml:"edgedefault,attr"`
+		Nodes       []struct {
+			ID   string        `xml:"id,attr"`
+			Data []graphMLData `xml:"data"`
+		} `xml:"node"`
+		Edges []struct {
+			Source string        `xml:"source,attr"`
+			Target string        `xml:"target,attr"`
+			Data   []graphMLData `xml:"data"`
+		} `xml:"edge"`
+	} `xml:"graph"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// readGraphML parses a GraphML document. Node labels are read from the node key named "label", and edge
+// weights from the edge key named "weight".
+func readGraphML(r io.Reader, bld *builder) error {
+	var doc graphML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return fmt.Errorf("failed to decode GraphML: %w", err)
+	}
+
+	bld.directed = doc.Graph.EdgeDefault == "directed"
+
+	labelKey, weightKey := "", ""
+	for _, key := range doc.Keys {
+		if key.AttrName == "label" && (key.For == "node" || key.For == "all") {
+			labelKey = key.ID
+		}
+		if key.AttrName == "weight" && (key.For == "edge" || key.For == "all") {
+			weightKey = key.ID
+		}
+	}
+
+	for _, node := range doc.Graph.Nodes {
+		label := ""
+		for _, data := range node.Data {
+			if labelKey != "" && data.Key == labelKey {
+				label = data.Value
+			}
+		}
+		bld.node(node.ID, label)
+	}
+
+	for _, edge := range doc.Graph.Edges {
+		weight := ""
+		for _, data := range edge.Data {
+			if weightKey != "" && data.Key == weightKey {
+				weight = strings.TrimSpace(data.Value)
+			}
+		}
+
+		if err := bld.edge(edge.Source, edge.Target, weight); err != nil {
+			return fmt.Errorf("edge %s-%s: %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// gexf mirrors the parts of a GEXF document we care about.
+type gexf struct {
+	Graph struct {
+		DefaultEdgeType string `xml:"defaultedgetype,attr"`
+		Nodes           []struct {
+			ID    string `xml:"id,attr"`
+			Label string `xml:"label,attr"`
+		} `xml:"nodes>node"`
+		Edges []struct {
+			Source string `xml:"source,attr"`
+			Target string `xml:"target,attr"`
+			Weight string `xml:"weight,attr"`
+		} `xml:"edges>edge"`
+	} `xml:"graph"`
+}
+
+// readGEXF parses a GEXF document.
+func readGEXF(r io.Reader, bld *builder) error {
+	var doc gexf
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return fmt.Errorf("failed to decode GEXF: %w", err)
+	}
+
+	bld.directed = doc.Graph.DefaultEdgeType == "directed"
+
+	for _, node := range doc.Graph.Nodes {
+		bld.node(node.ID, node.Label)
+	}
+
+	for _, edge := range doc.Graph.Edges {
+		if err := bld.edge(edge.Source, edge.Target, edge.Weight); err != nil {
+			return fmt.Errorf("edge %s-%s: %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// xmlAttr is a generic attribute in the output documents.
+type xmlAttr struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLKeyOut struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLNodeOut struct {
+	ID   string    `xml:"id,attr"`
+	Data []xmlAttr `xml:"data"`
+}
+
+type graphMLEdgeOut struct {
+	ID     string    `xml:"id,attr"`
+	Source string    `xml:"source,attr"`
+	Target string    `xml:"target,attr"`
+	Data   []xmlAttr `xml:"data"`
+}
+
+type graphMLOut struct {
+	XMLName xml.Name        `xml:"graphml"`
+	XMLNS   string          `xml:"xmlns,attr"`
+	Keys    []graphMLKeyOut `xml:"key"`
+	Graph   struct {
+		ID          string           `xml:"id,attr"`
+		EdgeDefault string           `xml:"edgedefault,attr"`
+		Nodes       []graphMLNodeOut `xml:"node"`
+		Edges       []graphMLEdgeOut `xml:"edge"`
+	} `xml:"graph"`
+}
+
+// writeGraphML writes the graph as a GraphML document.
+func writeGraphML(w io.Writer, graph *rpcv1.RandomGraphResponse) error {
+	ann := annotate(graph)
+	doc := graphMLOut{XMLNS: "http://graphml.graphdrawing.org/xmlns"}
+	doc.Keys = []graphMLKeyOut{
+		{ID: "label", For: "node", AttrName: "label", AttrType: "string"},
+		{ID: "type", For: "node", AttrName: "type", AttrType: "string"},
+		{ID: "x", For: "node", AttrName: "x", AttrType: "double"},
+		{ID: "y", For: "node", AttrName: "y", AttrType: "double"},
+		{ID: "walks", For: "node", AttrName: "walks", AttrType: "string"},
+		{ID: "edge_type", For: "edge", AttrName: "type", AttrType: "string"},
+		{ID: "edge_walks", For: "edge", AttrName: "walks", AttrType: "string"},
+		{ID: "weight", For: "edge", AttrName: "weight", AttrType: "double"},
+	}
+	doc.Graph.ID = "trustd"
+	doc.Graph.EdgeDefault = edgeDefault(graph)
+
+	for _, node := range graph.GetNodes() {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNodeOut{ID: node.GetId(), Data: []xmlAttr{
+			{Key: "label", Value: label(node)},
+			{Key: "type", Value: node.GetType()},
+			{Key: "x", Value: strconv.FormatInt(node.GetPosition().GetX(), 10)},
+			{Key: "y", Value: strconv.FormatInt(node.GetPosition().GetY(), 10)},
+			{Key: "walks", Value: joinInts(ann.nodes[node.GetId()])},
+		}})
+	}
+
+	for _, edge := range graph.GetEdges() {
+		data := []xmlAttr{
+			{Key: "edge_type", Value: edge.GetType()},
+			{Key: "edge_walks", Value: joinInts(ann.edges[edge.GetId()])},
+		}
+		if edge.HasWeight() {
+			data = append(data, xmlAttr{Key: "weight", Value: formatWeight(edge)})
+		}
+
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdgeOut{
+			ID: edge.GetId(), Source: edge.GetSource(), Target: edge.GetTarget(), Data: data,
+		})
+	}
+
+	return encodeXML(w, doc)
+}
+
+type gexfAttrDecl struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfAttrValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type gexfAttributes struct {
+	Class      string         `xml:"class,attr"`
+	Attributes []gexfAttrDecl `xml:"attribute"`
+}
+
+type gexfPosition struct {
+	X int64 `xml:"x,attr"`
+	Y int64 `xml:"y,attr"`
+	Z int64 `xml:"z,attr"`
+}
+
+type gexfNodeOut struct {
+	ID        string          `xml:"id,attr"`
+	Label     string          `xml:"label,attr"`
+	AttValues []gexfAttrValue `xml:"attvalues>attvalue"`
+	Position  gexfPosition    `xml:"viz:position"`
+}
+
+type gexfEdgeOut struct {
+	ID        string          `xml:"id,attr"`
+	Source    string          `xml:"source,attr"`
+	Target    string          `xml:"target,attr"`
+	Weight    string          `xml:"weight,attr,omitempty"`
+	AttValues []gexfAttrValue `xml:"attvalues>attvalue"`
+}
+
+type gexfOut struct {
+	XMLName  xml.Name `xml:"gexf"`
+	XMLNS    string   `xml:"xmlns,attr"`
+	XMLNSViz string   `xml:"xmlns:viz,attr"`
+	Version  string   `xml:"version,attr"`
+	Graph    struct {
+		DefaultEdgeType string           `xml:"defaultedgetype,attr"`
+		Mode            string           `xml:"mode,attr"`
+		Attributes      []gexfAttributes `xml:"attributes"`
+		Nodes           []gexfNodeOut    `xml:"nodes>node"`
+		Edges           []gexfEdgeOut    `xml:"edges>edge"`
+	} `xml:"graph"`
+}
+
+// writeGEXF writes the graph as a GEXF 1.3 document, positions are written with the viz extension.
+func writeGEXF(w io.Writer, graph *rpcv1.RandomGraphResponse) error {
+	ann := annotate(graph)
+	doc := gexfOut{XMLNS: "http://gexf.net/1.3", XMLNSViz: "http://gexf.net/1.3/viz", Version: "1.3"}
+	doc.Graph.DefaultEdgeType = edgeDefault(graph)
+	doc.Graph.Mode = "static"
+	doc.Graph.Attributes = []gexfAttributes{
+		{Class: "node", Attributes: []gexfAttrDecl{
+			{ID: "type", Title: "type", Type: "string"},
+			{ID: "walks", Title: "walks", Type: "string"},
+		}},
+		{Class: "edge", Attributes: []gexfAttrDecl{
+			{ID: "type", Title: "type", Type: "string"},
+			{ID: "walks", Title: "walks", Type: "string"},
+		}},
+	}
+
+	for _, node := range graph.GetNodes() {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, gexfNodeOut{
+			ID:    node.GetId(),
+			Label: label(node),
+			AttValues: []gexfAttrValue{
+				{For: "type", Value: node.GetType()},
+				{For: "walks", Value: joinInts(ann.nodes[node.GetId()])},
+			},
+			Position: gexfPosition{X: node.GetPosition().GetX(), Y: node.GetPosition().GetY()},
+		})
+	}
+
+	for _, edge := range graph.GetEdges() {
+		doc.Graph.Edges = append(doc.Graph.Edges, gexfEdgeOut{
+			ID:     edge.GetId(),
+			Source: edge.GetSource(),
+			Target: edge.GetTarget(),
+			Weight: formatWeight(edge),
+			AttValues: []gexfAttrValue{
+				{For: "type", Value: edge.GetType()},
+				{For: "walks", Value: joinInts(ann.edges[edge.GetId()])},
+			},
+		})
+	}
+
+	return encodeXML(w, doc)
+}
+
+// edgeDefault returns the direction of the edges as named by GraphML and GEXF.
+func edgeDefault(graph *rpcv1.RandomGraphResponse) string {
+	if graph.GetDirected() {
+		return "directed"
+	}
+	return "undirected"
+}
+
+// encodeXML writes the document with an XML header.
+func encodeXML(w io.Writer, doc any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write XML header: %w", err)
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode XML: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Package maxflow computes maximum flows and minimum cuts in capacitated networks.
+package maxflow
+
+import (
+	"context"
+	"math"
+)
+
+// eps is the residual capacity below which an arc is considered saturated.
+const eps = 1e-12
+
+// Network is a directed network with real valued capacities, nodes are numbered from 0. Capacities may
+// be infinite.
+type Network struct {
+	arcs  []arc
+	adj   [][]int
+	level []int
+	next  []int
+}
+
+// arc is one direction of an edge, arcs are stored in pairs so that the reverse of arc i is i^1.
+type arc struct {
+	to       int
+	capacity float64
+	flow     float64
+}
+
+// New returns a network with n nodes and no edges.
+func New(n int) *Network {
+	return &Network{adj: make([][]int, n), level: make([]int, n), next: make([]int, n)}
+}
+
+// Len returns the number of nodes in the network.
+func (nw *Network) Len() int {
+	return len(nw.adj)
+}
+
+// AddEdge adds a directed edge and returns its index, which can be used to read its flow.
+func (nw *Network) AddEdge(from, to int, capacity float64) int {
+	idx := len(nw.arcs)
+	nw.arcs = append(nw.arcs, arc{to: to, capacity: capacity}, arc{to: from})
+	nw.adj[from] = append(nw.adj[from], idx)
+	nw.adj[to] = append(nw.adj[to], idx+1)
+	return idx / 2
+}
+
+// Flow returns the flow over the edge with the given index.
+func (nw *Network) Flow(edge int) float64 {
+	return nw.arcs[2*edge].flow
+}
+
+// Saturated reports whether the flow over the edge with the given index is at its capacity.
+func (nw *Network) Saturated(edge int) bool {
+	a := nw.arcs[2*edge]
+	return a.capacity-a.flow <= eps
+}
+
+// MaxFlow computes a maximum flow from source to sink with Dinic's algorithm, and returns its value.
+// Flows of earlier calls are kept, so calling it again only adds flow that became possible since. Once
+// the context is done it stops with its error, the flow found so far is kept.
+func (nw *Network) MaxFlow(ctx context.Context, source, sink int) (float64, error) {
+	if source == sink {
+		return 0, nil
+	}
+
+	var total float64
+	for nw.bfs(source, sink) {
+		clear(nw.next)
+		for {
+			if err := ctx.Err(); err != nil {
+				return total, err
+			}
+
+			pushed := nw.dfs(source, sink, math.Inf(1))
+			if pushed <= eps {
+				break
+			}
+			total += pushed
+		}
+	}
+	return total, nil
+}
+
+// MinCut returns, after MaxFlow, which nodes are on the source side of a minimum cut: the nodes that
+// are reachable from the source in the residual network.
+func (nw *Network) MinCut(source int) []bool {
+	reachable := make([]bool, len(nw.adj))
+	reachable[source] = true
+
+	queue := []int{source}
+	for head := 0; head < len(queue); head++ {
+		for _, ai := range nw.adj[queue[head]] {
+			a := nw.arcs[ai]
+			if !reachable[a.to] && a.capacity-a.flow > eps {
+				reachable[a.to] = true
+				queue = append(queue, a.to)
+			}
+		}
+	}
+	return reachable
+}
+
+// bfs builds the level graph and reports whether the sink can still be reached.
+func (nw *Network) bfs(source, sink int) bool {
+	for i := range nw.level {
+		nw.level[i] = -1
+	}
+	nw.level[source] = 0
+
+	queue := []int{source}
+	for head := 0; head < len(queue); head++ {
+		v := queue[head]
+		for _, ai := range nw.adj[v] {
+			a := nw.arcs[ai]
+			if nw.level[a.to] < 0 && a.capacity-a.flow > eps {
+				nw.level[a.to] = nw.level[v] + 1
+				queue = append(queue, a.to)
+			}
+		}
+	}
+	return nw.level[sink] >= 0
+}
+
+// dfs pushes a blocking flow of at most limit along the level graph.
+func (nw *Network) dfs(v, sink int, limit float64) float64 {
+	if v == sink {
+		return limit
+	}
+
+	for ; nw.next[v] < len(nw.adj[v]); nw.next[v]++ {
+		ai := nw.adj[v][nw.next[v]]
+		a := &nw.arcs[ai]
+		if nw.level[a.to] != nw.level[v]+1 || a.capacity-a.flow <= eps {
+			continue
+		}
+
+		if pushed := nw.dfs(a.to, sink, min(limit, a.capacity-a.flow)); pushed > eps {
+			nw.arcs[ai].flow += pushed
+			nw.arcs[ai^1].flow -= pushed
+			return pushed
+		}
+	}
+	return 0
+}
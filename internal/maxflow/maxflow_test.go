@@ -0,0 +1,84 @@
+package maxflow_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/advdv/trustd/internal/maxflow"
+)
+
+type edge struct {
+	from, to int
+	capacity float64
+}
+
+func TestMaxFlow(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		n            int
+		edges        []edge
+		source, sink int
+		want         float64
+	}{
+		{"single path", 3, []edge{{0, 1, 3}, {1, 2, 2}}, 0, 2, 2},
+		{"parallel paths", 4, []edge{{0, 1, 2}, {1, 3, 2}, {0, 2, 3}, {2, 3, 3}}, 0, 3, 5},
+		{"bottleneck", 5, []edge{{0, 1, 10}, {0, 2, 10}, {1, 3, 10}, {2, 3, 10}, {3, 4, 1}}, 0, 4, 1},
+		{"cross edge", 6, []edge{
+			{0, 1, 16}, {0, 2, 13}, {1, 3, 12}, {2, 1, 4}, {2, 4, 14},
+			{3, 2, 9}, {3, 5, 20}, {4, 3, 7}, {4, 5, 4},
+		}, 0, 5, 23},
+		{"infinite capacity", 3, []edge{{0, 1, math.Inf(1)}, {1, 2, 4}}, 0, 2, 4},
+		{"unreachable sink", 4, []edge{{0, 1, 5}, {2, 3, 5}, {3, 0, 5}}, 0, 3, 0},
+		{"source is sink", 2, []edge{{0, 1, 5}}, 0, 0, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			nw := maxflow.New(tt.n)
+			for _, e := range tt.edges {
+				nw.AddEdge(e.from, e.to, e.capacity)
+			}
+
+			got, err := nw.MaxFlow(context.Background(), tt.source, tt.sink)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Fatalf("got flow %g, want %g", got, tt.want)
+			}
+			if tt.source == tt.sink {
+				return
+			}
+
+			// the cut separates source from sink, and its saturated edges carry all of the flow.
+			side := nw.MinCut(tt.source)
+			if !side[tt.source] || side[tt.sink] {
+				t.Fatalf("cut doesn't separate source from sink: %v", side)
+			}
+			var capacity float64
+			for i, e := range tt.edges {
+				if !side[e.from] || side[e.to] {
+					continue
+				}
+				if !nw.Saturated(i) {
+					t.Fatalf("cut edge %d->%d isn't saturated", e.from, e.to)
+				}
+				capacity += e.capacity
+			}
+			if math.Abs(capacity-tt.want) > 1e-9 {
+				t.Fatalf("got cut capacity %g, want %g", capacity, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxFlowStopsWhenDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	nw := maxflow.New(2)
+	nw.AddEdge(0, 1, 1)
+	if _, err := nw.MaxFlow(ctx, 0, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+)
+
+// computeContext bounds the computation of a request by the configured maximum compute time, if any.
+func (s g) computeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.cfg.MaxComputeTime <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.cfg.MaxComputeTime)
+}
+
+// contextError turns the error of a done context into a connect error with the matching code, other
+// errors, and errors that already are connect errors, are returned as they are.
+func contextError(err error) error {
+	var cerr *connect.Error
+	switch {
+	case errors.As(err, &cerr):
+		return err
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	default:
+		return err
+	}
+}
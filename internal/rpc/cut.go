@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/advdv/trustd/internal/maxflow"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// MinCut computes a maximum flow from the source to the target node, with every edge carrying one unit
+// or, when weighted is set, as much as its weight. Edges without a weight carry 1 and negative weights
+// carry nothing. Undirected edges carry flow in both directions. It returns the value of the flow and the
+// ids of the edges in a minimum cut, in the order of the edges: the edges from the nodes that can still
+// be reached from the source in the residual network to the nodes that can't. Once the context is done
+// it fails with its error.
+func MinCut(
+	ctx context.Context, resp *rpcv1.RandomGraphResponse, sourceID, targetID string, weighted bool,
+) (float64, []string, error) {
+	index := indexNodes(resp.GetNodes())
+	source, okSource := index[sourceID]
+	target, okTarget := index[targetID]
+	if !okSource || !okTarget || source == target {
+		return 0, nil, nil
+	}
+
+	network := maxflow.New(len(resp.GetNodes()))
+	for _, edge := range resp.GetEdges() {
+		from, okFrom := index[edge.GetSource()]
+		to, okTo := index[edge.GetTarget()]
+		if !okFrom || !okTo || from == to {
+			continue
+		}
+
+		capacity := 1.0
+		if weighted && edge.HasWeight() {
+			capacity = max(edge.GetWeight(), 0)
+		}
+
+		network.AddEdge(from, to, capacity)
+		if !resp.GetDirected() {
+			network.AddEdge(to, from, capacity)
+		}
+	}
+
+	value, err := network.MaxFlow(ctx, source, target)
+	if err != nil {
+		return 0, nil, err
+	}
+	reachable := network.MinCut(source)
+
+	var cut []string
+	for _, edge := range resp.GetEdges() {
+		from, okFrom := index[edge.GetSource()]
+		to, okTo := index[edge.GetTarget()]
+		if !okFrom || !okTo {
+			continue
+		}
+
+		crosses := reachable[from] && !reachable[to]
+		if !resp.GetDirected() {
+			crosses = reachable[from] != reachable[to]
+		}
+		if crosses {
+			cut = append(cut, edge.GetId())
+		}
+	}
+	return value, cut, nil
+}
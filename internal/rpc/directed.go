@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"math/rand/v2"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// OrientEdges turns an undirected graph into a directed one. Every edge becomes reciprocal with the
+// given probability: the edge is kept and a copy in the opposite direction is added right after it.
+// Otherwise the edge points in a uniformly random direction. Added edges continue the "e-%d" numbering,
+// skipping ids the graph already has, and keep the type and weight of the original. Graphs that are
+// directed already are left as is.
+func OrientEdges(r *rand.Rand, resp *rpcv1.RandomGraphResponse, reciprocity float64) {
+	if resp.GetDirected() {
+		return
+	}
+
+	edges := resp.GetEdges()
+	oriented := make([]*rpcv1.Edge, 0, len(edges))
+	edgeIDs := newIDSequence("e-%d", len(edges), edges)
+	for _, edge := range edges {
+		oriented = append(oriented, edge)
+		if r.Float64() < reciprocity {
+			reverse := &rpcv1.Edge{}
+			reverse.SetId(edgeIDs.id())
+			reverse.SetSource(edge.GetTarget())
+			reverse.SetTarget(edge.GetSource())
+			reverse.SetType(edge.GetType())
+			if edge.HasWeight() {
+				reverse.SetWeight(edge.GetWeight())
+			}
+			oriented = append(oriented, reverse)
+		} else if r.IntN(2) == 1 {
+			source, target := edge.GetSource(), edge.GetTarget()
+			edge.SetSource(target)
+			edge.SetTarget(source)
+		}
+	}
+
+	resp.SetEdges(oriented)
+	resp.SetDirected(true)
+}
@@ -0,0 +1,237 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sync"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// sweep is a swept parameter of an experiment, or the value of the base when the parameter isn't swept.
+type sweep[T int64 | float64] struct {
+	// path is the path of the field the values come from.
+	path              string
+	swept             bool
+	start, stop, step T
+}
+
+// int64Sweep reads the sweep of the named parameter, falling back to the value of the base.
+func int64Sweep(name string, s *rpcv1.Int64Sweep, base int64) sweep[int64] {
+	if s == nil {
+		return sweep[int64]{path: field("base", name), start: base, stop: base}
+	}
+	return sweep[int64]{path: name, swept: true, start: s.GetStart(), stop: s.GetStop(), step: s.GetStep()}
+}
+
+// doubleSweep reads the sweep of the named parameter, falling back to the value of the base.
+func doubleSweep(name string, s *rpcv1.DoubleSweep, base float64) sweep[float64] {
+	if s == nil {
+		return sweep[float64]{path: field("base", name), start: base, stop: base}
+	}
+	return sweep[float64]{path: name, swept: true, start: s.GetStart(), stop: s.GetStop(), step: s.GetStep()}
+}
+
+// field returns the path of the named field of the sweep, or the path of the base's field it falls back to.
+func (s sweep[T]) field(name string) string {
+	if !s.swept {
+		return s.path
+	}
+	return field(s.path, name)
+}
+
+// count returns the number of values of the sweep. Huge sweeps are clamped, they are never run anyway.
+func (s sweep[T]) count() int {
+	if s.step <= 0 || s.stop < s.start {
+		return 1
+	}
+	// the tolerance keeps the stop value of real sweeps that don't add up exactly.
+	steps := (float64(s.stop)-float64(s.start))/float64(s.step) + 1e-9
+	return int(min(steps, math.MaxInt32)) + 1
+}
+
+// last returns the largest value of the sweep.
+func (s sweep[T]) last() T {
+	return s.start + T(s.count()-1)*s.step
+}
+
+// values returns the values of the sweep in increasing order.
+func (s sweep[T]) values() []T {
+	values := make([]T, s.count())
+	for i := range values {
+		values[i] = s.start + T(i)*s.step
+	}
+	return values
+}
+
+// experiment holds the swept parameters of an experiment.
+type experiment struct {
+	numNodes, initialConnected sweep[int64]
+	rewiringProbability        sweep[float64]
+	walkLength, numWalks       sweep[int64]
+	seeds                      sweep[int64]
+}
+
+// newExperiment reads the sweeps of the request.
+func newExperiment(req *rpcv1.RunExperimentRequest) experiment {
+	base := req.GetBase()
+	return experiment{
+		numNodes:            int64Sweep("num_nodes", req.GetNumNodes(), base.GetNumNodes()),
+		initialConnected:    int64Sweep("initial_connected", req.GetInitialConnected(), base.GetInitialConnected()),
+		rewiringProbability: doubleSweep("rewiring_probability", req.GetRewiringProbability(), base.GetRewiringProbability()),
+		walkLength:          int64Sweep("walk_length", req.GetWalkLength(), base.GetWalkLength()),
+		numWalks:            int64Sweep("num_walks", req.GetNumWalks(), base.GetNumWalks()),
+		seeds:               int64Sweep("seeds", req.GetSeeds(), 0),
+	}
+}
+
+// configs returns every combination of the swept parameters, the last parameter varies fastest. The
+// seeds are not part of the configurations.
+func (e experiment) configs() []*rpcv1.ExperimentResult {
+	var configs []*rpcv1.ExperimentResult
+	for _, numNodes := range e.numNodes.values() {
+		for _, initialConnected := range e.initialConnected.values() {
+			for _, rewiringProbability := range e.rewiringProbability.values() {
+				for _, walkLength := range e.walkLength.values() {
+					for _, numWalks := range e.numWalks.values() {
+						config := &rpcv1.ExperimentResult{}
+						config.SetNumNodes(numNodes)
+						config.SetInitialConnected(initialConnected)
+						config.SetRewiringProbability(rewiringProbability)
+						config.SetWalkLength(walkLength)
+						config.SetNumWalks(numWalks)
+						configs = append(configs, config)
+					}
+				}
+			}
+		}
+	}
+	return configs
+}
+
+// runRequest returns the request of the run of the configuration with the given seed. Without swept
+// seeds the seeds of the base are used as they are. The layout is circular, as the positions of the
+// nodes don't matter to the outcome.
+func (e experiment) runRequest(
+	base *rpcv1.RandomGraphRequest, config *rpcv1.ExperimentResult, seed int64,
+) *rpcv1.RandomGraphRequest {
+	run := &rpcv1.RandomGraphRequest{}
+	if base != nil {
+		run = proto.Clone(base).(*rpcv1.RandomGraphRequest) //nolint:forcetypeassert
+	}
+
+	run.ClearGenerator()
+	run.SetNumNodes(config.GetNumNodes())
+	run.SetInitialConnected(config.GetInitialConnected())
+	run.SetRewiringProbability(config.GetRewiringProbability())
+	run.SetWalkLength(config.GetWalkLength())
+	run.SetNumWalks(config.GetNumWalks())
+	run.SetCircular(&rpcv1.CircularParams{})
+	if e.seeds.swept {
+		run.SetSeed1(uint64(seed)) //nolint:gosec
+		run.SetSeed3(uint64(seed)) //nolint:gosec
+	}
+	return run
+}
+
+// runOutcome is what a single run of an experiment observed.
+type runOutcome struct {
+	met         bool
+	meetingStep int64
+	walks       int
+	escaped     int
+}
+
+// runExperiment runs every configuration of the experiment with every seed, spread over a pool of
+// workers, and aggregates the outcomes per configuration. The first run that fails stops the others.
+func (s g) runExperiment(ctx context.Context, req *rpcv1.RunExperimentRequest) ([]*rpcv1.ExperimentResult, error) {
+	exp := newExperiment(req)
+	configs, seeds := exp.configs(), exp.seeds.values()
+	outcomes := make([]runOutcome, len(configs)*len(seeds))
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range orDefault(s.cfg.ExperimentWorkers, runtime.GOMAXPROCS(0)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				run := exp.runRequest(req.GetBase(), configs[job/len(seeds)], seeds[job%len(seeds)])
+				outcome, err := s.runOnce(ctx, run)
+				if err != nil {
+					cancel(err)
+					continue
+				}
+				outcomes[job] = outcome
+			}
+		}()
+	}
+
+feed:
+	for job := range outcomes {
+		select {
+		case jobs <- job:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return nil, contextError(err)
+	}
+
+	for i, config := range configs {
+		aggregate(config, outcomes[i*len(seeds):(i+1)*len(seeds)])
+	}
+	return configs, nil
+}
+
+// runOnce builds the graph of a single run and walks it.
+func (s g) runOnce(ctx context.Context, run *rpcv1.RandomGraphRequest) (runOutcome, error) {
+	graph, err := s.build(ctx, run)
+	if err != nil {
+		return runOutcome{}, err
+	}
+
+	if err := walkGraph(ctx, run, graph); err != nil {
+		return runOutcome{}, contextError(err)
+	}
+
+	outcome := runOutcome{walks: len(graph.GetWalks())}
+	for _, walk := range graph.GetWalks() {
+		if walk.GetEscaped() {
+			outcome.escaped++
+		}
+	}
+	if graph.HasIntersection() {
+		outcome.met = true
+		outcome.meetingStep = graph.GetIntersection().GetBobStep() + graph.GetIntersection().GetAdaStep()
+	}
+	return outcome, nil
+}
+
+// aggregate writes the statistics over the outcomes of the runs into the result of the configuration.
+func aggregate(result *rpcv1.ExperimentResult, outcomes []runOutcome) {
+	var met, walks, escaped int
+	var meetingSteps int64
+	for _, outcome := range outcomes {
+		walks += outcome.walks
+		escaped += outcome.escaped
+		if outcome.met {
+			met++
+			meetingSteps += outcome.meetingStep
+		}
+	}
+
+	result.SetRuns(int64(len(outcomes)))
+	result.SetIntersectionRate(float64(met) / float64(max(1, len(outcomes))))
+	result.SetMeanMeetingStep(float64(meetingSteps) / float64(max(1, met)))
+	result.SetEscapeProbability(float64(escaped) / float64(max(1, walks)))
+}
@@ -0,0 +1,18 @@
+package rpc
+
+import "math/rand/v2"
+
+// RepulsiveForces returns the repulsive forces k²/d on the nodes at the positions, approximated with a
+// Barnes–Hut quadtree or computed exactly between every pair of nodes.
+func RepulsiveForces(positions [][2]float64, k2, theta float64, barnesHut bool) [][2]float64 {
+	disp := make([][2]float64, len(positions))
+	if barnesHut {
+		var tree quadtree
+		tree.build(positions)
+		tree.repulse(positions, disp, k2, theta)
+	} else {
+		//nolint:gosec
+		exactRepulsion(rand.New(rand.NewPCG(1, 2)), positions, disp, k2)
+	}
+	return disp
+}
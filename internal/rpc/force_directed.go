@@ -0,0 +1,474 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// DefaultTheta is the Barnes–Hut opening angle that is used when none is configured.
+const DefaultTheta = 0.8
+
+// ForceDirected configures the Fruchterman–Reingold force-directed layout.
+type ForceDirected struct {
+	Iterations int
+	Area       float64
+
+	// BarnesHut approximates the repulsive forces with a quadtree, which brings each iteration
+	// down from O(n²) to O(n log n) so that graphs with tens of thousands of nodes can be laid out.
+	BarnesHut bool
+	// Theta is the Barnes–Hut opening angle: a cell is treated as a single body when its width
+	// divided by its distance is below theta. Zero means DefaultTheta.
+	Theta float64
+
+	// Cooling is the schedule by which the maximum step of the nodes decreases. The unspecified
+	// schedule keeps the step fixed.
+	Cooling rpcv1.CoolingSchedule
+	// Tolerance stops the layout once the average movement of the nodes in an iteration is below
+	// this fraction of the ideal edge length. Zero runs all iterations.
+	Tolerance float64
+}
+
+// ForceDirectedLayout applies a simple force-directed layout to the given RandomGraphResponse.
+// It modifies and returns the same response with updated node X/Y positions.
+func ForceDirectedLayout(
+	rng *rand.Rand,
+	iterations int,
+	area float64,
+	resp *rpcv1.RandomGraphResponse,
+) *rpcv1.RandomGraphResponse {
+	// without a deadline or an observer the layout can't fail.
+	_ = ForceDirected{Iterations: iterations, Area: area}.Stream(context.Background(), rng, resp, 0, nil)
+	return resp
+}
+
+// Apply lays out the graph and writes the positions into the nodes of the response, together with
+// statistics on the layout. The response is returned for convenience.
+func (fd ForceDirected) Apply(
+	ctx context.Context, rng *rand.Rand, resp *rpcv1.RandomGraphResponse,
+) (*rpcv1.RandomGraphResponse, error) {
+	if err := fd.Stream(ctx, rng, resp, 0, nil); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Stream lays out the graph like Apply, and calls observe with the statistics and positions so far after
+// every interval iterations. If the context is done, or observe returns an error, the layout stops with
+// that error and the response is left as is. The observer must not retain the positions.
+//
+//nolint:gocognit
+func (fd ForceDirected) Stream(
+	ctx context.Context,
+	rng *rand.Rand,
+	resp *rpcv1.RandomGraphResponse,
+	interval int,
+	observe func(stats *rpcv1.LayoutStats, positions [][2]float64) error,
+) error {
+	nodes := resp.GetNodes()
+
+	//nolint:varnamelen
+	n := len(nodes)
+	if n == 0 {
+		return nil
+	}
+
+	//nolint:varnamelen
+	k := math.Sqrt(fd.Area / float64(n)) // Ideal distance between nodes (Fruchterman–Reingold concept)
+
+	// We'll store floating-point positions internally, then cast to int64 at the end.
+	positions := make([][2]float64, n)
+	// We'll use displacement vectors to accumulate net force on each node per iteration.
+	disp := make([][2]float64, n)
+
+	// resolve the edge endpoints once, instead of looking up the nodes in every iteration.
+	springs := indexEdges(resp, indexNodes(nodes))
+
+	// Randomly initialize positions in a box of size sqrt(area) x sqrt(area), random init
+	// often helps avoid local minima.
+	for i := range n {
+		positions[i][0] = rng.Float64() * math.Sqrt(fd.Area)
+		positions[i][1] = rng.Float64() * math.Sqrt(fd.Area)
+	}
+
+	// Fruchterman–Reingold standard:
+	//   repulsiveForce(d) ~ k^2 / d
+	//   attractiveForce(d) ~ d^2 / k
+	attractive := func(dist float64) float64 {
+		return (dist * dist) / k
+	}
+
+	theta := fd.Theta
+	if theta <= 0 {
+		theta = DefaultTheta
+	}
+
+	stats := &rpcv1.LayoutStats{}
+	cool := newCooler(fd.Cooling, fd.Iterations, math.Sqrt(fd.Area)/10)
+
+	var tree quadtree
+	for iter := range fd.Iterations {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// Reset displacement
+		for i := range n {
+			disp[i][0] = 0
+			disp[i][1] = 0
+		}
+
+		if fd.BarnesHut {
+			tree.build(positions)
+			tree.repulse(positions, disp, k*k, theta)
+		} else {
+			exactRepulsion(rng, positions, disp, k*k)
+		}
+
+		// ATTRACTIVE FORCES (Edges)
+		for _, spring := range springs {
+			srcIndex, tgtIndex := spring[0], spring[1]
+
+			dx := positions[tgtIndex][0] - positions[srcIndex][0]
+			dy := positions[tgtIndex][1] - positions[srcIndex][1]
+			dist := math.Hypot(dx, dy)
+			if dist < 1e-9 {
+				// Avoid division by zero
+				dx = (rng.Float64() - 0.5) * 0.01
+				dy = (rng.Float64() - 0.5) * 0.01
+				dist = math.Hypot(dx, dy)
+			}
+
+			// Attractive force magnitude
+			force := attractive(dist)
+
+			// Normalize & apply
+			fx := (dx / dist) * force
+			fy := (dy / dist) * force
+
+			disp[srcIndex][0] += fx
+			disp[srcIndex][1] += fy
+			disp[tgtIndex][0] -= fx
+			disp[tgtIndex][1] -= fy
+		}
+
+		// Update positions, limiting the movement per iteration to the temperature so nodes don't "shoot off".
+		var energy, moved float64
+		for i := range n {
+			dx := disp[i][0]
+			dy := disp[i][1]
+
+			dist := math.Hypot(dx, dy)
+			energy += dist * dist
+			if dist > cool.temp {
+				dx = dx / dist * cool.temp
+				dy = dy / dist * cool.temp
+				dist = cool.temp
+			}
+			positions[i][0] += dx
+			positions[i][1] += dy
+			moved += dist
+		}
+
+		stats.SetIterations(int64(iter + 1))
+		stats.SetEnergy(energy)
+		if fd.Tolerance > 0 && moved/float64(n) < fd.Tolerance*k {
+			stats.SetConverged(true)
+			break
+		}
+
+		if observe != nil && (iter+1)%interval == 0 {
+			if err := observe(stats, positions); err != nil {
+				return err
+			}
+		}
+
+		cool.next(iter, energy)
+	}
+
+	setPositions(nodes, positions)
+	resp.SetLayout(stats)
+	return nil
+}
+
+// legacyMaxStep is the fixed maximum step of the unspecified cooling schedule.
+const legacyMaxStep = 10.0
+
+// cooler tracks the temperature of the layout: the maximum distance a node moves in an iteration.
+type cooler struct {
+	schedule   rpcv1.CoolingSchedule
+	iterations int
+	initial    float64
+	temp       float64
+
+	// the adaptive schedule tracks the energy of the previous iteration and the number of
+	// consecutive iterations in which it decreased.
+	energy   float64
+	progress int
+}
+
+// newCooler starts a cooling schedule at the initial temperature.
+func newCooler(schedule rpcv1.CoolingSchedule, iterations int, initial float64) *cooler {
+	if schedule == rpcv1.CoolingSchedule_COOLING_SCHEDULE_UNSPECIFIED {
+		initial = legacyMaxStep
+	}
+
+	return &cooler{
+		schedule:   schedule,
+		iterations: iterations,
+		initial:    initial,
+		temp:       initial,
+		energy:     math.Inf(1),
+	}
+}
+
+// next updates the temperature after the iteration, which ended with the given energy.
+func (c *cooler) next(iter int, energy float64) {
+	const (
+		exponentialFactor = 0.95
+		adaptiveFactor    = 0.9
+		adaptiveProgress  = 5
+	)
+
+	switch c.schedule {
+	case rpcv1.CoolingSchedule_COOLING_SCHEDULE_LINEAR:
+		c.temp = c.initial * (1 - float64(iter+1)/float64(c.iterations))
+	case rpcv1.CoolingSchedule_COOLING_SCHEDULE_EXPONENTIAL:
+		c.temp *= exponentialFactor
+	case rpcv1.CoolingSchedule_COOLING_SCHEDULE_ADAPTIVE:
+		if energy < c.energy {
+			c.progress++
+			if c.progress >= adaptiveProgress {
+				c.progress = 0
+				c.temp = min(c.initial, c.temp/adaptiveFactor)
+			}
+		} else {
+			c.progress = 0
+			c.temp *= adaptiveFactor
+		}
+		c.energy = energy
+	default:
+	}
+}
+
+// exactRepulsion accumulates the repulsive force k²/d between every pair of nodes.
+func exactRepulsion(rng *rand.Rand, positions, disp [][2]float64, k2 float64) {
+	for iidx := range positions {
+		for jidx := iidx + 1; jidx < len(positions); jidx++ {
+			dx := positions[jidx][0] - positions[iidx][0]
+			dy := positions[jidx][1] - positions[iidx][1]
+			dist := math.Hypot(dx, dy)
+			if dist < 1e-9 {
+				// Avoid division by zero; nudge slightly
+				dx = (rng.Float64() - 0.5) * 0.01
+				dy = (rng.Float64() - 0.5) * 0.01
+				dist = math.Hypot(dx, dy)
+			}
+
+			// Repulsive force magnitude, normalized & applied
+			force := k2 / dist
+			fx := (dx / dist) * force
+			fy := (dy / dist) * force
+
+			disp[iidx][0] -= fx
+			disp[iidx][1] -= fy
+			disp[jidx][0] += fx
+			disp[jidx][1] += fy
+		}
+	}
+}
+
+// maxQuadDepth bounds the depth of the quadtree, nodes that (nearly) coincide end up together
+// in a leaf at this depth instead of being split forever.
+const maxQuadDepth = 32
+
+// quadCell is a square cell of the quadtree, it tracks the total mass and center of mass of the
+// nodes inside of it.
+type quadCell struct {
+	x, y, half float64 // center and half width of the cell
+	mass       float64
+	comX, comY float64
+	body       int      // index of the node in a leaf with a single node, -1 otherwise
+	children   [4]int32 // indices of the quadrants in the arena, 0 means no quadrant
+}
+
+// quadtree is a Barnes–Hut quadtree, its cells are kept in an arena that is reused across
+// iterations.
+type quadtree struct {
+	cells     []quadCell
+	positions [][2]float64
+}
+
+// build (re)builds the tree over the positions.
+func (t *quadtree) build(positions [][2]float64) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, p := range positions {
+		minX, maxX = min(minX, p[0]), max(maxX, p[0])
+		minY, maxY = min(minY, p[1]), max(maxY, p[1])
+	}
+
+	t.positions = positions
+	t.cells = append(t.cells[:0], quadCell{
+		x: (minX + maxX) / 2, y: (minY + maxY) / 2,
+		half: max(maxX-minX, maxY-minY)/2 + 1,
+		body: -1,
+	})
+	for i := range positions {
+		t.insert(0, i, 0)
+	}
+}
+
+// insert adds the node at index body to the cell at index ci.
+func (t *quadtree) insert(ci int32, body, depth int) {
+	p := t.positions[body]
+	for ; ; depth++ {
+		cell := &t.cells[ci]
+		if cell.mass == 0 {
+			cell.body, cell.mass = body, 1
+			cell.comX, cell.comY = p[0], p[1]
+			return
+		}
+
+		cell.comX = (cell.comX*cell.mass + p[0]) / (cell.mass + 1)
+		cell.comY = (cell.comY*cell.mass + p[1]) / (cell.mass + 1)
+		cell.mass++
+		if depth >= maxQuadDepth {
+			cell.body = -1
+			return
+		}
+
+		// a leaf that holds a single node is split, its node moves one level down.
+		if prev := cell.body; prev >= 0 {
+			cell.body = -1
+			t.insert(t.quadrant(ci, t.positions[prev]), prev, depth+1)
+		}
+
+		ci = t.quadrant(ci, p)
+	}
+}
+
+// quadrant returns the index of the quadrant of the cell at index ci that contains p, it is
+// created when it doesn't exist yet.
+func (t *quadtree) quadrant(ci int32, p [2]float64) int32 {
+	cell := t.cells[ci]
+
+	qi, qx, qy := quadrantOf(&cell, p), cell.x-cell.half/2, cell.y-cell.half/2
+	if qi&1 != 0 {
+		qx = cell.x + cell.half/2
+	}
+	if qi&2 != 0 {
+		qy = cell.y + cell.half/2
+	}
+
+	if child := cell.children[qi]; child != 0 {
+		return child
+	}
+
+	//nolint:gosec
+	child := int32(len(t.cells))
+	t.cells = append(t.cells, quadCell{x: qx, y: qy, half: cell.half / 2, body: -1})
+	t.cells[ci].children[qi] = child
+	return child
+}
+
+// quadrantOf returns the index of the quadrant of the cell that contains p.
+func quadrantOf(cell *quadCell, p [2]float64) int {
+	qi := 0
+	if p[0] >= cell.x {
+		qi |= 1
+	}
+	if p[1] >= cell.y {
+		qi |= 2
+	}
+	return qi
+}
+
+// repulse accumulates the approximated repulsive force k²/d on every node. The nodes are
+// independent of each other so they are spread over the available CPUs.
+func (t *quadtree) repulse(positions, disp [][2]float64, k2, theta float64) {
+	workers := min(runtime.GOMAXPROCS(0), max(1, len(positions)/1024))
+	chunk := (len(positions) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for lo := 0; lo < len(positions); lo += chunk {
+		hi := min(lo+chunk, len(positions))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stack := make([]quadVisit, 0, 4*maxQuadDepth)
+			for i := lo; i < hi; i++ {
+				disp[i][0], disp[i][1] = t.force(stack, i, positions[i], k2, theta)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// quadVisit is a cell that is still to be visited by force, own tells whether the node that the
+// force acts on is inside of it.
+type quadVisit struct {
+	cell int32
+	own  bool
+}
+
+// force returns the repulsive force on the node at index body. Cells that are small enough
+// relative to their distance are treated as a single body at their center of mass, without the
+// node itself if it is inside of the cell.
+func (t *quadtree) force(stack []quadVisit, body int, p [2]float64, k2, theta float64) (fx, fy float64) {
+	stack = append(stack[:0], quadVisit{cell: 0, own: true})
+	for len(stack) > 0 {
+		visit := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		cell := &t.cells[visit.cell]
+		if cell.mass == 0 || cell.body == body {
+			continue
+		}
+
+		// the node doesn't push itself, so it is taken out of the cells that contain it.
+		mass, comX, comY := cell.mass, cell.comX, cell.comY
+		if visit.own {
+			mass--
+			if mass == 0 {
+				continue
+			}
+			comX = (cell.comX*cell.mass - p[0]) / mass
+			comY = (cell.comY*cell.mass - p[1]) / mass
+		}
+
+		dx := p[0] - comX
+		dy := p[1] - comY
+		dist2 := dx*dx + dy*dy
+
+		// cells are opened when their width is too large relative to their distance.
+		width := 2 * cell.half
+		if cell.children != [4]int32{} && width*width >= theta*theta*dist2 {
+			own := -1
+			if visit.own {
+				own = quadrantOf(cell, p)
+			}
+			for qi, child := range cell.children {
+				if child != 0 {
+					stack = append(stack, quadVisit{cell: child, own: qi == own})
+				}
+			}
+			continue
+		}
+
+		// nodes that coincide with this one push it in no particular direction.
+		if dist2 < 1e-18 {
+			continue
+		}
+
+		// the force k²/d along the unit vector (dx, dy)/d.
+		force := mass * k2 / dist2
+		fx += dx * force
+		fy += dy * force
+	}
+
+	return fx, fy
+}
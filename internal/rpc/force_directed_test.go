@@ -0,0 +1,46 @@
+package rpc_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/advdv/trustd/internal/rpc"
+)
+
+func TestBarnesHutForces(t *testing.T) {
+	//nolint:gosec
+	rng := rand.New(rand.NewPCG(3, 4))
+	scattered := make([][2]float64, 40)
+	for i := range scattered {
+		scattered[i] = [2]float64{rng.Float64() * 100, rng.Float64() * 100}
+	}
+	// two nodes that are close enough to share a leaf at the maximum depth of the tree.
+	scattered[1] = [2]float64{scattered[0][0] + 3e-9, scattered[0][1]}
+
+	for _, tt := range []struct {
+		name      string
+		positions [][2]float64
+		theta     float64
+		tolerance float64
+	}{
+		// a theta of 0 opens every cell, which leaves only the pairwise forces.
+		{"exact", scattered, 0, 1e-9},
+		{"approximated", scattered, 0.5, 0.1},
+		// a large theta treats the root as a single body, which is the other node once the node itself is
+		// taken out.
+		{"root as a single body", [][2]float64{{0, 0}, {10, 5}}, 100, 1e-9},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			exact := rpc.RepulsiveForces(tt.positions, 25, 0, false)
+			approx := rpc.RepulsiveForces(tt.positions, 25, tt.theta, true)
+			for i := range tt.positions {
+				want := math.Hypot(exact[i][0], exact[i][1])
+				diff := math.Hypot(approx[i][0]-exact[i][0], approx[i][1]-exact[i][1])
+				if diff > tt.tolerance*want {
+					t.Fatalf("node %d: got force %v, want %v", i, approx[i], exact[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,533 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// Generator generates a random (honest) graph. The nodes of the graph are positioned on a circle and
+// have the "labelNode" type, the parties are assigned afterwards. Generators check the context as they
+// go, and return its error once it is done.
+type Generator interface {
+	Generate(ctx context.Context, r *rand.Rand) (*rpcv1.RandomGraphResponse, error)
+}
+
+// generator returns the generator as selected by the request, including previously stored graphs.
+func (s g) generator(ctx context.Context, req *rpcv1.RandomGraphRequest) (Generator, error) {
+	if req.WhichGenerator() != rpcv1.RandomGraphRequest_GraphId_case {
+		return newGenerator(req), nil
+	}
+
+	graph, err := s.graphs.Base(ctx, req.GetGraphId())
+	if err != nil {
+		return nil, err
+	}
+
+	return Stored{Graph: graph, Overlaid: graph.HasManifest()}, nil
+}
+
+// newGenerator returns the generator as selected by the request. If no generator is selected the
+// request's top-level Watts–Strogatz parameters are used.
+func newGenerator(req *rpcv1.RandomGraphRequest) Generator {
+	switch req.WhichGenerator() {
+	case rpcv1.RandomGraphRequest_WattsStrogatz_case:
+		p := req.GetWattsStrogatz()
+		return WattsStrogatz{N: int(p.GetNumNodes()), K: int(p.GetInitialConnected()), Beta: p.GetRewiringProbability()}
+	case rpcv1.RandomGraphRequest_ErdosRenyi_case:
+		p := req.GetErdosRenyi()
+		return ErdosRenyi{N: int(p.GetNumNodes()), P: p.GetEdgeProbability()}
+	case rpcv1.RandomGraphRequest_BarabasiAlbert_case:
+		p := req.GetBarabasiAlbert()
+		return BarabasiAlbert{N: int(p.GetNumNodes()), M: int(p.GetEdgesPerNode())}
+	case rpcv1.RandomGraphRequest_StochasticBlock_case:
+		p := req.GetStochasticBlock()
+		sizes := make([]int, 0, len(p.GetBlockSizes()))
+		for _, size := range p.GetBlockSizes() {
+			sizes = append(sizes, int(size))
+		}
+		return StochasticBlock{Sizes: sizes, PIn: p.GetPIn(), POut: p.GetPOut()}
+	case rpcv1.RandomGraphRequest_RandomRegular_case:
+		p := req.GetRandomRegular()
+		return RandomRegular{N: int(p.GetNumNodes()), D: int(p.GetDegree())}
+	case rpcv1.RandomGraphRequest_Lattice_case:
+		p := req.GetLattice()
+		return Lattice{Rows: int(p.GetRows()), Cols: int(p.GetColumns()), Periodic: p.GetPeriodic()}
+	default:
+		return WattsStrogatz{
+			N:    int(req.GetNumNodes()),
+			K:    int(req.GetInitialConnected()),
+			Beta: req.GetRewiringProbability(),
+		}
+	}
+}
+
+// WattsStrogatz generates small-world graphs, see GenerateWattsStrogatzGraph.
+type WattsStrogatz struct {
+	N, K int
+	Beta float64
+}
+
+// Generate implements Generator.
+func (g WattsStrogatz) Generate(ctx context.Context, r *rand.Rand) (*rpcv1.RandomGraphResponse, error) {
+	return GenerateWattsStrogatzGraph(ctx, r, g.N, g.K, g.Beta)
+}
+
+// GenerateWattsStrogatzGraph creates a small-world network using the classic
+// Watts–Strogatz model. It takes:
+//   - ctx: generation stops with the error of the context once it is done
+//   - src: an explicit random source (so you can control seeding)
+//   - n: number of nodes
+//   - k: each node is initially connected to k nearest neighbors (k/2 on each side in a ring)
+//   - beta: rewiring probability in [0,1]
+//
+// It returns a RandomGraphResponse containing Nodes and Edges that match
+// your protobuf definitions in this package.
+//
+//nolint:varnamelen
+func GenerateWattsStrogatzGraph(
+	ctx context.Context, r *rand.Rand, n, k int, beta float64,
+) (*rpcv1.RandomGraphResponse, error) {
+	adjacency, err := wattsStrogatzAdjacency(ctx, r, n, k, beta)
+	if err != nil {
+		return nil, err
+	}
+	return newGraph(ctx, adjacency)
+}
+
+// wattsStrogatzAdjacency creates the ring lattice with n nodes and k neighbors per node, and rewires
+// each of its edges with probability beta. adjacency[i] holds the set of neighbors of node i.
+//
+//nolint:gocognit,varnamelen
+func wattsStrogatzAdjacency(ctx context.Context, r *rand.Rand, n, k int, beta float64) ([]map[int]bool, error) {
+	// adjacency[i] will be a set of neighbors of node i
+	adjacency := newAdjacency(n)
+
+	// 1. Create initial ring of edges:
+	//    each node i connects to k/2 neighbors to the right (mod n).
+	//    We'll store edges in adjacency to represent undirected connections.
+	for i := range n {
+		for j := 1; j <= k/2; j++ {
+			neighbor := (i + j) % n
+			adjacency[i][neighbor] = true
+			adjacency[neighbor][i] = true
+		}
+	}
+
+	// 2. Rewire edges with probability beta.
+	//    Only consider edges where i < neighbor to avoid duplicating undirected edges.
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for j := 1; j <= k/2; j++ {
+			oldNeighbor := (i + j) % n
+			if i < oldNeighbor {
+				if r.Float64() < beta {
+					// Remove old edge
+					adjacency[i][oldNeighbor] = false
+					adjacency[oldNeighbor][i] = false
+
+					// Rewire to a new neighbor that is neither i nor already a neighbor
+					for {
+						newNeighbor := r.IntN(n)
+						if newNeighbor != i && !adjacency[i][newNeighbor] {
+							adjacency[i][newNeighbor] = true
+							adjacency[newNeighbor][i] = true
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return adjacency, nil
+}
+
+// Stored "generates" a graph that already exists, such as an imported graph.
+type Stored struct {
+	Graph *rpcv1.RandomGraphResponse
+	// Overlaid is set for graphs that were generated by trustd, they already have the Sybil region,
+	// orientation and weights of the request that generated them.
+	Overlaid bool
+}
+
+// Generate implements Generator.
+func (g Stored) Generate(context.Context, *rand.Rand) (*rpcv1.RandomGraphResponse, error) {
+	return g.Graph, nil
+}
+
+// ErdosRenyi generates G(n, p) graphs: every pair of nodes is connected with probability P.
+type ErdosRenyi struct {
+	N int
+	P float64
+}
+
+// Generate implements Generator.
+func (g ErdosRenyi) Generate(ctx context.Context, r *rand.Rand) (*rpcv1.RandomGraphResponse, error) {
+	adjacency := newAdjacency(g.N)
+	for i := range g.N {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for j := i + 1; j < g.N; j++ {
+			if r.Float64() < g.P {
+				addUndirected(adjacency, i, j)
+			}
+		}
+	}
+
+	return newGraph(ctx, adjacency)
+}
+
+// BarabasiAlbert generates scale-free graphs through preferential attachment: every new node attaches
+// to M distinct existing nodes, picked proportional to their degree.
+type BarabasiAlbert struct {
+	N, M int
+}
+
+// Generate implements Generator.
+func (g BarabasiAlbert) Generate(ctx context.Context, r *rand.Rand) (*rpcv1.RandomGraphResponse, error) {
+	if g.M < 1 || g.M >= g.N {
+		return nil, fmt.Errorf("edges per node must be in [1, %d), got: %d", g.N, g.M)
+	}
+
+	adjacency := newAdjacency(g.N)
+
+	// the first new node attaches to all of the M initial nodes. After that, "repeated" holds every node
+	// once per incident edge so drawing from it uniformly is drawing proportional to degree.
+	targets := make([]int, 0, g.M)
+	for i := range g.M {
+		targets = append(targets, i)
+	}
+
+	repeated := make([]int, 0, 2*g.N*g.M)
+	for source := g.M; source < g.N; source++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, target := range targets {
+			addUndirected(adjacency, source, target)
+			repeated = append(repeated, target, source)
+		}
+
+		picked := make(map[int]bool, g.M)
+		targets = targets[:0]
+		for len(targets) < g.M {
+			target := repeated[r.IntN(len(repeated))]
+			if !picked[target] {
+				picked[target] = true
+				targets = append(targets, target)
+			}
+		}
+	}
+
+	return newGraph(ctx, adjacency)
+}
+
+// StochasticBlock generates graphs with a community structure: nodes are partitioned into blocks of the
+// given sizes, nodes in the same block are connected with probability PIn and nodes in different blocks
+// with probability POut.
+type StochasticBlock struct {
+	Sizes     []int
+	PIn, POut float64
+}
+
+// Generate implements Generator.
+func (g StochasticBlock) Generate(ctx context.Context, r *rand.Rand) (*rpcv1.RandomGraphResponse, error) {
+	var block []int
+	for b, size := range g.Sizes {
+		for range size {
+			block = append(block, b)
+		}
+	}
+
+	adjacency := newAdjacency(len(block))
+	for i := range block {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for j := i + 1; j < len(block); j++ {
+			p := g.POut
+			if block[i] == block[j] {
+				p = g.PIn
+			}
+
+			if r.Float64() < p {
+				addUndirected(adjacency, i, j)
+			}
+		}
+	}
+
+	return newGraph(ctx, adjacency)
+}
+
+// RandomRegular generates graphs where every node has exactly D neighbors.
+type RandomRegular struct {
+	N, D int
+}
+
+// maxRegularAttempts bounds how often the pairing of a random regular graph is restarted.
+const maxRegularAttempts = 100
+
+// regularTriesPerCheck is how many stubs are tried between checks of the context, as finding a pair
+// can take up to the number of stubs squared tries.
+const regularTriesPerCheck = 1 << 12
+
+// Generate implements Generator. It uses the pairing model: every node gets D "stubs" that are randomly
+// paired up, the pairing is restarted whenever it gets stuck on self-loops or parallel edges.
+func (g RandomRegular) Generate(ctx context.Context, r *rand.Rand) (*rpcv1.RandomGraphResponse, error) {
+	if g.D < 0 || g.D >= g.N || (g.N*g.D)%2 != 0 {
+		return nil, fmt.Errorf("no %d-regular graph with %d nodes exists", g.D, g.N)
+	}
+
+	for range maxRegularAttempts {
+		adjacency, ok, err := g.pair(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return newGraph(ctx, adjacency)
+		}
+	}
+
+	return nil, errors.New("failed to generate random regular graph, try different parameters")
+}
+
+// pair attempts a single pairing of all stubs.
+func (g RandomRegular) pair(ctx context.Context, r *rand.Rand) ([]map[int]bool, bool, error) {
+	adjacency := newAdjacency(g.N)
+	stubs := make([]int, 0, g.N*g.D)
+	for i := range g.N {
+		for range g.D {
+			stubs = append(stubs, i)
+		}
+	}
+
+	for len(stubs) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
+		}
+
+		paired := false
+		for try := range len(stubs) * len(stubs) {
+			if try%regularTriesPerCheck == regularTriesPerCheck-1 {
+				if err := ctx.Err(); err != nil {
+					return nil, false, err
+				}
+			}
+
+			i, j := r.IntN(len(stubs)), r.IntN(len(stubs))
+			u, v := stubs[i], stubs[j]
+			if i == j || u == v || adjacency[u][v] {
+				continue
+			}
+
+			addUndirected(adjacency, u, v)
+
+			// remove both stubs, the higher index first so the lower one stays valid.
+			i, j = max(i, j), min(i, j)
+			stubs[i] = stubs[len(stubs)-1]
+			stubs = stubs[:len(stubs)-1]
+			stubs[j] = stubs[len(stubs)-1]
+			stubs = stubs[:len(stubs)-1]
+			paired = true
+			break
+		}
+
+		if !paired {
+			return nil, false, nil
+		}
+	}
+
+	return adjacency, true, nil
+}
+
+// Lattice generates 2D grid graphs, optionally wrapped around into a torus.
+type Lattice struct {
+	Rows, Cols int
+	Periodic   bool
+}
+
+// Generate implements Generator.
+func (g Lattice) Generate(ctx context.Context, _ *rand.Rand) (*rpcv1.RandomGraphResponse, error) {
+	adjacency := newAdjacency(g.Rows * g.Cols)
+	for row := range g.Rows {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for col := range g.Cols {
+			i := row*g.Cols + col
+			switch {
+			case col+1 < g.Cols:
+				addUndirected(adjacency, i, i+1)
+			case g.Periodic && g.Cols > 2:
+				addUndirected(adjacency, i, row*g.Cols)
+			}
+
+			switch {
+			case row+1 < g.Rows:
+				addUndirected(adjacency, i, i+g.Cols)
+			case g.Periodic && g.Rows > 2:
+				addUndirected(adjacency, i, col)
+			}
+		}
+	}
+
+	return newGraph(ctx, adjacency)
+}
+
+// newAdjacency inits the adjacency sets for n nodes.
+func newAdjacency(n int) []map[int]bool {
+	adjacency := make([]map[int]bool, n)
+	for i := range adjacency {
+		adjacency[i] = make(map[int]bool)
+	}
+	return adjacency
+}
+
+// addUndirected adds an undirected edge between i and j.
+func addUndirected(adjacency []map[int]bool, i, j int) {
+	adjacency[i][j] = true
+	adjacency[j][i] = true
+}
+
+// newGraph turns the adjacency sets into a graph with the nodes positioned on a circle.
+//
+//nolint:varnamelen
+func newGraph(ctx context.Context, adjacency []map[int]bool) (*rpcv1.RandomGraphResponse, error) {
+	n := len(adjacency)
+
+	// Create Nodes with positions on a circle
+	// (this is just for an example layout—positions are optional or can be changed).
+	nodes := make([]*rpcv1.Node, 0, n)
+	radius := 300.0
+	for i := range n {
+		angle := 2.0 * math.Pi * float64(i) / float64(n)
+		x := int64(radius * math.Cos(angle))
+		y := int64(radius * math.Sin(angle))
+
+		node := &rpcv1.Node{}
+		node.SetId(fmt.Sprintf("%d", i))
+		node.SetType("labelNode")
+
+		pos := &rpcv1.Position{}
+		pos.SetX(x)
+		pos.SetY(y)
+		node.SetPosition(pos)
+
+		nodes = append(nodes, node)
+	}
+
+	// Convert adjacency into a list of Edges
+	// We only add an edge once (i -> j) for i < j to avoid duplicates, in the order of j so the edges
+	// don't depend on the order of the map.
+	var edges []*rpcv1.Edge
+	var neighbors []int
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		neighbors = neighbors[:0]
+		for j, ok := range adjacency[i] {
+			if ok && j > i {
+				neighbors = append(neighbors, j)
+			}
+		}
+		slices.Sort(neighbors)
+
+		for _, j := range neighbors {
+			e := &rpcv1.Edge{}
+			e.SetId(fmt.Sprintf("e-%d", len(edges)))
+			e.SetSource(fmt.Sprintf("%d", i))
+			e.SetTarget(fmt.Sprintf("%d", j))
+			edges = append(edges, e)
+		}
+	}
+
+	resp := &rpcv1.RandomGraphResponse{}
+	resp.SetNodes(nodes)
+	resp.SetEdges(edges)
+	return resp, nil
+}
+
+// idSequence hands out the ids format%d that are not taken yet, counting up from where it starts. Ids are
+// taken once they are handed out, so graphs that already use ids of the format, such as imported
+// graphs, don't get duplicates.
+type idSequence struct {
+	format string
+	next   int
+	taken  map[string]bool
+}
+
+// newIDSequence starts a sequence at next, with the ids of the items taken.
+func newIDSequence[T interface{ GetId() string }](format string, next int, items []T) *idSequence {
+	taken := make(map[string]bool, len(items))
+	for _, item := range items {
+		taken[item.GetId()] = true
+	}
+	return &idSequence{format: format, next: next, taken: taken}
+}
+
+// id returns the next id that is not taken.
+func (s *idSequence) id() string {
+	for {
+		id := fmt.Sprintf(s.format, s.next)
+		s.next++
+		if !s.taken[id] {
+			s.taken[id] = true
+			return id
+		}
+	}
+}
+
+// assignParties assigns exactly one bobNode and one adaNode randomly, and records them as the parties of
+// the graph. It returns the ids of both, which are empty if the graph has less than two nodes.
+func assignParties(r *rand.Rand, resp *rpcv1.RandomGraphResponse) (bobID, adaID string) {
+	nodes := resp.GetNodes()
+	if len(nodes) < 2 {
+		return "", ""
+	}
+
+	bobIndex := r.IntN(len(nodes))
+	adaIndex := r.IntN(len(nodes))
+	for adaIndex == bobIndex {
+		adaIndex = r.IntN(len(nodes))
+	}
+	nodes[bobIndex].SetType("bobNode")
+	nodes[adaIndex].SetType("adaNode")
+	resp.SetBobNodeId(nodes[bobIndex].GetId())
+	resp.SetAdaNodeId(nodes[adaIndex].GetId())
+	return resp.GetBobNodeId(), resp.GetAdaNodeId()
+}
+
+// findParties returns the ids of Bob's and Ada's nodes in the graph, or empty strings if the graph has
+// no parties assigned.
+func findParties(resp *rpcv1.RandomGraphResponse) (bobID, adaID string) {
+	return resp.GetBobNodeId(), resp.GetAdaNodeId()
+}
+
+// partiesByType records the nodes with the bobNode and adaNode types as the parties of the graph, as
+// graphs that were exported by trustd have them. It is only meant for graphs that are imported.
+func partiesByType(resp *rpcv1.RandomGraphResponse) {
+	for _, node := range resp.GetNodes() {
+		switch node.GetType() {
+		case "bobNode":
+			resp.SetBobNodeId(node.GetId())
+		case "adaNode":
+			resp.SetAdaNodeId(node.GetId())
+		}
+	}
+}
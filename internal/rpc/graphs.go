@@ -0,0 +1,289 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"github.com/advdv/trustd/internal/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// Graphs keeps the records of the store in memory so later calls can refer to them by id without
+// loading them again. Every change is written through to the store, so graphs outlive the process if
+// the store does. Records are dropped from memory when they haven't been accessed for the configured
+// time-to-live. They remain in a persistent store, but are deleted from a store that isn't so it
+// doesn't grow without bound.
+//
+// The store is accessed without holding the lock of the cache, so slow IO on one graph doesn't stall
+// the others. Records in memory are replaced but never modified, and changes to the same graph are
+// serialized by a lock of their own.
+type Graphs struct {
+	ttl   time.Duration
+	now   func() time.Time
+	store store.Store
+
+	mu      sync.Mutex
+	graphs  map[string]*storedGraph
+	writing map[string]*writeLock
+	deletes uint64 // counts deletes, so loads can tell that the record they read may be gone.
+}
+
+// storedGraph is a record in memory. The base graph is generated and laid out but not walked, the
+// current graph is the base graph annotated with the latest walks.
+type storedGraph struct {
+	record    *rpcv1.GraphRecord
+	expiresAt time.Time
+}
+
+// writeLock serializes the changes to a graph, it is kept for as long as a change waits for it.
+type writeLock struct {
+	sync.Mutex
+	waiting int
+}
+
+// NewGraphs inits an empty cache of the store, a ttl of zero means records are never dropped.
+func NewGraphs(ttl time.Duration, st store.Store) *Graphs {
+	return &Graphs{
+		ttl: ttl, now: time.Now, store: st,
+		graphs: map[string]*storedGraph{}, writing: map[string]*writeLock{},
+	}
+}
+
+// newRecord returns a record of the kind for the graph, which is both its base and current graph.
+func newRecord(kind rpcv1.RecordKind, graph *rpcv1.RandomGraphResponse) *rpcv1.GraphRecord {
+	meta := &rpcv1.GraphMetadata{}
+	meta.SetKind(kind)
+	meta.SetNumNodes(int64(len(graph.GetNodes())))
+	meta.SetNumEdges(int64(len(graph.GetEdges())))
+
+	rec := &rpcv1.GraphRecord{}
+	rec.SetMetadata(meta)
+	rec.SetBase(graph)
+	rec.SetGraph(graph)
+	return rec
+}
+
+// put stores the record under a new random id, and returns the id.
+func (gs *Graphs) put(ctx context.Context, rec *rpcv1.GraphRecord) (string, error) {
+	id := rand.Text()
+	now := gs.now().UnixMilli()
+	rec.GetMetadata().SetGraphId(id)
+	rec.GetMetadata().SetCreatedAt(now)
+	rec.GetMetadata().SetUpdatedAt(now)
+	if err := gs.store.Put(ctx, rec); err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to store graph: %w", err))
+	}
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.cache(ctx, id, rec)
+	return id, nil
+}
+
+// Get returns a copy of the current graph with the given id, so callers are free to modify it.
+func (gs *Graphs) Get(ctx context.Context, id string) (*rpcv1.RandomGraphResponse, error) {
+	return gs.graph(ctx, id, (*rpcv1.GraphRecord).GetGraph)
+}
+
+// Base returns a copy of the graph with the given id as it was before any walks were performed on it.
+func (gs *Graphs) Base(ctx context.Context, id string) (*rpcv1.RandomGraphResponse, error) {
+	return gs.graph(ctx, id, (*rpcv1.GraphRecord).GetBase)
+}
+
+// graph returns a copy of the graph picked from the record, records without a graph are not found.
+func (gs *Graphs) graph(
+	ctx context.Context, id string, pick func(*rpcv1.GraphRecord) *rpcv1.RandomGraphResponse,
+) (*rpcv1.RandomGraphResponse, error) {
+	rec, err := gs.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := pick(rec)
+	if graph == nil {
+		return nil, errGraphNotFound(id)
+	}
+	return proto.Clone(graph).(*rpcv1.RandomGraphResponse), nil //nolint:forcetypeassert
+}
+
+// Record returns a copy of the record with the given id.
+func (gs *Graphs) Record(ctx context.Context, id string) (*rpcv1.GraphRecord, error) {
+	rec, err := gs.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(rec).(*rpcv1.GraphRecord), nil //nolint:forcetypeassert
+}
+
+// update changes the record with the given id and writes it to the store. The change may only set
+// fields to values that are not shared with the caller.
+func (gs *Graphs) update(ctx context.Context, id string, change func(rec *rpcv1.GraphRecord)) error {
+	defer gs.lockWriting(id)()
+	rec, err := gs.load(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	rec = proto.Clone(rec).(*rpcv1.GraphRecord) //nolint:forcetypeassert
+	change(rec)
+	rec.GetMetadata().SetUpdatedAt(gs.now().UnixMilli())
+	if err := gs.store.Put(ctx, rec); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to store graph: %w", err))
+	}
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.cache(ctx, id, rec)
+	return nil
+}
+
+// delete removes the record from the store and from memory.
+func (gs *Graphs) delete(ctx context.Context, id string) error {
+	defer gs.lockWriting(id)()
+	err := gs.store.Delete(ctx, id)
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	delete(gs.graphs, id)
+	gs.deletes++
+	return storeError(id, err)
+}
+
+// lockWriting waits until no other change is made to the graph, and returns the function that allows
+// them again.
+func (gs *Graphs) lockWriting(id string) (unlock func()) {
+	gs.mu.Lock()
+	wl, ok := gs.writing[id]
+	if !ok {
+		wl = &writeLock{}
+		gs.writing[id] = wl
+	}
+	wl.waiting++
+	gs.mu.Unlock()
+
+	wl.Lock()
+	return func() {
+		wl.Unlock()
+		gs.mu.Lock()
+		defer gs.mu.Unlock()
+		if wl.waiting--; wl.waiting == 0 {
+			delete(gs.writing, id)
+		}
+	}
+}
+
+// List returns the metadata of the records in the store, most recently created first.
+func (gs *Graphs) List(ctx context.Context) ([]*rpcv1.GraphMetadata, error) {
+	gs.mu.Lock()
+	gs.sweep(ctx)
+	gs.mu.Unlock()
+
+	list, err := gs.store.List(ctx)
+	if err != nil {
+		return nil, storeError("", err)
+	}
+	return list, nil
+}
+
+// load returns the record in memory, loading it from the store if it isn't. The record is shared, so it
+// must not be modified.
+func (gs *Graphs) load(ctx context.Context, id string) (*rpcv1.GraphRecord, error) {
+	for {
+		gs.mu.Lock()
+		if rec, ok := gs.cached(ctx, id); ok {
+			gs.mu.Unlock()
+			return rec, nil
+		}
+		deletes := gs.deletes
+		gs.mu.Unlock()
+
+		rec, err := gs.store.Get(ctx, id)
+		if err != nil {
+			return nil, storeError(id, err)
+		}
+
+		gs.mu.Lock()
+		if deletes != gs.deletes {
+			gs.mu.Unlock()
+			continue // the record may have been deleted after it was read, so read it again.
+		}
+		if cached, ok := gs.cached(ctx, id); ok {
+			rec = cached // it was loaded or changed while the store was read.
+		} else {
+			gs.cache(ctx, id, rec)
+		}
+		gs.mu.Unlock()
+		return rec, nil
+	}
+}
+
+// cached returns the record in memory, if it hasn't expired. The lock must be held.
+func (gs *Graphs) cached(ctx context.Context, id string) (*rpcv1.GraphRecord, bool) {
+	sg, ok := gs.graphs[id]
+	if !ok {
+		return nil, false
+	}
+	if gs.expired(sg) {
+		gs.drop(ctx, id)
+		return nil, false
+	}
+	gs.touch(sg)
+	return sg.record, true
+}
+
+// cache keeps the record in memory, replacing the one it had. The lock must be held.
+func (gs *Graphs) cache(ctx context.Context, id string, rec *rpcv1.GraphRecord) {
+	gs.sweep(ctx)
+	sg := &storedGraph{record: rec}
+	gs.graphs[id] = sg
+	gs.touch(sg)
+}
+
+// storeError turns an error of the store into a connect error.
+func storeError(id string, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, store.ErrNotFound):
+		return errGraphNotFound(id)
+	default:
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access the store: %w", err))
+	}
+}
+
+// touch extends the life of the record in memory.
+func (gs *Graphs) touch(sg *storedGraph) {
+	if gs.ttl > 0 {
+		sg.expiresAt = gs.now().Add(gs.ttl)
+	}
+}
+
+// expired returns whether the record has outlived its ttl.
+func (gs *Graphs) expired(sg *storedGraph) bool {
+	return gs.ttl > 0 && gs.now().After(sg.expiresAt)
+}
+
+// sweep drops all expired records, it is called whenever a record is added so the cache doesn't need
+// a background routine to stay bounded.
+func (gs *Graphs) sweep(ctx context.Context) {
+	for id, sg := range gs.graphs {
+		if gs.expired(sg) {
+			gs.drop(ctx, id)
+		}
+	}
+}
+
+// drop removes the record from memory, and from the store if it isn't persistent. Such stores keep
+// their records in memory, so this doesn't wait on IO.
+func (gs *Graphs) drop(ctx context.Context, id string) {
+	delete(gs.graphs, id)
+	if !gs.store.Persistent() {
+		_ = gs.store.Delete(ctx, id) // the record may not have made it into the store.
+	}
+}
@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"slices"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// IntersectWalks finds the first point where any of Bob's walks meets any of Ada's walks. "First" is
+// the meeting with the smallest combined number of steps, ties are broken by Bob's step and then by the
+// order of the walks. It returns nil if none of the walks meet.
+func IntersectWalks(walks []*rpcv1.Walk) *rpcv1.WalkIntersection {
+	var bobWalks, adaWalks []int
+	for i, walk := range walks {
+		switch walk.GetOwner() {
+		case rpcv1.Party_PARTY_BOB:
+			bobWalks = append(bobWalks, i)
+		case rpcv1.Party_PARTY_ADA:
+			adaWalks = append(adaWalks, i)
+		case rpcv1.Party_PARTY_UNSPECIFIED:
+		}
+	}
+
+	// index, for each of Ada's walks, the first step at which it visits a node.
+	adaSteps := make([]map[string]int, len(adaWalks))
+	for i, wi := range adaWalks {
+		adaSteps[i] = map[string]int{}
+		for step, id := range walks[wi].GetNodeIds() {
+			if _, ok := adaSteps[i][id]; !ok {
+				adaSteps[i][id] = step
+			}
+		}
+	}
+
+	bestBob, bestAda, bestBobStep, bestAdaStep := -1, -1, 0, 0
+	for _, bi := range bobWalks {
+		for bobStep, id := range walks[bi].GetNodeIds() {
+			if bestBob >= 0 && bobStep > bestBobStep+bestAdaStep {
+				break // no later step on this walk can improve the best meeting.
+			}
+
+			for ai, steps := range adaSteps {
+				adaStep, ok := steps[id]
+				if !ok {
+					continue
+				}
+
+				if bestBob < 0 || bobStep+adaStep < bestBobStep+bestAdaStep ||
+					(bobStep+adaStep == bestBobStep+bestAdaStep && bobStep < bestBobStep) {
+					bestBob, bestAda, bestBobStep, bestAdaStep = bi, adaWalks[ai], bobStep, adaStep
+				}
+			}
+		}
+	}
+
+	if bestBob < 0 {
+		return nil
+	}
+
+	bob, ada := walks[bestBob], walks[bestAda]
+	isect := &rpcv1.WalkIntersection{}
+	isect.SetBobWalk(int64(bestBob))
+	isect.SetAdaWalk(int64(bestAda))
+	isect.SetBobStep(int64(bestBobStep))
+	isect.SetAdaStep(int64(bestAdaStep))
+	isect.SetNodeId(bob.GetNodeIds()[bestBobStep])
+
+	// the walks meet on an edge if both of them entered the meeting node over it.
+	if bestBobStep > 0 && bestAdaStep > 0 &&
+		bestBobStep <= len(bob.GetEdgeIds()) && bestAdaStep <= len(ada.GetEdgeIds()) &&
+		bob.GetEdgeIds()[bestBobStep-1] != "" && bob.GetEdgeIds()[bestBobStep-1] == ada.GetEdgeIds()[bestAdaStep-1] {
+		isect.SetEdgeId(bob.GetEdgeIds()[bestBobStep-1])
+	}
+
+	// the trust path follows Bob's walk up to the meeting node, and then Ada's walk backwards.
+	pathNodes := slices.Clone(bob.GetNodeIds()[:bestBobStep+1])
+	adaNodes := slices.Clone(ada.GetNodeIds()[:bestAdaStep])
+	slices.Reverse(adaNodes)
+	// steps at which a lazy walk stayed at its node are left out of the path.
+	isect.SetPathNodeIds(slices.Compact(append(pathNodes, adaNodes...)))
+
+	pathEdges := slices.Clone(bob.GetEdgeIds()[:min(bestBobStep, len(bob.GetEdgeIds()))])
+	adaEdges := slices.Clone(ada.GetEdgeIds()[:min(bestAdaStep, len(ada.GetEdgeIds()))])
+	slices.Reverse(adaEdges)
+	isect.SetPathEdgeIds(slices.DeleteFunc(append(pathEdges, adaEdges...), func(id string) bool {
+		return id == ""
+	}))
+
+	return isect
+}
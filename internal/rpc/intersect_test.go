@@ -0,0 +1,74 @@
+package rpc_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/advdv/trustd/internal/rpc"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// testWalk returns a walk of the party over the nodes and the edges between them.
+func testWalk(owner rpcv1.Party, nodes []string, edges []string) *rpcv1.Walk {
+	walk := &rpcv1.Walk{}
+	walk.SetOwner(owner)
+	walk.SetNodeIds(nodes)
+	walk.SetEdgeIds(edges)
+	return walk
+}
+
+func TestIntersectWalks(t *testing.T) {
+	bob, ada := rpcv1.Party_PARTY_BOB, rpcv1.Party_PARTY_ADA
+	walks := []*rpcv1.Walk{
+		// the first walks meet at x after 3+1 steps.
+		testWalk(bob, []string{"b", "p", "q", "x"}, []string{"b-p", "p-q", "q-x"}),
+		testWalk(ada, []string{"a", "x", "m", "y"}, []string{"a-x", "x-m", "m-y"}),
+		// Bob's second walk meets Ada's first walk at m after 1+2 steps, and her second walk at y after 2+1
+		// steps. Both take 3 steps, the tie goes to the meeting at which Bob took fewer steps.
+		testWalk(bob, []string{"b", "m", "y"}, []string{"b-m", "m-y"}),
+		testWalk(ada, []string{"a", "y"}, []string{"a-y"}),
+	}
+
+	isect := rpc.IntersectWalks(walks)
+	if isect == nil {
+		t.Fatal("expected the walks to meet")
+	}
+	if isect.GetNodeId() != "m" || isect.GetBobWalk() != 2 || isect.GetAdaWalk() != 1 ||
+		isect.GetBobStep() != 1 || isect.GetAdaStep() != 2 {
+		t.Fatalf("got meeting at %s on walks %d and %d after steps %d and %d, want m on walks 2 and 1 after "+
+			"steps 1 and 2", isect.GetNodeId(), isect.GetBobWalk(), isect.GetAdaWalk(), isect.GetBobStep(), isect.GetAdaStep())
+	}
+	if isect.GetEdgeId() != "" {
+		t.Fatalf("got meeting on edge %s, the walks entered m over different edges", isect.GetEdgeId())
+	}
+	if want := []string{"b", "m", "x", "a"}; !slices.Equal(isect.GetPathNodeIds(), want) {
+		t.Fatalf("got path %v, want %v", isect.GetPathNodeIds(), want)
+	}
+	if want := []string{"b-m", "x-m", "a-x"}; !slices.Equal(isect.GetPathEdgeIds(), want) {
+		t.Fatalf("got path edges %v, want %v", isect.GetPathEdgeIds(), want)
+	}
+}
+
+func TestIntersectWalksWithoutMeeting(t *testing.T) {
+	bob, ada := rpcv1.Party_PARTY_BOB, rpcv1.Party_PARTY_ADA
+	for _, tt := range []struct {
+		name  string
+		walks []*rpcv1.Walk
+	}{
+		{"disjoint walks", []*rpcv1.Walk{
+			testWalk(bob, []string{"b", "p"}, []string{"b-p"}),
+			testWalk(ada, []string{"a", "q"}, []string{"a-q"}),
+		}},
+		{"only Bob walks", []*rpcv1.Walk{
+			testWalk(bob, []string{"b", "p"}, []string{"b-p"}),
+			testWalk(bob, []string{"b", "p"}, []string{"b-p"}),
+		}},
+		{"no walks", nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if isect := rpc.IntersectWalks(tt.walks); isect != nil {
+				t.Fatalf("got meeting at %s, want none", isect.GetNodeId())
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// kamadaKawaiTolerance is the relative decrease of the stress below which the layout has converged.
+const kamadaKawaiTolerance = 1e-4
+
+// KamadaKawai positions the nodes such that their distance approximates their shortest path distance
+// in the graph, by minimizing the Kamada–Kawai stress
+//
+//	sum over i<j of w_ij (|x_i - x_j| - d_ij)², with w_ij = d_ij⁻²
+//
+// using (localized) stress majorization. It needs the distance between every pair of nodes, so it
+// takes O(n²) memory and time per iteration. Nodes in different components are placed one edge
+// length further apart than the largest distance in the graph.
+type KamadaKawai struct {
+	Iterations int
+	EdgeLength float64
+}
+
+// Apply implements Layout.
+func (kk KamadaKawai) Apply(
+	ctx context.Context, r *rand.Rand, resp *rpcv1.RandomGraphResponse,
+) (*rpcv1.RandomGraphResponse, error) {
+	const (
+		defaultIterations = 100
+		defaultEdgeLength = 50.0
+	)
+
+	nodes := resp.GetNodes()
+
+	// a single node has no distances to approximate, it is placed at the origin without any statistics.
+	//nolint:varnamelen
+	n := len(nodes)
+	if n <= 1 {
+		setPositions(nodes, make([][2]float64, n))
+		resp.ClearLayout()
+		return resp, nil
+	}
+
+	edgeLength := orDefault(kk.EdgeLength, defaultEdgeLength)
+	dist, err := allPairsHops(ctx, indexAdjacency(n, indexEdges(resp, indexNodes(nodes))))
+	if err != nil {
+		return nil, err
+	}
+
+	// ideal returns the ideal distance between i and j in the layout.
+	ideal := func(i, j int) float64 {
+		return float64(dist[i*n+j]) * edgeLength
+	}
+
+	// stress returns the stress of the current positions, or the error of the context once it is done.
+	positions := make([][2]float64, n)
+	stress := func() (float64, error) {
+		var sum float64
+		for i := range n {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+
+			for j := i + 1; j < n; j++ {
+				d := ideal(i, j)
+				diff := math.Hypot(positions[i][0]-positions[j][0], positions[i][1]-positions[j][1]) - d
+				sum += diff * diff / (d * d)
+			}
+		}
+		return sum, nil
+	}
+
+	side := edgeLength * math.Sqrt(float64(n))
+	for i := range positions {
+		positions[i] = [2]float64{r.Float64() * side, r.Float64() * side}
+	}
+
+	stats := &rpcv1.LayoutStats{}
+	prev, err := stress()
+	if err != nil {
+		return nil, err
+	}
+	for iter := range orDefault(kk.Iterations, defaultIterations) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// move every node to the position that majorizes the stress, given the other nodes.
+		for i := range n {
+			var sumX, sumY, sumW float64
+			for j := range n {
+				if i == j {
+					continue
+				}
+
+				dx := positions[i][0] - positions[j][0]
+				dy := positions[i][1] - positions[j][1]
+				norm := math.Hypot(dx, dy)
+				if norm < 1e-9 {
+					// Avoid division by zero; nudge slightly
+					dx = (r.Float64() - 0.5) * 0.01
+					dy = (r.Float64() - 0.5) * 0.01
+					norm = math.Hypot(dx, dy)
+				}
+
+				d := ideal(i, j)
+				w := 1 / (d * d)
+				sumX += w * (positions[j][0] + d*dx/norm)
+				sumY += w * (positions[j][1] + d*dy/norm)
+				sumW += w
+			}
+
+			positions[i] = [2]float64{sumX / sumW, sumY / sumW}
+		}
+
+		current, err := stress()
+		if err != nil {
+			return nil, err
+		}
+		stats.SetIterations(int64(iter + 1))
+		stats.SetEnergy(current)
+		if prev-current < kamadaKawaiTolerance*prev {
+			stats.SetConverged(true)
+			break
+		}
+		prev = current
+	}
+
+	setPositions(nodes, positions)
+	resp.SetLayout(stats)
+	return resp, nil
+}
+
+// allPairsHops returns the number of hops between every pair of nodes as a flattened n×n matrix, using
+// a breadth-first search from every node. Unreachable pairs are one hop further apart than the largest
+// distance in the graph. It stops with the error of the context once it is done.
+func allPairsHops(ctx context.Context, adjacency [][]int) ([]int32, error) {
+	//nolint:varnamelen
+	n := len(adjacency)
+	dist := make([]int32, n*n)
+	for i := range dist {
+		dist[i] = -1
+	}
+
+	var longest int32
+	queue := make([]int, 0, n)
+	for src := range n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		row := dist[src*n : (src+1)*n]
+		row[src] = 0
+
+		queue = append(queue[:0], src)
+		for head := 0; head < len(queue); head++ {
+			v := queue[head]
+			for _, u := range adjacency[v] {
+				if row[u] < 0 {
+					row[u] = row[v] + 1
+					longest = max(longest, row[u])
+					queue = append(queue, u)
+				}
+			}
+		}
+	}
+
+	for i, d := range dist {
+		if d < 0 {
+			dist[i] = longest + 1
+		}
+	}
+
+	return dist, nil
+}
@@ -0,0 +1,252 @@
+package rpc
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// Layout positions the nodes of a graph. It modifies and returns the same response. Iterative layouts
+// check the context between iterations, and return its error once it is done.
+type Layout interface {
+	Apply(ctx context.Context, r *rand.Rand, resp *rpcv1.RandomGraphResponse) (*rpcv1.RandomGraphResponse, error)
+}
+
+// newLayout returns the layout as selected by the request. If no layout is selected the request's
+// top-level layout fields configure a force-directed layout.
+func newLayout(req *rpcv1.RandomGraphRequest) Layout {
+	switch req.WhichLayout() {
+	case rpcv1.RandomGraphRequest_ForceDirected_case:
+		p := req.GetForceDirected()
+		return ForceDirected{
+			Iterations: int(p.GetIterations()),
+			Area:       p.GetArea(),
+			BarnesHut:  p.GetRepulsion() == rpcv1.RepulsionAlgorithm_REPULSION_ALGORITHM_BARNES_HUT,
+			Theta:      p.GetTheta(),
+			Cooling:    p.GetCooling(),
+			Tolerance:  p.GetTolerance(),
+		}
+	case rpcv1.RandomGraphRequest_Circular_case:
+		return Circular{Radius: req.GetCircular().GetRadius()}
+	case rpcv1.RandomGraphRequest_Spectral_case:
+		return Spectral{Scale: req.GetSpectral().GetScale()}
+	case rpcv1.RandomGraphRequest_KamadaKawai_case:
+		p := req.GetKamadaKawai()
+		return KamadaKawai{Iterations: int(p.GetIterations()), EdgeLength: p.GetEdgeLength()}
+	case rpcv1.RandomGraphRequest_Hierarchical_case:
+		p := req.GetHierarchical()
+		return Hierarchical{LayerSpacing: p.GetLayerSpacing(), NodeSpacing: p.GetNodeSpacing()}
+	default:
+		return ForceDirected{
+			Iterations: int(req.GetLayoutIterations()),
+			Area:       req.GetLayoutArea(),
+			BarnesHut:  req.GetLayoutRepulsion() == rpcv1.RepulsionAlgorithm_REPULSION_ALGORITHM_BARNES_HUT,
+			Theta:      req.GetLayoutTheta(),
+			Cooling:    req.GetLayoutCooling(),
+			Tolerance:  req.GetLayoutTolerance(),
+		}
+	}
+}
+
+// orDefault returns v, or def when v is not positive.
+func orDefault[T int | float64](v, def T) T {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
+// indexNodes maps the node ids onto their index in the graph.
+func indexNodes(nodes []*rpcv1.Node) map[string]int {
+	index := make(map[string]int, len(nodes))
+	for i, node := range nodes {
+		index[node.GetId()] = i
+	}
+	return index
+}
+
+// indexEdges returns the endpoints of the edges as node indices, edges with unknown endpoints are skipped.
+func indexEdges(resp *rpcv1.RandomGraphResponse, index map[string]int) [][2]int {
+	edges := make([][2]int, 0, len(resp.GetEdges()))
+	for _, e := range resp.GetEdges() {
+		srcIndex, srcOk := index[e.GetSource()]
+		tgtIndex, tgtOk := index[e.GetTarget()]
+		if !srcOk || !tgtOk {
+			continue // skip if invalid
+		}
+		edges = append(edges, [2]int{srcIndex, tgtIndex})
+	}
+	return edges
+}
+
+// indexAdjacency returns the (undirected) neighbors of every node by index.
+func indexAdjacency(n int, edges [][2]int) [][]int {
+	adjacency := make([][]int, n)
+	for _, e := range edges {
+		adjacency[e[0]] = append(adjacency[e[0]], e[1])
+		adjacency[e[1]] = append(adjacency[e[1]], e[0])
+	}
+	return adjacency
+}
+
+// setPositions writes the positions into the nodes, rounded to whole units.
+func setPositions(nodes []*rpcv1.Node, positions [][2]float64) {
+	for i, node := range nodes {
+		node.SetPosition(roundPosition(positions[i]))
+	}
+}
+
+// roundPosition converts a position to whole units.
+func roundPosition(p [2]float64) *rpcv1.Position {
+	pos := &rpcv1.Position{}
+	pos.SetX(int64(math.Round(p[0])))
+	pos.SetY(int64(math.Round(p[1])))
+	return pos
+}
+
+// Circular places the nodes on a circle in the order of the graph, the same way the generators do.
+type Circular struct {
+	Radius float64
+}
+
+// Apply implements Layout.
+func (c Circular) Apply(
+	_ context.Context, _ *rand.Rand, resp *rpcv1.RandomGraphResponse,
+) (*rpcv1.RandomGraphResponse, error) {
+	const defaultRadius = 300.0
+
+	nodes := resp.GetNodes()
+	radius := orDefault(c.Radius, defaultRadius)
+
+	positions := make([][2]float64, len(nodes))
+	for i := range positions {
+		angle := 2.0 * math.Pi * float64(i) / float64(len(nodes))
+		positions[i] = [2]float64{radius * math.Cos(angle), radius * math.Sin(angle)}
+	}
+
+	setPositions(nodes, positions)
+	resp.ClearLayout()
+	return resp, nil
+}
+
+// Hierarchical places the nodes in horizontal layers by their breadth-first distance from Bob, or
+// from the first node if there is no Bob. Nodes that can't be reached from there end up in a last
+// layer. Within a layer the nodes are ordered by the average position of their neighbors in the
+// layer above, which keeps the edges between layers short.
+type Hierarchical struct {
+	LayerSpacing float64
+	NodeSpacing  float64
+}
+
+// Apply implements Layout.
+func (h Hierarchical) Apply(
+	_ context.Context, _ *rand.Rand, resp *rpcv1.RandomGraphResponse,
+) (*rpcv1.RandomGraphResponse, error) {
+	const (
+		defaultLayerSpacing = 100.0
+		defaultNodeSpacing  = 50.0
+	)
+
+	nodes := resp.GetNodes()
+	if len(nodes) == 0 {
+		return resp, nil
+	}
+
+	index := indexNodes(nodes)
+	adjacency := indexAdjacency(len(nodes), indexEdges(resp, index))
+
+	root := 0
+	if bobID, _ := findParties(resp); bobID != "" {
+		root = index[bobID]
+	}
+
+	layers := bfsLayers(adjacency, root)
+	depth := make([]int, len(nodes))
+	for li, layer := range layers {
+		for _, v := range layer {
+			depth[v] = li
+		}
+	}
+
+	// order is the position of every node within its layer.
+	order := make([]float64, len(nodes))
+	for li, layer := range layers {
+		if li > 0 {
+			barycenter := make(map[int]float64, len(layer))
+			for _, v := range layer {
+				var sum, count float64
+				for _, u := range adjacency[v] {
+					if depth[u] == li-1 {
+						sum, count = sum+order[u], count+1
+					}
+				}
+				barycenter[v] = sum / max(count, 1)
+			}
+
+			slices.SortStableFunc(layer, func(a, b int) int {
+				return cmp.Compare(barycenter[a], barycenter[b])
+			})
+		}
+
+		for i, v := range layer {
+			order[v] = float64(i)
+		}
+	}
+
+	layerSpacing := orDefault(h.LayerSpacing, defaultLayerSpacing)
+	nodeSpacing := orDefault(h.NodeSpacing, defaultNodeSpacing)
+
+	positions := make([][2]float64, len(nodes))
+	for li, layer := range layers {
+		for i, v := range layer {
+			positions[v] = [2]float64{
+				(float64(i) - float64(len(layer)-1)/2) * nodeSpacing,
+				float64(li) * layerSpacing,
+			}
+		}
+	}
+
+	setPositions(nodes, positions)
+	resp.ClearLayout()
+	return resp, nil
+}
+
+// bfsLayers groups the nodes by their breadth-first distance from the root, the nodes that can't be
+// reached form the last layer.
+func bfsLayers(adjacency [][]int, root int) [][]int {
+	visited := make([]bool, len(adjacency))
+	visited[root] = true
+
+	layers := [][]int{{root}}
+	for {
+		var next []int
+		for _, v := range layers[len(layers)-1] {
+			for _, u := range adjacency[v] {
+				if !visited[u] {
+					visited[u] = true
+					next = append(next, u)
+				}
+			}
+		}
+		if len(next) == 0 {
+			break
+		}
+		layers = append(layers, next)
+	}
+
+	var unreachable []int
+	for v, ok := range visited {
+		if !ok {
+			unreachable = append(unreachable, v)
+		}
+	}
+	if len(unreachable) > 0 {
+		layers = append(layers, unreachable)
+	}
+
+	return layers
+}
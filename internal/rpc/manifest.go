@@ -0,0 +1,171 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// The stages of generating a graph, every stage draws from its own PCG source.
+const (
+	stageGraph    = "graph"
+	stageRelayout = "relayout"
+	stageWalks    = "walks"
+)
+
+// algorithmVersions are the versions of the algorithms of the stages. The version of an algorithm must
+// be increased whenever a change makes it produce a different graph for the same parameters and seeds,
+// so replays of older manifests are refused instead of silently producing another graph.
+var algorithmVersions = []struct {
+	name    string
+	version int64
+}{
+	{"generator", 1},
+	{"parties", 1},
+	{"sybil_region", 2},
+	{"orientation", 2},
+	{"edge_weights", 2},
+	{"layout", 1},
+	{"walks", 2},
+}
+
+// newManifest returns the manifest of a graph generated for the request by this server, the graph is
+// not walked.
+func (s g) newManifest(req *rpcv1.RandomGraphRequest) *rpcv1.GraphManifest {
+	manifest := &rpcv1.GraphManifest{}
+	manifest.SetRequest(proto.Clone(req).(*rpcv1.RandomGraphRequest)) //nolint:forcetypeassert
+	manifest.SetTrustdVersion(string(s.version))
+	setStage(manifest, stageGraph, req.GetSeed1(), req.GetSeed2())
+
+	algorithms := make([]*rpcv1.AlgorithmVersion, len(algorithmVersions))
+	for i, algorithm := range algorithmVersions {
+		algorithms[i] = &rpcv1.AlgorithmVersion{}
+		algorithms[i].SetName(algorithm.name)
+		algorithms[i].SetVersion(algorithm.version)
+	}
+	manifest.SetAlgorithms(algorithms)
+	return manifest
+}
+
+// setStage records the seeds of the stage, replacing those it had.
+func setStage(manifest *rpcv1.GraphManifest, stage string, seed1, seed2 uint64) {
+	seed := &rpcv1.StageSeed{}
+	seed.SetStage(stage)
+	seed.SetSeed1(seed1)
+	seed.SetSeed2(seed2)
+
+	seeds := manifest.GetSeeds()
+	for i, existing := range seeds {
+		if existing.GetStage() == stage {
+			seeds[i] = seed
+			return
+		}
+	}
+	manifest.SetSeeds(append(seeds, seed))
+}
+
+// stageSeed returns the seeds of the stage, and whether it ran.
+func stageSeed(manifest *rpcv1.GraphManifest, stage string) (*rpcv1.StageSeed, bool) {
+	for _, seed := range manifest.GetSeeds() {
+		if seed.GetStage() == stage {
+			return seed, true
+		}
+	}
+	return nil, false
+}
+
+// walked records in the manifest that the graph was walked with the walk parameters of the request.
+func walked(manifest *rpcv1.GraphManifest, params *rpcv1.RandomGraphRequest) {
+	req := manifest.GetRequest()
+	req.SetWalkLength(params.GetWalkLength())
+	req.SetNumWalks(params.GetNumWalks())
+	req.SetWalkMode(params.GetWalkMode())
+	req.SetLaziness(params.GetLaziness())
+	req.SetSeed3(params.GetSeed3())
+	req.SetSeed4(params.GetSeed4())
+	setStage(manifest, stageWalks, params.GetSeed3(), params.GetSeed4())
+}
+
+// relaidOut records in the manifest that the graph was laid out again with the layout parameters of the
+// request, which replaces any earlier layout as layouts don't depend on the positions they start from.
+func relaidOut(manifest *rpcv1.GraphManifest, params *rpcv1.RandomGraphRequest) {
+	manifest.SetRelayout(proto.Clone(params).(*rpcv1.RandomGraphRequest)) //nolint:forcetypeassert
+	setStage(manifest, stageRelayout, params.GetSeed1(), params.GetSeed2())
+}
+
+// checkAlgorithms fails with a failed precondition error unless the manifest lists exactly the versions
+// of the algorithms of this server.
+func checkAlgorithms(manifest *rpcv1.GraphManifest) error {
+	versions := make(map[string]int64, len(manifest.GetAlgorithms()))
+	for _, algorithm := range manifest.GetAlgorithms() {
+		versions[algorithm.GetName()] = algorithm.GetVersion()
+	}
+
+	for _, algorithm := range algorithmVersions {
+		if version := versions[algorithm.name]; version != algorithm.version {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf(
+				"the graph was generated with version %d of the %s algorithms, this server runs version %d",
+				version, algorithm.name, algorithm.version))
+		}
+	}
+	return nil
+}
+
+// replay generates the graph of the manifest: it builds the graph, lays it out again and walks it as
+// far as the stages of the manifest say, with the seeds of those stages. The graph carries a copy of
+// the manifest.
+func (s g) replay(ctx context.Context, manifest *rpcv1.GraphManifest) (*rpcv1.RandomGraphResponse, error) {
+	req := proto.Clone(manifest.GetRequest()).(*rpcv1.RandomGraphRequest) //nolint:forcetypeassert
+	if req == nil {
+		req = &rpcv1.RandomGraphRequest{}
+	}
+	if seed, ok := stageSeed(manifest, stageGraph); ok {
+		req.SetSeed1(seed.GetSeed1())
+		req.SetSeed2(seed.GetSeed2())
+	}
+
+	graph, err := s.build(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.HasRelayout() {
+		seed1, seed2 := manifest.GetRelayout().GetSeed1(), manifest.GetRelayout().GetSeed2()
+		if seed, ok := stageSeed(manifest, stageRelayout); ok {
+			seed1, seed2 = seed.GetSeed1(), seed.GetSeed2()
+		}
+
+		if err := s.checkLayoutSize(manifest.GetRelayout(), graph); err != nil {
+			return nil, err
+		}
+
+		//nolint:gosec
+		layoutRng := rand.New(rand.NewPCG(seed1, seed2))
+		if graph, err = newLayout(manifest.GetRelayout()).Apply(ctx, layoutRng, graph); err != nil {
+			return nil, contextError(err)
+		}
+	}
+
+	if seed, ok := stageSeed(manifest, stageWalks); ok {
+		req.SetSeed3(seed.GetSeed1())
+		req.SetSeed4(seed.GetSeed2())
+		if err := walkGraph(ctx, req, graph); err != nil {
+			return nil, contextError(err)
+		}
+	}
+
+	if req.GetIncludeStats() {
+		stats, err := ComputeGraphStats(ctx, graph)
+		if err != nil {
+			return nil, contextError(err)
+		}
+		graph.SetStats(stats)
+	}
+
+	graph.SetManifest(proto.Clone(manifest).(*rpcv1.GraphManifest)) //nolint:forcetypeassert
+	return graph, nil
+}
@@ -0,0 +1,208 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/advdv/trustd/internal/rpc"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"github.com/advdv/trustd/internal/rpc/v1/rpcv1connect"
+	"github.com/advdv/trustd/internal/store"
+	"google.golang.org/protobuf/proto"
+)
+
+func newService() rpcv1connect.GraphServiceHandler {
+	return rpc.NewService(rpc.Config{
+		MaxNodes:            100000,
+		MaxGraphFileSize:    1 << 20,
+		MaxIterations:       10000,
+		MaxKamadaKawaiNodes: 2000,
+		MaxWalkSteps:        10000000,
+		MaxPaths:            100,
+		MaxComputeTime:      time.Minute,
+		MaxExperimentRuns:   10000,
+	}, store.NewMemory(), "test")
+}
+
+// requireIdentical fails unless the graphs encode to the same bytes.
+func requireIdentical(t *testing.T, want, got *rpcv1.RandomGraphResponse) {
+	t.Helper()
+	opts := proto.MarshalOptions{Deterministic: true}
+	wantb, err := opts.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotb, err := opts.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(wantb) != string(gotb) {
+		t.Fatalf("replayed graph differs from the original")
+	}
+}
+
+func replay(ctx context.Context, t *testing.T, svc rpcv1connect.GraphServiceHandler,
+	manifest *rpcv1.GraphManifest,
+) *rpcv1.RandomGraphResponse {
+	t.Helper()
+	req := &rpcv1.ReplayRequest{}
+	req.SetManifest(manifest)
+	resp, err := svc.Replay(ctx, connect.NewRequest(req))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Msg
+}
+
+func TestReplayIsDeterministic(t *testing.T) {
+	ctx, svc := context.Background(), newService()
+
+	wattsStrogatz := &rpcv1.RandomGraphRequest{}
+	wattsStrogatz.SetSeed1(5)
+	wattsStrogatz.SetSeed2(3)
+	wattsStrogatz.SetNumNodes(80)
+	wattsStrogatz.SetInitialConnected(4)
+	wattsStrogatz.SetRewiringProbability(0.3)
+	wattsStrogatz.SetLayoutIterations(50)
+	wattsStrogatz.SetLayoutArea(10000)
+	wattsStrogatz.SetWalkLength(20)
+	wattsStrogatz.SetNumWalks(3)
+	wattsStrogatz.SetSeed3(7)
+	wattsStrogatz.SetSeed4(11)
+	wattsStrogatz.SetIncludeStats(true)
+
+	sybil := &rpcv1.SybilRegion{}
+	sybil.SetNumNodes(20)
+	sybil.SetInitialConnected(2)
+	sybil.SetRewiringProbability(0.1)
+	sybil.SetAttackEdges(3)
+	weights := &rpcv1.EdgeWeights{}
+	weights.SetDistribution(rpcv1.WeightDistribution_WEIGHT_DISTRIBUTION_EXPONENTIAL)
+	weights.SetMean(2)
+	barabasiAlbert := &rpcv1.RandomGraphRequest{}
+	barabasiAlbert.SetSeed1(1)
+	barabasiAlbert.SetSeed2(2)
+	barabasiAlbert.SetBarabasiAlbert(&rpcv1.BarabasiAlbertParams{})
+	barabasiAlbert.GetBarabasiAlbert().SetNumNodes(60)
+	barabasiAlbert.GetBarabasiAlbert().SetEdgesPerNode(2)
+	barabasiAlbert.SetKamadaKawai(&rpcv1.KamadaKawaiParams{})
+	barabasiAlbert.GetKamadaKawai().SetIterations(20)
+	barabasiAlbert.SetSybilRegion(sybil)
+	barabasiAlbert.SetEdgeWeights(weights)
+	barabasiAlbert.SetDirected(true)
+	barabasiAlbert.SetReciprocity(0.5)
+	barabasiAlbert.SetWalkMode(rpcv1.WalkMode_WALK_MODE_WEIGHTED)
+	barabasiAlbert.SetLaziness(0.2)
+	barabasiAlbert.SetWalkLength(30)
+	barabasiAlbert.SetNumWalks(2)
+	barabasiAlbert.SetSeed3(3)
+	barabasiAlbert.SetSeed4(4)
+
+	erdosRenyi := &rpcv1.RandomGraphRequest{}
+	erdosRenyi.SetSeed1(9)
+	erdosRenyi.SetErdosRenyi(&rpcv1.ErdosRenyiParams{})
+	erdosRenyi.GetErdosRenyi().SetNumNodes(50)
+	erdosRenyi.GetErdosRenyi().SetEdgeProbability(0.1)
+	erdosRenyi.SetCircular(&rpcv1.CircularParams{})
+	erdosRenyi.SetWalkMode(rpcv1.WalkMode_WALK_MODE_RANDOM_ROUTE)
+	erdosRenyi.SetWalkLength(15)
+	erdosRenyi.SetNumWalks(4)
+	erdosRenyi.SetSeed4(12)
+
+	for name, req := range map[string]*rpcv1.RandomGraphRequest{
+		"watts_strogatz":  wattsStrogatz,
+		"barabasi_albert": barabasiAlbert,
+		"erdos_renyi":     erdosRenyi,
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := svc.RandomGraph(ctx, connect.NewRequest(req))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !resp.Msg.HasManifest() {
+				t.Fatal("graph has no manifest")
+			}
+			if got := resp.Msg.GetManifest().GetTrustdVersion(); got != "test" {
+				t.Fatalf("trustd version is %q, want %q", got, "test")
+			}
+
+			requireIdentical(t, resp.Msg, replay(ctx, t, svc, resp.Msg.GetManifest()))
+			// the replay's own manifest replays the same graph again.
+			requireIdentical(t, resp.Msg, replay(ctx, t, svc, replay(ctx, t, svc, resp.Msg.GetManifest()).GetManifest()))
+		})
+	}
+}
+
+func TestReplayStoredGraph(t *testing.T) {
+	ctx, svc := context.Background(), newService()
+
+	params := &rpcv1.RandomGraphRequest{}
+	params.SetSeed1(21)
+	params.SetSeed2(22)
+	params.SetNumNodes(40)
+	params.SetInitialConnected(4)
+	params.SetRewiringProbability(0.2)
+	params.SetLayoutIterations(30)
+	params.SetLayoutArea(10000)
+	createReq := &rpcv1.CreateGraphRequest{}
+	createReq.SetParams(params)
+	created, err := svc.CreateGraph(ctx, connect.NewRequest(createReq))
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireIdentical(t, created.Msg.GetGraph(), replay(ctx, t, svc, created.Msg.GetGraph().GetManifest()))
+
+	walkParams := &rpcv1.RandomGraphRequest{}
+	walkParams.SetWalkLength(10)
+	walkParams.SetNumWalks(2)
+	walkParams.SetSeed3(31)
+	walkParams.SetSeed4(32)
+	walkReq := &rpcv1.RunWalksRequest{}
+	walkReq.SetGraphId(created.Msg.GetGraphId())
+	walkReq.SetParams(walkParams)
+	if _, err := svc.RunWalks(ctx, connect.NewRequest(walkReq)); err != nil {
+		t.Fatal(err)
+	}
+
+	layoutParams := &rpcv1.RandomGraphRequest{}
+	layoutParams.SetSeed1(41)
+	layoutParams.SetSeed2(42)
+	layoutParams.SetLayoutIterations(20)
+	layoutParams.SetLayoutArea(40000)
+	relayoutReq := &rpcv1.RelayoutRequest{}
+	relayoutReq.SetGraphId(created.Msg.GetGraphId())
+	relayoutReq.SetParams(layoutParams)
+	if _, err := svc.Relayout(ctx, connect.NewRequest(relayoutReq)); err != nil {
+		t.Fatal(err)
+	}
+
+	getReq := &rpcv1.GetGraphRequest{}
+	getReq.SetGraphId(created.Msg.GetGraphId())
+	stored, err := svc.GetGraph(ctx, connect.NewRequest(getReq))
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireIdentical(t, stored.Msg.GetGraph(), replay(ctx, t, svc, stored.Msg.GetGraph().GetManifest()))
+}
+
+func TestReplayRefusesOtherAlgorithmVersions(t *testing.T) {
+	ctx, svc := context.Background(), newService()
+
+	req := &rpcv1.RandomGraphRequest{}
+	req.SetNumNodes(10)
+	req.SetInitialConnected(2)
+	resp, err := svc.RandomGraph(ctx, connect.NewRequest(req))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := resp.Msg.GetManifest()
+	manifest.GetAlgorithms()[0].SetVersion(manifest.GetAlgorithms()[0].GetVersion() + 1)
+	replayReq := &rpcv1.ReplayRequest{}
+	replayReq.SetManifest(manifest)
+	if _, err := svc.Replay(ctx, connect.NewRequest(replayReq)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("replay failed with %v, want a failed precondition error", err)
+	}
+}
@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// PageRank computes personalized PageRank: the stationary distribution of a random surfer that follows
+// a uniformly random edge with probability Damping, and teleports back to the source otherwise. Nodes
+// without outgoing edges send the surfer back to the source as well.
+type PageRank struct {
+	Damping float64
+	// MonteCarlo estimates the scores from the end points of random walks instead of using power
+	// iteration.
+	MonteCarlo bool
+	// MaxIterations and Tolerance bound the power iteration.
+	MaxIterations int
+	Tolerance     float64
+	// NumWalks is the number of Monte Carlo walks.
+	NumWalks int
+}
+
+// newPageRank reads the configuration of personalized PageRank, applying the defaults.
+func newPageRank(p *rpcv1.PageRankParams) PageRank {
+	const (
+		defaultDamping       = 0.85
+		defaultMaxIterations = 100
+		defaultTolerance     = 1e-9
+		defaultNumWalks      = 10000
+	)
+
+	damping := defaultDamping
+	if p.HasDamping() {
+		damping = p.GetDamping()
+	}
+
+	return PageRank{
+		Damping:       damping,
+		MonteCarlo:    p.GetMethod() == rpcv1.PageRankMethod_PAGE_RANK_METHOD_MONTE_CARLO,
+		MaxIterations: orDefault(int(p.GetMaxIterations()), defaultMaxIterations),
+		Tolerance:     orDefault(p.GetTolerance(), defaultTolerance),
+		NumWalks:      orDefault(int(p.GetNumWalks()), defaultNumWalks),
+	}
+}
+
+// Scores returns the score of every node by id, personalized to the source node. It also returns the
+// number of power iterations, or walks, that were needed. It stops with the error of the context once
+// it is done.
+func (pr PageRank) Scores(
+	ctx context.Context, r *rand.Rand, resp *rpcv1.RandomGraphResponse, sourceID string,
+) (scores map[string]float64, iterations int, err error) {
+	if len(resp.GetNodes()) == 0 {
+		return map[string]float64{}, 0, nil
+	}
+
+	idx := newGraphIndex(resp)
+	sourceID = idx.startNode(sourceID)
+	if pr.MonteCarlo {
+		scores, err = pr.monteCarlo(ctx, r, idx, sourceID)
+		return scores, pr.NumWalks, err
+	}
+	return pr.powerIteration(ctx, idx, sourceID)
+}
+
+// powerIteration iterates x' = (1-d)·e_s + d·Pᵀx, with the mass of dangling nodes sent to the source,
+// until the L1 change is below the tolerance.
+func (pr PageRank) powerIteration(
+	ctx context.Context, idx *graphIndex, sourceID string,
+) (map[string]float64, int, error) {
+	index := indexNodes(idx.nodes)
+	source := index[sourceID]
+
+	out := make([][]int, len(idx.nodes))
+	for i, node := range idx.nodes {
+		for _, neighbor := range idx.adjacency[node.GetId()] {
+			out[i] = append(out[i], index[neighbor])
+		}
+	}
+
+	scores := make([]float64, len(idx.nodes))
+	next := make([]float64, len(idx.nodes))
+	scores[source] = 1
+
+	iterations := 0
+	for iterations < pr.MaxIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		iterations++
+
+		clear(next)
+		next[source] = 1 - pr.Damping
+		for i, neighbors := range out {
+			if len(neighbors) == 0 {
+				next[source] += pr.Damping * scores[i]
+				continue
+			}
+
+			share := pr.Damping * scores[i] / float64(len(neighbors))
+			for _, j := range neighbors {
+				next[j] += share
+			}
+		}
+
+		var change float64
+		for i := range scores {
+			change += math.Abs(next[i] - scores[i])
+		}
+
+		scores, next = next, scores
+		if change < pr.Tolerance {
+			break
+		}
+	}
+
+	result := make(map[string]float64, len(idx.nodes))
+	for i, node := range idx.nodes {
+		result[node.GetId()] = scores[i]
+	}
+	return result, iterations, nil
+}
+
+// monteCarlo estimates the scores as the fraction of walks from the source that end at each node. Every
+// walk continues with probability Damping at each step, so its length is geometrically distributed.
+// The walks are performed with the same logic as NonWeightedRandomWalk, a walk that gets stuck at a node
+// without outgoing edges jumps back to the source as part of its next step.
+func (pr PageRank) monteCarlo(
+	ctx context.Context, r *rand.Rand, idx *graphIndex, sourceID string,
+) (map[string]float64, error) {
+	ends := make(map[string]int, len(idx.nodes))
+	for range pr.NumWalks {
+		length := 0
+		for r.Float64() < pr.Damping {
+			length++
+		}
+
+		current := sourceID
+		for length > 0 {
+			path, _, err := idx.randomWalk(ctx, r, length, current, "", "")
+			if err != nil {
+				return nil, err
+			}
+			length -= len(path) - 1
+			current = path[len(path)-1]
+			if length > 0 {
+				current = sourceID
+				length--
+			}
+		}
+		ends[current]++
+	}
+
+	scores := make(map[string]float64, len(idx.nodes))
+	for _, node := range idx.nodes {
+		scores[node.GetId()] = float64(ends[node.GetId()]) / float64(max(1, pr.NumWalks))
+	}
+	return scores, nil
+}
@@ -0,0 +1,97 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"github.com/advdv/trustd/internal/rpc/v1/rpcv1connect"
+)
+
+// analyze runs trust, flow and paths between the parties of the source's graph, which must all find
+// Bob and Ada.
+func analyze(ctx context.Context, t *testing.T, svc rpcv1connect.GraphServiceHandler, src *rpcv1.GraphSource) {
+	t.Helper()
+
+	trustReq := &rpcv1.ComputeTrustRequest{}
+	trustReq.SetSource(src)
+	trust, err := svc.ComputeTrust(ctx, connect.NewRequest(trustReq))
+	if err != nil {
+		t.Fatalf("compute trust: %v", err)
+	}
+	if trust.Msg.GetSourceNodeId() == "" || trust.Msg.GetTargetNodeId() == "" {
+		t.Fatalf("compute trust: no parties")
+	}
+
+	flowReq := &rpcv1.MaxFlowRequest{}
+	flowReq.SetSource(src)
+	if _, err := svc.MaxFlow(ctx, connect.NewRequest(flowReq)); err != nil {
+		t.Fatalf("max flow: %v", err)
+	}
+
+	pathsReq := &rpcv1.ShortestPathsRequest{}
+	pathsReq.SetSource(src)
+	if _, err := svc.ShortestPaths(ctx, connect.NewRequest(pathsReq)); err != nil {
+		t.Fatalf("shortest paths: %v", err)
+	}
+}
+
+func walkedRequest(seed uint64) *rpcv1.RandomGraphRequest {
+	req := &rpcv1.RandomGraphRequest{}
+	req.SetSeed1(seed)
+	req.SetSeed3(seed)
+	req.SetNumNodes(30)
+	req.SetInitialConnected(4)
+	req.SetRewiringProbability(0.2)
+	req.SetCircular(&rpcv1.CircularParams{})
+	req.SetWalkLength(20)
+	req.SetNumWalks(3)
+	return req
+}
+
+func TestAnalyzeGeneratedWalkedGraph(t *testing.T) {
+	ctx, svc := context.Background(), newService()
+	for seed := range uint64(20) {
+		src := &rpcv1.GraphSource{}
+		src.SetGenerate(walkedRequest(seed))
+		analyze(ctx, t, svc, src)
+	}
+}
+
+func TestAnalyzeStoredWalkedGraph(t *testing.T) {
+	ctx, svc := context.Background(), newService()
+
+	createReq := &rpcv1.CreateGraphRequest{}
+	createReq.SetParams(walkedRequest(1))
+	created, err := svc.CreateGraph(ctx, connect.NewRequest(createReq))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	walkReq := &rpcv1.RunWalksRequest{}
+	walkReq.SetGraphId(created.Msg.GetGraphId())
+	walkReq.SetParams(walkedRequest(1))
+	walked, err := svc.RunWalks(ctx, connect.NewRequest(walkReq))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the parties keep their types, so they remain visible in the walked graph.
+	for _, node := range walked.Msg.GetGraph().GetNodes() {
+		switch node.GetId() {
+		case walked.Msg.GetGraph().GetBobNodeId():
+			if node.GetType() != "bobNode" {
+				t.Fatalf("bob's node has type %q", node.GetType())
+			}
+		case walked.Msg.GetGraph().GetAdaNodeId():
+			if node.GetType() != "adaNode" {
+				t.Fatalf("ada's node has type %q", node.GetType())
+			}
+		}
+	}
+
+	src := &rpcv1.GraphSource{}
+	src.SetGraphId(created.Msg.GetGraphId())
+	analyze(ctx, t, svc, src)
+}
@@ -0,0 +1,226 @@
+package rpc
+
+import (
+	"container/heap"
+	"context"
+	"math"
+	"slices"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// Path is a path through the graph by node and edge index, with its total cost.
+type Path struct {
+	Nodes []int
+	Edges []int
+	Cost  float64
+}
+
+// pathArc is an edge as it can be followed from one of its end points.
+type pathArc struct {
+	to, edge int
+}
+
+// pathGraph indexes a graph for shortest path searches. The cost of an edge is either 1, to count hops,
+// or -log(trust) with the weight as trust, so the cheapest path is the one whose trust product is
+// highest. Edges without a weight have a trust of 1, trust above 1 is capped at 1 and edges with a
+// trust of 0 or less can't be followed.
+type pathGraph struct {
+	out   [][]pathArc
+	costs []float64
+}
+
+// newPathGraph indexes the graph, the nodes and edges are identified by their index in the graph.
+func newPathGraph(resp *rpcv1.RandomGraphResponse, index map[string]int, trust bool) *pathGraph {
+	pg := &pathGraph{
+		out:   make([][]pathArc, len(resp.GetNodes())),
+		costs: make([]float64, len(resp.GetEdges())),
+	}
+	for ei, edge := range resp.GetEdges() {
+		source, okSource := index[edge.GetSource()]
+		target, okTarget := index[edge.GetTarget()]
+		if !okSource || !okTarget {
+			continue
+		}
+
+		pg.costs[ei] = 1
+		if trust {
+			if edge.HasWeight() && edge.GetWeight() <= 0 {
+				continue
+			}
+			if edge.HasWeight() {
+				pg.costs[ei] = -math.Log(min(edge.GetWeight(), 1))
+			} else {
+				pg.costs[ei] = 0
+			}
+		}
+
+		pg.out[source] = append(pg.out[source], pathArc{to: target, edge: ei})
+		if !resp.GetDirected() {
+			pg.out[target] = append(pg.out[target], pathArc{to: source, edge: ei})
+		}
+	}
+	return pg
+}
+
+// shortest returns the cheapest path from source to target with Dijkstra's algorithm, avoiding the
+// removed nodes and edges. Ties are broken in favor of fewer hops. It returns false if there is no path.
+func (pg *pathGraph) shortest(source, target int, removedNodes []bool, removedEdges map[int]bool) (Path, bool) {
+	dist := make([]float64, len(pg.out))
+	hops := make([]int, len(pg.out))
+	via := make([]pathArc, len(pg.out))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	dist[source] = 0
+
+	queue := &pathQueue{{node: source}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(pathItem) //nolint:forcetypeassert
+		if item.cost > dist[item.node] || (item.cost == dist[item.node] && item.hops > hops[item.node]) {
+			continue // stale entry.
+		}
+		if item.node == target {
+			break
+		}
+
+		for _, arc := range pg.out[item.node] {
+			if removedNodes[arc.to] || removedEdges[arc.edge] || arc.to == source {
+				continue
+			}
+
+			cost, hop := item.cost+pg.costs[arc.edge], item.hops+1
+			if cost < dist[arc.to] || (cost == dist[arc.to] && hop < hops[arc.to]) {
+				dist[arc.to], hops[arc.to] = cost, hop
+				via[arc.to] = pathArc{to: item.node, edge: arc.edge}
+				heap.Push(queue, pathItem{node: arc.to, cost: cost, hops: hop})
+			}
+		}
+	}
+
+	if math.IsInf(dist[target], 1) {
+		return Path{}, false
+	}
+
+	path := Path{Nodes: []int{target}, Cost: dist[target]}
+	for node := target; node != source; node = via[node].to {
+		path.Nodes = append(path.Nodes, via[node].to)
+		path.Edges = append(path.Edges, via[node].edge)
+	}
+	slices.Reverse(path.Nodes)
+	slices.Reverse(path.Edges)
+	return path, true
+}
+
+// KShortestPaths returns up to k loopless paths from the source to the target node in order of increasing
+// cost, costing edges like pathGraph does when trust is set and counting hops otherwise. The nodes and
+// edges of the paths are identified by their index in the graph. Once the context is done it fails with
+// its error.
+func KShortestPaths(
+	ctx context.Context, resp *rpcv1.RandomGraphResponse, sourceID, targetID string, k int, trust bool,
+) ([]Path, error) {
+	index := indexNodes(resp.GetNodes())
+	source, okSource := index[sourceID]
+	target, okTarget := index[targetID]
+	if !okSource || !okTarget {
+		return nil, nil
+	}
+	return newPathGraph(resp, index, trust).kShortest(ctx, source, target, k)
+}
+
+// kShortest returns up to k loopless paths from source to target in order of increasing cost, using
+// Yen's algorithm. Once the context is done it fails with its error.
+func (pg *pathGraph) kShortest(ctx context.Context, source, target, k int) ([]Path, error) {
+	if source == target {
+		return nil, nil
+	}
+
+	removedNodes := make([]bool, len(pg.out))
+	first, ok := pg.shortest(source, target, removedNodes, nil)
+	if !ok {
+		return nil, nil
+	}
+
+	found := []Path{first}
+	var candidates []Path
+	for len(found) < k {
+		prev := found[len(found)-1]
+		for i := range len(prev.Edges) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			spur := prev.Nodes[i]
+			rootEdges := prev.Edges[:i]
+
+			// leave out the next edge of every path found so far that shares this root, and the nodes of
+			// the root itself, so the spur path is new and loopless.
+			removedEdges := map[int]bool{}
+			for _, p := range found {
+				if len(p.Edges) > i && slices.Equal(p.Edges[:i], rootEdges) {
+					removedEdges[p.Edges[i]] = true
+				}
+			}
+			clear(removedNodes)
+			for _, node := range prev.Nodes[:i] {
+				removedNodes[node] = true
+			}
+
+			spurPath, ok := pg.shortest(spur, target, removedNodes, removedEdges)
+			if !ok {
+				continue
+			}
+
+			candidate := Path{
+				Nodes: append(slices.Clone(prev.Nodes[:i]), spurPath.Nodes...),
+				Edges: append(slices.Clone(rootEdges), spurPath.Edges...),
+			}
+			for _, edge := range candidate.Edges {
+				candidate.Cost += pg.costs[edge]
+			}
+
+			known := slices.ContainsFunc(candidates, func(p Path) bool { return slices.Equal(p.Edges, candidate.Edges) })
+			if !known {
+				candidates = append(candidates, candidate)
+			}
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		// take the cheapest candidate, ties go to fewer hops and then to the candidate found first.
+		best := 0
+		for i, candidate := range candidates {
+			if candidate.Cost < candidates[best].Cost ||
+				(candidate.Cost == candidates[best].Cost && len(candidate.Edges) < len(candidates[best].Edges)) {
+				best = i
+			}
+		}
+		found = append(found, candidates[best])
+		candidates = slices.Delete(candidates, best, best+1)
+	}
+	return found, nil
+}
+
+// pathItem is a node in the queue of Dijkstra's algorithm.
+type pathItem struct {
+	node, hops int
+	cost       float64
+}
+
+// pathQueue is a min-heap of nodes by cost and then hops.
+type pathQueue []pathItem
+
+func (q pathQueue) Len() int { return len(q) }
+func (q pathQueue) Less(i, j int) bool {
+	return q[i].cost < q[j].cost || (q[i].cost == q[j].cost && q[i].hops < q[j].hops)
+}
+func (q pathQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue) Push(x any)   { *q = append(*q, x.(pathItem)) } //nolint:forcetypeassert
+func (q *pathQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,157 @@
+package rpc_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/advdv/trustd/internal/rpc"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// testEdge is an edge of a test graph, edges with a weight of 0 get none.
+type testEdge struct {
+	source, target string
+	weight         float64
+}
+
+// testGraph returns a graph of the edges, its nodes are the end points of the edges in order of appearance.
+func testGraph(directed bool, edges ...testEdge) *rpcv1.RandomGraphResponse {
+	graph := &rpcv1.RandomGraphResponse{}
+	graph.SetDirected(directed)
+
+	seen := map[string]bool{}
+	var nodes []*rpcv1.Node
+	var graphEdges []*rpcv1.Edge
+	for i, e := range edges {
+		for _, id := range []string{e.source, e.target} {
+			if !seen[id] {
+				seen[id] = true
+				node := &rpcv1.Node{}
+				node.SetId(id)
+				nodes = append(nodes, node)
+			}
+		}
+
+		edge := &rpcv1.Edge{}
+		edge.SetId(fmt.Sprintf("e-%d", i))
+		edge.SetSource(e.source)
+		edge.SetTarget(e.target)
+		if e.weight != 0 {
+			edge.SetWeight(e.weight)
+		}
+		graphEdges = append(graphEdges, edge)
+	}
+	graph.SetNodes(nodes)
+	graph.SetEdges(graphEdges)
+	return graph
+}
+
+// requirePaths fails unless the paths lead from source to target over the edges of the graph, are
+// loopless and distinct, and come in order of nondecreasing cost.
+func requirePaths(t *testing.T, graph *rpcv1.RandomGraphResponse, paths []rpc.Path, source, target string) {
+	t.Helper()
+	nodes, edges := graph.GetNodes(), graph.GetEdges()
+	for i, path := range paths {
+		if nodes[path.Nodes[0]].GetId() != source || nodes[path.Nodes[len(path.Nodes)-1]].GetId() != target {
+			t.Fatalf("path %d doesn't lead from %s to %s", i, source, target)
+		}
+		if len(path.Edges) != len(path.Nodes)-1 {
+			t.Fatalf("path %d has %d nodes and %d edges", i, len(path.Nodes), len(path.Edges))
+		}
+		for j, ei := range path.Edges {
+			from, to := nodes[path.Nodes[j]].GetId(), nodes[path.Nodes[j+1]].GetId()
+			edge := edges[ei]
+			forward := edge.GetSource() == from && edge.GetTarget() == to
+			backward := !graph.GetDirected() && edge.GetSource() == to && edge.GetTarget() == from
+			if !forward && !backward {
+				t.Fatalf("edge %s of path %d doesn't lead from %s to %s", edge.GetId(), i, from, to)
+			}
+		}
+
+		visited := slices.Clone(path.Nodes)
+		slices.Sort(visited)
+		if len(slices.Compact(visited)) != len(path.Nodes) {
+			t.Fatalf("path %d visits a node twice: %v", i, path.Nodes)
+		}
+		for _, other := range paths[:i] {
+			if slices.Equal(other.Edges, path.Edges) {
+				t.Fatalf("path %d is found twice: %v", i, path.Edges)
+			}
+		}
+		if i > 0 && path.Cost < paths[i-1].Cost {
+			t.Fatalf("path %d costs %g, less than the %g of the path before it", i, path.Cost, paths[i-1].Cost)
+		}
+	}
+}
+
+func TestKShortestPaths(t *testing.T) {
+	// the loopless paths from s to t are s-a-t, s-b-t, s-a-b-t, s-b-a-t and the detour s-c-d-t, which is
+	// the longest by hops but the most trusted.
+	graph := testGraph(false,
+		testEdge{"s", "a", 0.9}, testEdge{"a", "t", 0.9}, testEdge{"s", "b", 0.5}, testEdge{"b", "t", 0.5},
+		testEdge{"a", "b", 0.9}, testEdge{"s", "c", 1}, testEdge{"c", "d", 1}, testEdge{"d", "t", 1})
+
+	for _, tt := range []struct {
+		name     string
+		k        int
+		trust    bool
+		want     int
+		cheapest []string
+	}{
+		{"hops", 3, false, 3, nil},
+		{"trust", 3, true, 3, []string{"s", "c", "d", "t"}},
+		{"fewer than k", 10, false, 5, nil},
+		{"fewer than k by trust", 10, true, 5, []string{"s", "c", "d", "t"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			paths, err := rpc.KShortestPaths(context.Background(), graph, "s", "t", tt.k, tt.trust)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(paths) != tt.want {
+				t.Fatalf("got %d paths, want %d", len(paths), tt.want)
+			}
+			requirePaths(t, graph, paths, "s", "t")
+
+			if tt.cheapest == nil {
+				return
+			}
+			var cheapest []string
+			for _, node := range paths[0].Nodes {
+				cheapest = append(cheapest, graph.GetNodes()[node].GetId())
+			}
+			if !slices.Equal(cheapest, tt.cheapest) {
+				t.Fatalf("got cheapest path %v, want %v", cheapest, tt.cheapest)
+			}
+		})
+	}
+}
+
+func TestKShortestPathsFollowDirections(t *testing.T) {
+	// only s-a-t follows the directions, b points towards s.
+	graph := testGraph(true,
+		testEdge{"s", "a", 0}, testEdge{"a", "t", 0}, testEdge{"b", "s", 0}, testEdge{"b", "t", 0})
+
+	paths, err := rpc.KShortestPaths(context.Background(), graph, "s", "t", 5, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requirePaths(t, graph, paths, "s", "t")
+	if len(paths) != 1 {
+		t.Fatalf("got %d paths, want 1", len(paths))
+	}
+}
+
+func TestKShortestPathsWithoutPath(t *testing.T) {
+	graph := testGraph(false, testEdge{"s", "a", 0}, testEdge{"b", "t", 0})
+
+	paths, err := rpc.KShortestPaths(context.Background(), graph, "s", "t", 5, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 0 {
+		t.Fatalf("got %d paths, want none", len(paths))
+	}
+}
@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"github.com/advdv/trustd/internal/trustmetric"
+)
+
+func (s g) ComputeTrust(
+	ctx context.Context, req *connect.Request[rpcv1.ComputeTrustRequest],
+) (*connect.Response[rpcv1.ComputeTrustResponse], error) {
+	if err := s.validate(req.Msg, func(v *validator) { v.computeTrust(req.Msg) }); err != nil {
+		return nil, err
+	}
+
+	graph, err := s.resolve(ctx, req.Msg.GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	bobID, adaID := findParties(graph)
+	if bobID == "" || adaID == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("graph has no Bob and Ada"))
+	}
+
+	//nolint:gosec
+	rng := rand.New(rand.NewPCG(
+		req.Msg.GetSeed1(), req.Msg.GetSeed2(),
+	))
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	scores, iterations, err := computeTrust(ctx, rng, graph, bobID, req.Msg)
+	if err != nil {
+		return nil, contextError(err)
+	}
+
+	resp := &rpcv1.ComputeTrustResponse{}
+	resp.SetScores(scores)
+	resp.SetSourceNodeId(bobID)
+	resp.SetTargetNodeId(adaID)
+	resp.SetTargetScore(scores[adaID])
+	resp.SetTargetRank(int64(rank(scores, bobID, adaID)))
+	resp.SetIterations(int64(iterations))
+	return connect.NewResponse(resp), nil
+}
+
+// computeTrust runs the metric selected by the request, personalized PageRank by default, and returns
+// the scores by node id with the number of iterations it needed. It stops with the error of the context
+// once it is done.
+func computeTrust(
+	ctx context.Context, r *rand.Rand, graph *rpcv1.RandomGraphResponse, sourceID string,
+	req *rpcv1.ComputeTrustRequest,
+) (map[string]float64, int, error) {
+	var metric trustmetric.Metric
+	switch req.WhichMetric() {
+	case rpcv1.ComputeTrustRequest_EigenTrust_case:
+		metric = newEigenTrust(req.GetEigenTrust())
+	case rpcv1.ComputeTrustRequest_TidalTrust_case:
+		metric = trustmetric.TidalTrust{}
+	case rpcv1.ComputeTrustRequest_Advogato_case:
+		capacities := make([]int, len(req.GetAdvogato().GetCapacities()))
+		for i, capacity := range req.GetAdvogato().GetCapacities() {
+			capacities[i] = int(capacity)
+		}
+		metric = trustmetric.Advogato{Capacities: capacities}
+	case rpcv1.ComputeTrustRequest_PersonalizedPageRank_case, rpcv1.ComputeTrustRequest_Metric_not_set_case:
+		return newPageRank(req.GetPersonalizedPageRank()).Scores(ctx, r, graph, sourceID)
+	}
+
+	tg := trustmetric.NewGraph(graph)
+	source, _ := tg.Index(sourceID)
+	result, err := metric.Compute(ctx, tg, source)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tg.ScoresByID(result.Scores), result.Iterations, nil
+}
+
+// newEigenTrust reads the configuration of EigenTrust, applying the defaults.
+func newEigenTrust(p *rpcv1.EigenTrustParams) trustmetric.EigenTrust {
+	const (
+		defaultPreTrustWeight = 0.15
+		defaultMaxIterations  = 100
+		defaultTolerance      = 1e-9
+	)
+
+	preTrustWeight := defaultPreTrustWeight
+	if p.HasPreTrustWeight() {
+		preTrustWeight = p.GetPreTrustWeight()
+	}
+
+	return trustmetric.EigenTrust{
+		PreTrustWeight:  preTrustWeight,
+		UniformPreTrust: p.GetUniformPreTrust(),
+		MaxIterations:   orDefault(int(p.GetMaxIterations()), defaultMaxIterations),
+		Tolerance:       orDefault(p.GetTolerance(), defaultTolerance),
+	}
+}
+
+// rank returns the position of the target when all nodes except the source are ordered by decreasing
+// score, starting at 1. Nodes with the same score share their rank.
+func rank(scores map[string]float64, sourceID, targetID string) int {
+	position := 1
+	for id, score := range scores {
+		if id != sourceID && score > scores[targetID] {
+			position++
+		}
+	}
+	return position
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/advdv/trustd/internal/graphio"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// resolve returns the graph that the source refers to, generating it if necessary.
+func (s g) resolve(ctx context.Context, src *rpcv1.GraphSource) (*rpcv1.RandomGraphResponse, error) {
+	switch src.WhichSource() {
+	case rpcv1.GraphSource_GraphId_case:
+		return s.graphs.Get(ctx, src.GetGraphId())
+	case rpcv1.GraphSource_Generate_case:
+		resp, err := s.RandomGraph(ctx, connect.NewRequest(src.GetGenerate()))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no graph source provided"))
+	}
+}
+
+func (s g) ExportGraph(
+	ctx context.Context, req *connect.Request[rpcv1.ExportGraphRequest],
+) (*connect.Response[rpcv1.ExportGraphResponse], error) {
+	format := graphioFormat(req.Msg.GetFormat(), "")
+	if format == graphio.FormatUnknown {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no export format provided"))
+	}
+
+	if err := s.validate(req.Msg, func(v *validator) { v.source("source", req.Msg.GetSource()) }); err != nil {
+		return nil, err
+	}
+
+	graph, err := s.resolve(ctx, req.Msg.GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := graphio.Write(&buf, graph, format); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to export graph: %w", err))
+	}
+
+	resp := &rpcv1.ExportGraphResponse{}
+	resp.SetContent(buf.Bytes())
+	resp.SetContentType(format.ContentType())
+	resp.SetFileName("graph" + format.Extension())
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,219 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// errGraphNotFound is returned when a graph id does not refer to a stored graph.
+func errGraphNotFound(id string) error {
+	return connect.NewError(connect.CodeNotFound, fmt.Errorf("no graph with id: %q", id))
+}
+
+func (s g) CreateGraph(
+	ctx context.Context, req *connect.Request[rpcv1.CreateGraphRequest],
+) (*connect.Response[rpcv1.CreateGraphResponse], error) {
+	if err := s.validate(req.Msg, func(v *validator) {
+		v.layoutSize("params", req.Msg.GetParams(), v.graph("params", req.Msg.GetParams()))
+		v.layout("params", req.Msg.GetParams())
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	graph, err := s.build(ctx, req.Msg.GetParams())
+	if err != nil {
+		return nil, err
+	}
+
+	// stats are not part of stored graphs, so replays of them leave them out too.
+	manifest := s.newManifest(req.Msg.GetParams())
+	manifest.GetRequest().ClearIncludeStats()
+	graph.SetManifest(manifest)
+
+	rec := newRecord(rpcv1.RecordKind_RECORD_KIND_GENERATED, proto.Clone(graph).(*rpcv1.RandomGraphResponse))
+	rec.GetMetadata().SetParams(req.Msg.GetParams())
+	id, err := s.graphs.put(ctx, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcv1.CreateGraphResponse{}
+	resp.SetGraphId(id)
+	resp.SetGraph(graph)
+	return connect.NewResponse(resp), nil
+}
+
+func (s g) RunWalks(
+	ctx context.Context, req *connect.Request[rpcv1.RunWalksRequest],
+) (*connect.Response[rpcv1.RunWalksResponse], error) {
+	if err := s.validate(req.Msg, func(v *validator) { v.walks("params", req.Msg.GetParams()) }); err != nil {
+		return nil, err
+	}
+
+	graph, err := s.graphs.Base(ctx, req.Msg.GetGraphId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	if err := walkGraph(ctx, req.Msg.GetParams(), graph); err != nil {
+		return nil, contextError(err)
+	}
+
+	// the graph may have been laid out again while it was walked. The walks don't depend on the positions,
+	// so they are stored with the layout and manifest of the graph as it is now.
+	if err := s.graphs.update(ctx, req.Msg.GetGraphId(), func(rec *rpcv1.GraphRecord) {
+		copyPositions(rec.GetBase(), graph)
+		if rec.GetBase().HasManifest() {
+			graph.SetManifest(proto.Clone(rec.GetBase().GetManifest()).(*rpcv1.GraphManifest))
+			walked(graph.GetManifest(), req.Msg.GetParams())
+		}
+		rec.SetGraph(proto.Clone(graph).(*rpcv1.RandomGraphResponse))
+		rec.GetMetadata().SetWalkParams(req.Msg.GetParams())
+	}); err != nil {
+		return nil, err
+	}
+
+	resp := &rpcv1.RunWalksResponse{}
+	resp.SetGraph(graph)
+	return connect.NewResponse(resp), nil
+}
+
+func (s g) Relayout(
+	ctx context.Context, req *connect.Request[rpcv1.RelayoutRequest],
+) (*connect.Response[rpcv1.RelayoutResponse], error) {
+	if err := s.validate(req.Msg, func(v *validator) { v.layout("params", req.Msg.GetParams()) }); err != nil {
+		return nil, err
+	}
+
+	base, err := s.graphs.Base(ctx, req.Msg.GetGraphId())
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:gosec
+	layoutRng := rand.New(rand.NewPCG(
+		req.Msg.GetParams().GetSeed1(), req.Msg.GetParams().GetSeed2(),
+	))
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	if err := s.checkLayoutSize(req.Msg.GetParams(), base); err != nil {
+		return nil, err
+	}
+
+	laidOut, err := newLayout(req.Msg.GetParams()).Apply(ctx, layoutRng, base)
+	if err != nil {
+		return nil, contextError(err)
+	}
+
+	current, err := s.saveLayout(ctx, req.Msg.GetGraphId(), req.Msg.GetParams(), laidOut)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcv1.RelayoutResponse{}
+	resp.SetGraph(current)
+	return connect.NewResponse(resp), nil
+}
+
+func (s g) ListGraphs(
+	ctx context.Context, req *connect.Request[rpcv1.ListGraphsRequest],
+) (*connect.Response[rpcv1.ListGraphsResponse], error) {
+	list, err := s.graphs.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	graphs := list[:0]
+	for _, meta := range list {
+		if kind := req.Msg.GetKind(); kind == rpcv1.RecordKind_RECORD_KIND_UNSPECIFIED || meta.GetKind() == kind {
+			graphs = append(graphs, meta)
+		}
+	}
+
+	resp := &rpcv1.ListGraphsResponse{}
+	resp.SetGraphs(graphs)
+	return connect.NewResponse(resp), nil
+}
+
+func (s g) GetGraph(
+	ctx context.Context, req *connect.Request[rpcv1.GetGraphRequest],
+) (*connect.Response[rpcv1.GetGraphResponse], error) {
+	rec, err := s.graphs.Record(ctx, req.Msg.GetGraphId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcv1.GetGraphResponse{}
+	resp.SetGraph(rec.GetGraph())
+	resp.SetMetadata(rec.GetMetadata())
+	resp.SetResults(rec.GetResults())
+	return connect.NewResponse(resp), nil
+}
+
+func (s g) DeleteGraph(
+	ctx context.Context, req *connect.Request[rpcv1.DeleteGraphRequest],
+) (*connect.Response[rpcv1.DeleteGraphResponse], error) {
+	if err := s.graphs.delete(ctx, req.Msg.GetGraphId()); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rpcv1.DeleteGraphResponse{}), nil
+}
+
+// saveLayout stores the positions of the laid out graph with the stored graph, and returns the stored
+// graph with its walks. The graph may have been walked again while it was laid out, so the positions are
+// applied to the graph as it is now.
+func (s g) saveLayout(
+	ctx context.Context, id string, params *rpcv1.RandomGraphRequest, laidOut *rpcv1.RandomGraphResponse,
+) (*rpcv1.RandomGraphResponse, error) {
+	var current *rpcv1.RandomGraphResponse
+	if err := s.graphs.update(ctx, id, func(rec *rpcv1.GraphRecord) {
+		copyPositions(laidOut, rec.GetBase())
+		copyPositions(laidOut, rec.GetGraph())
+		relaidOutGraphs(params, rec.GetBase(), rec.GetGraph())
+		rec.GetMetadata().SetLayoutParams(params)
+		current = proto.Clone(rec.GetGraph()).(*rpcv1.RandomGraphResponse)
+	}); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// relaidOutGraphs records the layout in the manifests of the graphs that have one.
+func relaidOutGraphs(params *rpcv1.RandomGraphRequest, graphs ...*rpcv1.RandomGraphResponse) {
+	for _, graph := range graphs {
+		if graph.HasManifest() {
+			relaidOut(graph.GetManifest(), params)
+		}
+	}
+}
+
+// copyPositions copies the node positions and layout statistics from one graph onto another, nodes
+// are matched by id.
+func copyPositions(from, to *rpcv1.RandomGraphResponse) {
+	positions := make(map[string]*rpcv1.Position, len(from.GetNodes()))
+	for _, node := range from.GetNodes() {
+		positions[node.GetId()] = node.GetPosition()
+	}
+
+	for _, node := range to.GetNodes() {
+		if pos, ok := positions[node.GetId()]; ok {
+			node.SetPosition(proto.Clone(pos).(*rpcv1.Position))
+		}
+	}
+
+	to.SetLayout(proto.Clone(from.GetLayout()).(*rpcv1.LayoutStats))
+}
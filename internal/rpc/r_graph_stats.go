@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+func (s g) GraphStats(
+	ctx context.Context, req *connect.Request[rpcv1.GraphStatsRequest],
+) (*connect.Response[rpcv1.GraphStatsResponse], error) {
+	if err := s.validate(req.Msg, func(v *validator) { v.source("source", req.Msg.GetSource()) }); err != nil {
+		return nil, err
+	}
+
+	graph, err := s.resolve(ctx, req.Msg.GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	stats, err := ComputeGraphStats(ctx, graph)
+	if err != nil {
+		return nil, contextError(err)
+	}
+
+	resp := &rpcv1.GraphStatsResponse{}
+	resp.SetStats(stats)
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"connectrpc.com/connect"
+	"github.com/advdv/trustd/internal/graphio"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// graphioFormat maps the protobuf format onto the graphio format, falling back to the file extension.
+func graphioFormat(format rpcv1.GraphFormat, fileName string) graphio.Format {
+	switch format {
+	case rpcv1.GraphFormat_GRAPH_FORMAT_EDGE_LIST:
+		return graphio.FormatEdgeList
+	case rpcv1.GraphFormat_GRAPH_FORMAT_GRAPHML:
+		return graphio.FormatGraphML
+	case rpcv1.GraphFormat_GRAPH_FORMAT_GEXF:
+		return graphio.FormatGEXF
+	case rpcv1.GraphFormat_GRAPH_FORMAT_DOT:
+		return graphio.FormatDOT
+	case rpcv1.GraphFormat_GRAPH_FORMAT_JSON:
+		return graphio.FormatJSON
+	default:
+		return graphio.FormatFromPath(fileName)
+	}
+}
+
+func (s g) LoadGraph(
+	ctx context.Context, req *connect.Request[rpcv1.LoadGraphRequest],
+) (*connect.Response[rpcv1.LoadGraphResponse], error) {
+	if s.cfg.GraphDir == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("no graph directory configured"))
+	}
+
+	format := graphioFormat(req.Msg.GetFormat(), req.Msg.GetFileName())
+	if format == graphio.FormatUnknown {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("unable to determine format of: %q", req.Msg.GetFileName()))
+	}
+
+	// opening in the root makes sure the file name cannot escape the graph directory.
+	file, err := os.OpenInRoot(s.cfg.GraphDir, req.Msg.GetFileName())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no such graph file: %q", req.Msg.GetFileName()))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to open graph file: %w", err))
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to stat graph file: %w", err))
+	} else if info.Size() > s.cfg.MaxGraphFileSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(
+			"graph file has %d bytes, at most %d are supported", info.Size(), s.cfg.MaxGraphFileSize))
+	}
+
+	// the limit also holds when the file grows while it is read.
+	graph, err := graphio.Read(io.LimitReader(file, s.cfg.MaxGraphFileSize), format)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to read graph: %w", err))
+	} else if len(graph.GetNodes()) > s.cfg.MaxNodes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(
+			"graph has %d nodes, at most %d are supported", len(graph.GetNodes()), s.cfg.MaxNodes))
+	}
+	partiesByType(graph)
+
+	rec := newRecord(rpcv1.RecordKind_RECORD_KIND_IMPORTED, graph)
+	rec.GetMetadata().SetFileName(req.Msg.GetFileName())
+	id, err := s.graphs.put(ctx, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcv1.LoadGraphResponse{}
+	resp.SetGraphId(id)
+	resp.SetNumNodes(int64(len(graph.GetNodes())))
+	resp.SetNumEdges(int64(len(graph.GetEdges())))
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+func (s g) MaxFlow(
+	ctx context.Context, req *connect.Request[rpcv1.MaxFlowRequest],
+) (*connect.Response[rpcv1.MaxFlowResponse], error) {
+	if err := s.validate(req.Msg, func(v *validator) { v.source("source", req.Msg.GetSource()) }); err != nil {
+		return nil, err
+	}
+
+	graph, err := s.resolve(ctx, req.Msg.GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	bobID, adaID := findParties(graph)
+	if bobID == "" || adaID == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("graph has no Bob and Ada"))
+	}
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	weighted := req.Msg.GetCapacity() == rpcv1.FlowCapacity_FLOW_CAPACITY_TRUST
+	value, cut, err := MinCut(ctx, graph, bobID, adaID, weighted)
+	if err != nil {
+		return nil, contextError(err)
+	}
+
+	// highlight the bottleneck, the other edges keep their type.
+	cutEdges := make(map[string]bool, len(cut))
+	for _, id := range cut {
+		cutEdges[id] = true
+	}
+	for _, edge := range graph.GetEdges() {
+		if cutEdges[edge.GetId()] {
+			edge.SetType("cutEdge")
+		}
+	}
+
+	resp := &rpcv1.MaxFlowResponse{}
+	resp.SetSourceNodeId(bobID)
+	resp.SetTargetNodeId(adaID)
+	resp.SetValue(value)
+	resp.SetCutEdgeIds(cut)
+	resp.SetGraph(graph)
+	return connect.NewResponse(resp), nil
+}
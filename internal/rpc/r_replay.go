@@ -0,0 +1,31 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+func (s g) Replay(
+	ctx context.Context, req *connect.Request[rpcv1.ReplayRequest],
+) (*connect.Response[rpcv1.RandomGraphResponse], error) {
+	manifest := req.Msg.GetManifest()
+	if err := s.validate(req.Msg, func(v *validator) { v.manifest("manifest", manifest) }); err != nil {
+		return nil, err
+	}
+
+	if err := checkAlgorithms(manifest); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	graph, err := s.replay(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(graph), nil
+}
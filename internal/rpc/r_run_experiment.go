@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+func (s g) RunExperiment(
+	ctx context.Context, req *connect.Request[rpcv1.RunExperimentRequest],
+) (*connect.Response[rpcv1.RunExperimentResponse], error) {
+	if err := s.validate(req.Msg, func(v *validator) { v.experiment(req.Msg) }); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	results, err := s.runExperiment(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcv1.RunExperimentResponse{}
+	resp.SetResults(results)
+
+	rec := &rpcv1.GraphRecord{}
+	rec.SetMetadata(&rpcv1.GraphMetadata{})
+	rec.GetMetadata().SetKind(rpcv1.RecordKind_RECORD_KIND_EXPERIMENT)
+	rec.GetMetadata().SetExperiment(req.Msg)
+	rec.SetResults(resp)
+	id, err := s.graphs.put(ctx, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.SetExperimentId(id)
+	return connect.NewResponse(resp), nil
+}
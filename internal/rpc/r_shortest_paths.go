@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+func (s g) ShortestPaths(
+	ctx context.Context, req *connect.Request[rpcv1.ShortestPathsRequest],
+) (*connect.Response[rpcv1.ShortestPathsResponse], error) {
+	if err := s.validate(req.Msg, func(v *validator) {
+		v.source("source", req.Msg.GetSource())
+		v.atMost("k", req.Msg.GetK(), int64(s.cfg.MaxPaths), "trustd.max_paths")
+	}); err != nil {
+		return nil, err
+	}
+
+	graph, err := s.resolve(ctx, req.Msg.GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	bobID, adaID := findParties(graph)
+	if bobID == "" || adaID == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("graph has no Bob and Ada"))
+	}
+
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	paths, err := KShortestPaths(ctx, graph, bobID, adaID, orDefault(int(req.Msg.GetK()), 1),
+		req.Msg.GetCost() == rpcv1.PathCost_PATH_COST_TRUST)
+	if err != nil {
+		return nil, contextError(err)
+	}
+
+	nodes, edges := graph.GetNodes(), graph.GetEdges()
+	trustPaths := make([]*rpcv1.TrustPath, len(paths))
+	for i, path := range paths {
+		nodeIDs := make([]string, len(path.Nodes))
+		for j, node := range path.Nodes {
+			nodeIDs[j] = nodes[node].GetId()
+			if j > 0 && j < len(path.Nodes)-1 {
+				nodes[node].SetType("pathNode")
+			}
+		}
+
+		edgeIDs := make([]string, len(path.Edges))
+		for j, edge := range path.Edges {
+			edgeIDs[j] = edges[edge].GetId()
+			edges[edge].SetType("pathEdge")
+		}
+
+		trustPaths[i] = &rpcv1.TrustPath{}
+		trustPaths[i].SetNodeIds(nodeIDs)
+		trustPaths[i].SetEdgeIds(edgeIDs)
+		trustPaths[i].SetCost(path.Cost)
+	}
+
+	resp := &rpcv1.ShortestPathsResponse{}
+	resp.SetSourceNodeId(bobID)
+	resp.SetTargetNodeId(adaID)
+	resp.SetPaths(trustPaths)
+	resp.SetGraph(graph)
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultSnapshotInterval is the number of iterations between snapshots when none is configured.
+const defaultSnapshotInterval = 10
+
+func (s g) StreamLayout(
+	ctx context.Context,
+	req *connect.Request[rpcv1.StreamLayoutRequest],
+	stream *connect.ServerStream[rpcv1.StreamLayoutResponse],
+) error {
+	if err := s.validate(req.Msg, func(v *validator) { v.layout("params", req.Msg.GetParams()) }); err != nil {
+		return err
+	}
+
+	layout, ok := newLayout(req.Msg.GetParams()).(ForceDirected)
+	if !ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("only the force-directed layout can be streamed"))
+	}
+
+	base, err := s.graphs.Base(ctx, req.Msg.GetGraphId())
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec
+	layoutRng := rand.New(rand.NewPCG(
+		req.Msg.GetParams().GetSeed1(), req.Msg.GetParams().GetSeed2(),
+	))
+
+	// the layout stops once the client went away or aborted, or the compute time ran out.
+	ctx, cancel := s.computeContext(ctx)
+	defer cancel()
+
+	interval := orDefault(int(req.Msg.GetSnapshotInterval()), defaultSnapshotInterval)
+	if err := layout.Stream(ctx, layoutRng, base, interval, func(stats *rpcv1.LayoutStats, positions [][2]float64) error {
+		snapshot := make([]*rpcv1.NodePosition, len(positions))
+		for i, node := range base.GetNodes() {
+			snapshot[i] = &rpcv1.NodePosition{}
+			snapshot[i].SetNodeId(node.GetId())
+			snapshot[i].SetPosition(roundPosition(positions[i]))
+		}
+
+		msg := &rpcv1.StreamLayoutResponse{}
+		msg.SetStats(proto.Clone(stats).(*rpcv1.LayoutStats))
+		msg.SetPositions(snapshot)
+		return stream.Send(msg)
+	}); err != nil {
+		return contextError(err)
+	}
+
+	current, err := s.saveLayout(ctx, req.Msg.GetGraphId(), req.Msg.GetParams(), base)
+	if err != nil {
+		return err
+	}
+
+	msg := &rpcv1.StreamLayoutResponse{}
+	msg.SetStats(current.GetLayout())
+	msg.SetGraph(current)
+	return stream.Send(msg)
+}
@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"math/rand/v2"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// RouteTable holds the routing tables of a single random route instance as used by SybilGuard and
+// SybilLimit: every node has a random permutation over its edges that maps the edge a route arrives on
+// to the edge it leaves on. Routes of the same instance that traverse the same edge in the same
+// direction therefore continue along the same path. In a directed graph a node may have a different
+// number of incoming and outgoing edges, the permutation then covers the larger of the two and is
+// taken modulo the number of outgoing edges.
+type RouteTable struct {
+	idx *graphIndex
+	// perms maps each node to a permutation over the positions in its neighbor lists.
+	perms map[string][]int
+	// position maps a directed (from, to) pair to the position of "from" in the incoming list of "to".
+	position map[[2]string]int
+}
+
+// NewRouteTables builds the routing tables for the given number of random route instances (SybilLimit's
+// r), drawing every permutation from the provided random source.
+func NewRouteTables(rng *rand.Rand, resp *rpcv1.RandomGraphResponse, instances int) []*RouteTable {
+	idx := newGraphIndex(resp)
+
+	position := make(map[[2]string]int, 2*len(resp.GetEdges()))
+	for _, node := range idx.nodes {
+		for i, neighbor := range idx.incoming[node.GetId()] {
+			position[[2]string{neighbor, node.GetId()}] = i
+		}
+	}
+
+	tables := make([]*RouteTable, 0, instances)
+	for range instances {
+		table := &RouteTable{idx: idx, position: position, perms: make(map[string][]int, len(idx.nodes))}
+		for _, node := range idx.nodes {
+			table.perms[node.GetId()] = rng.Perm(max(len(idx.incoming[node.GetId()]), len(idx.adjacency[node.GetId()])))
+		}
+		tables = append(tables, table)
+	}
+
+	return tables
+}
+
+// RandomRoute follows the random route of a single instance for `routeLength` steps from the given node
+// ID. The first edge is picked uniformly at random, after that the route is fully determined by the
+// routing table. Nodes and edges are re-typed the same way as NonWeightedRandomWalk does, and the
+// visited node ids and traversed edge ids are returned in route order. The route stops with the error
+// of the context once it is done.
+func RandomRoute(
+	ctx context.Context,
+	rng *rand.Rand,
+	table *RouteTable,
+	routeLength int,
+	startNodeID string,
+	newNodeType string,
+	newEdgeType string,
+) (path, edgePath []string, err error) {
+	if table == nil || len(table.idx.nodes) == 0 {
+		return nil, nil, nil
+	}
+
+	idx := table.idx
+	current := idx.startNode(startNodeID)
+
+	path = make([]string, 0, routeLength+1)
+	edgePath = make([]string, 0, routeLength)
+	path = append(path, current)
+
+	previous := ""
+	for range routeLength {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		neighbors := idx.adjacency[current]
+		if len(neighbors) == 0 {
+			break
+		}
+
+		var next string
+		if previous == "" {
+			next = neighbors[rng.IntN(len(neighbors))]
+		} else {
+			next = neighbors[table.perms[current][table.position[[2]string{previous, current}]]%len(neighbors)]
+		}
+
+		if edgeID, ok := idx.step(current, next, newNodeType, newEdgeType); ok {
+			edgePath = append(edgePath, edgeID)
+		}
+
+		path = append(path, next)
+		previous, current = current, next
+	}
+
+	return path, edgePath, nil
+}
@@ -0,0 +1,57 @@
+package rpc_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/advdv/trustd/internal/rpc"
+)
+
+func TestRandomRoutes(t *testing.T) {
+	// a ring of 10 nodes in which every node is also linked to the node two steps further, so that every
+	// node has 4 neighbors to route to.
+	var edges []testEdge
+	for i := range 10 {
+		node := func(j int) string { return fmt.Sprintf("n%d", j%10) }
+		edges = append(edges, testEdge{node(i), node(i + 1), 0}, testEdge{node(i), node(i + 2), 0})
+	}
+	graph := testGraph(false, edges...)
+
+	//nolint:gosec
+	tables := rpc.NewRouteTables(rand.New(rand.NewPCG(1, 2)), graph, 1)
+
+	// routes from every node, with their first edges drawn from different sources.
+	var routes [][]string
+	for i, node := range graph.GetNodes() {
+		for seed := range uint64(4) {
+			//nolint:gosec
+			rng := rand.New(rand.NewPCG(uint64(i), seed))
+			route, _, err := rpc.RandomRoute(context.Background(), rng, tables[0], 15, node.GetId(), "", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			routes = append(routes, route)
+		}
+	}
+
+	// routes that traverse an edge in the same direction continue along the same path.
+	for i, route := range routes {
+		for j, other := range routes[:i] {
+			for a := range len(route) - 1 {
+				for b := range len(other) - 1 {
+					if route[a] != other[b] || route[a+1] != other[b+1] {
+						continue
+					}
+					n := min(len(route)-a, len(other)-b)
+					if !slices.Equal(route[a:a+n], other[b:b+n]) {
+						t.Fatalf("routes %d and %d enter %s from %s, and then go %v and %v",
+							i, j, route[a+1], route[a], route[a:a+n], other[b:b+n])
+					}
+				}
+			}
+		}
+	}
+}
@@ -3,40 +3,93 @@ package rpc
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/advdv/stdgo/stdfx"
 	"github.com/advdv/trustd/internal/rpc/v1/rpcv1connect"
+	"github.com/advdv/trustd/internal/store"
 	"go.uber.org/fx"
 )
 
 // Config configures the package's components.
-type Config struct{}
+type Config struct {
+	// GraphDir is the directory that graph files are loaded from.
+	GraphDir string `env:"GRAPH_DIR"`
+	// GraphTTL is how long stored graphs are kept in memory after they were last accessed. They remain in a
+	// persistent store, otherwise they are dropped.
+	GraphTTL time.Duration `env:"GRAPH_TTL" envDefault:"1h"`
+	// MaxNodes caps the number of nodes of a generated or loaded graph, including its Sybil region.
+	MaxNodes int `env:"MAX_NODES" envDefault:"100000"`
+	// MaxGraphFileSize caps the size in bytes of the graph files that are loaded.
+	MaxGraphFileSize int64 `env:"MAX_GRAPH_FILE_SIZE" envDefault:"104857600"`
+	// MaxIterations caps the iterations of layouts and of iterative trust metrics.
+	MaxIterations int `env:"MAX_ITERATIONS" envDefault:"10000"`
+	// MaxKamadaKawaiNodes caps the number of nodes of graphs that are laid out with Kamada–Kawai, which
+	// needs memory and time quadratic in the number of nodes.
+	MaxKamadaKawaiNodes int `env:"MAX_KAMADA_KAWAI_NODES" envDefault:"2000"`
+	// MaxWalkSteps caps the total number of steps of the walks of a request.
+	MaxWalkSteps int `env:"MAX_WALK_STEPS" envDefault:"10000000"`
+	// MaxPaths caps the number of shortest paths of a request.
+	MaxPaths int `env:"MAX_PATHS" envDefault:"100"`
+	// MaxComputeTime caps the time a request may spend generating, laying out, walking or measuring a
+	// graph, or computing trust, flows or paths in it. Zero disables the cap.
+	MaxComputeTime time.Duration `env:"MAX_COMPUTE_TIME" envDefault:"1m"`
+	// MaxExperimentRuns caps the number of runs of an experiment, over all configurations and seeds.
+	MaxExperimentRuns int `env:"MAX_EXPERIMENT_RUNS" envDefault:"10000"`
+	// ExperimentWorkers is the number of runs of an experiment that run in parallel, it defaults to the
+	// number of CPUs that Go may use.
+	ExperimentWorkers int `env:"EXPERIMENT_WORKERS"`
+}
+
+// Version is the build version of trustd, it is recorded in the manifests of generated graphs.
+type Version string
 
 // Params declares input components required for this package's components.
 type Params struct {
 	fx.In
+	Config  Config
+	Store   store.Store
+	Version Version
 }
 
 // Result describes what the components produce for the rest of the system.
 type Result struct {
 	fx.Out
 	http.Handler `name:"rpc"`
+	Graphs       *Graphs
 }
 
 // g implements the graph service.
-type g struct{}
+type g struct {
+	cfg     Config
+	graphs  *Graphs
+	version Version
+}
 
 // New inits the main http handler.
-func New(Params) (Result, error) {
+func New(params Params) (Result, error) {
+	graphs := NewGraphs(params.Config.GraphTTL, params.Store)
+
 	mux := http.NewServeMux()
-	path, handler := rpcv1connect.NewGraphServiceHandler(g{})
+	path, handler := rpcv1connect.NewGraphServiceHandler(g{
+		cfg:     params.Config,
+		graphs:  graphs,
+		version: params.Version,
+	})
 	mux.Handle(path, handler)
 
 	return Result{
 		Handler: mux,
+		Graphs:  graphs,
 	}, nil
 }
 
+// NewService inits the graph service on its own, so it can be called in-process without serving it over
+// HTTP, as the command line does.
+func NewService(cfg Config, st store.Store, version Version) rpcv1connect.GraphServiceHandler {
+	return g{cfg: cfg, graphs: NewGraphs(cfg.GraphTTL, st), version: version}
+}
+
 // Provide provides the package's components as an fx module.
 func Provide() fx.Option {
 	return stdfx.ZapEnvCfgModule[Config]("rpc", New)
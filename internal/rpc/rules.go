@@ -0,0 +1,190 @@
+package rpc
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// rule is a compiled protovalidate rule of a scalar value.
+type rule struct {
+	id, message string
+	ok          func(x protoreflect.Value) bool
+}
+
+// fieldRules holds the compiled rules of a field, the item rules apply to the elements of repeated fields.
+type fieldRules struct {
+	required bool
+	rules    []rule
+	items    []rule
+}
+
+// declaredRules holds the rules of every field of rpc.proto that declares any, by the full name of the
+// field. They are compiled once, so rules the server doesn't support fail at startup.
+var declaredRules = compileRules(rpcv1.File_internal_rpc_v1_rpc_proto)
+
+// compileRules compiles the (buf.validate.field) rules of the fields of the messages in the file.
+func compileRules(file protoreflect.FileDescriptor) map[protoreflect.FullName]fieldRules {
+	compiled := map[protoreflect.FullName]fieldRules{}
+	for i := range file.Messages().Len() {
+		fields := file.Messages().Get(i).Fields()
+		for j := range fields.Len() {
+			fd := fields.Get(j)
+			declared, ok := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldRules)
+			if !ok || declared == nil {
+				continue
+			}
+			onlySupported(fd, declared, "required", "int64", "double", "string", "repeated")
+			var rules fieldRules
+			rules.required = declared.GetRequired()
+			if declared.HasRepeated() {
+				onlySupported(fd, declared.GetRepeated(), "items")
+				rules.items = compileScalar(fd, declared.GetRepeated().GetItems())
+			}
+			rules.rules = compileScalar(fd, declared)
+			compiled[fd.FullName()] = rules
+		}
+	}
+	return compiled
+}
+
+// onlySupported panics if the rules set any field other than the supported ones.
+func onlySupported(fd protoreflect.FieldDescriptor, rules proto.Message, supported ...string) {
+	rules.ProtoReflect().Range(func(rd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		for _, name := range supported {
+			if string(rd.Name()) == name {
+				return true
+			}
+		}
+		panic(fmt.Sprintf("rpc: unsupported protovalidate rule %s on %s", rd.FullName(), fd.FullName()))
+	})
+}
+
+// compileScalar compiles the rules of a scalar value.
+func compileScalar(fd protoreflect.FieldDescriptor, declared *validate.FieldRules) []rule {
+	var rules []rule
+	switch {
+	case declared.HasInt64():
+		onlySupported(fd, declared.GetInt64(), "gt", "gte", "lt", "lte")
+		rules = append(rules, rangeRule("int64", "%d", declared.GetInt64(), protoreflect.Value.Int))
+	case declared.HasDouble():
+		r := declared.GetDouble()
+		onlySupported(fd, r, "gt", "gte", "lt", "lte", "finite")
+		if r.HasGreaterThan() || r.HasLessThan() {
+			rules = append(rules, rangeRule("double", "%g", r, protoreflect.Value.Float))
+		}
+		if r.GetFinite() {
+			rules = append(rules, rule{
+				id:      "double.finite",
+				message: "value must be finite",
+				ok: func(x protoreflect.Value) bool {
+					return !math.IsNaN(x.Float()) && !math.IsInf(x.Float(), 0)
+				},
+			})
+		}
+	case declared.HasString():
+		onlySupported(fd, declared.GetString(), "in")
+		in := declared.GetString().GetIn()
+		rules = append(rules, rule{
+			id:      "string.in",
+			message: fmt.Sprintf("value must be in list [%s]", strings.Join(in, ", ")),
+			ok: func(x protoreflect.Value) bool {
+				return slices.Contains(in, x.String())
+			},
+		})
+	}
+	return rules
+}
+
+// rangeRules are the rules of a number that bound it from below, above or both.
+type rangeRules[T int64 | float64] interface {
+	HasGt() bool
+	GetGt() T
+	HasGte() bool
+	GetGte() T
+	HasLt() bool
+	GetLt() T
+	HasLte() bool
+	GetLte() T
+}
+
+// rangeRule compiles a rule that the value lies within the bounds, with the id and message protovalidate
+// reports for it. NaN is outside of every range.
+func rangeRule[T int64 | float64](kind, verb string, r rangeRules[T], get func(protoreflect.Value) T) rule {
+	var ids, msgs []string
+	var args []any
+	switch {
+	case r.HasGt():
+		ids, msgs, args = append(ids, "gt"), append(msgs, "greater than "+verb), append(args, r.GetGt())
+	case r.HasGte():
+		ids, msgs, args = append(ids, "gte"), append(msgs, "greater than or equal to "+verb), append(args, r.GetGte())
+	}
+	switch {
+	case r.HasLt():
+		ids, msgs, args = append(ids, "lt"), append(msgs, "less than "+verb), append(args, r.GetLt())
+	case r.HasLte():
+		ids, msgs, args = append(ids, "lte"), append(msgs, "less than or equal to "+verb), append(args, r.GetLte())
+	}
+
+	return rule{
+		id:      kind + "." + strings.Join(ids, "_"),
+		message: fmt.Sprintf("value must be "+strings.Join(msgs, " and "), args...),
+		ok: func(x protoreflect.Value) bool {
+			v := get(x)
+			return (!r.HasGt() || v > r.GetGt()) && (!r.HasGte() || v >= r.GetGte()) &&
+				(!r.HasLt() || v < r.GetLt()) && (!r.HasLte() || v <= r.GetLte())
+		},
+	}
+}
+
+// rules checks the message, and the messages it holds, against the rules declared on their fields. Like
+// protovalidate, the rules of a field only apply when it is set.
+func (v *validator) rules(prefix string, msg protoreflect.Message) {
+	fields := msg.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		path := field(prefix, string(fd.Name()))
+		rules := declaredRules[fd.FullName()]
+		if !msg.Has(fd) {
+			if rules.required {
+				v.violate(path, "required", "value is required")
+			}
+			continue
+		}
+
+		value := msg.Get(fd)
+		switch {
+		case fd.IsList():
+			list := value.List()
+			for j := range list.Len() {
+				item := fmt.Sprintf("%s[%d]", path, j)
+				if fd.Message() != nil {
+					v.rules(item, list.Get(j).Message())
+					continue
+				}
+				v.scalar(item, rules.items, list.Get(j))
+			}
+		case fd.IsMap():
+			// the maps of the requests hold no messages, so they have no rules to check.
+		case fd.Message() != nil:
+			v.rules(path, value.Message())
+		default:
+			v.scalar(path, rules.rules, value)
+		}
+	}
+}
+
+// scalar checks the value against the rules.
+func (v *validator) scalar(path string, rules []rule, x protoreflect.Value) {
+	for _, r := range rules {
+		if !r.ok(x) {
+			v.violate(path, r.id, "%s", r.message)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+const (
+	// spectralIterations bounds the number of power iterations of the spectral layout.
+	spectralIterations = 2000
+	// spectralTolerance is the change in the eigenvectors below which the power iteration stops.
+	spectralTolerance = 1e-7
+)
+
+// Spectral positions the nodes by the eigenvectors of the graph Laplacian L = D - A with the two
+// smallest non-zero eigenvalues, which places nodes that are well connected close together. The
+// eigenvectors are found with a power iteration on cI - L, where c bounds the eigenvalues of L, after
+// removing the constant eigenvector with eigenvalue zero. In a disconnected graph every component has
+// its own zero eigenvalue, so components tend to collapse onto a single point.
+type Spectral struct {
+	Scale float64
+}
+
+// Apply implements Layout.
+func (sp Spectral) Apply(
+	ctx context.Context, r *rand.Rand, resp *rpcv1.RandomGraphResponse,
+) (*rpcv1.RandomGraphResponse, error) {
+	const defaultScale = 300.0
+
+	nodes := resp.GetNodes()
+	scale := orDefault(sp.Scale, defaultScale)
+
+	// with fewer than three nodes there are not enough eigenvectors to go around.
+	//nolint:varnamelen
+	n := len(nodes)
+	if n < 3 {
+		return Circular{Radius: scale}.Apply(ctx, r, resp)
+	}
+
+	adjacency := indexAdjacency(n, indexEdges(resp, indexNodes(nodes)))
+
+	var shift float64
+	for _, neighbors := range adjacency {
+		shift = max(shift, 2*float64(len(neighbors)))
+	}
+	shift++
+
+	// multiply computes (cI - L)x into dst.
+	multiply := func(dst, x []float64) {
+		for i, neighbors := range adjacency {
+			sum := (shift - float64(len(neighbors))) * x[i]
+			for _, j := range neighbors {
+				sum += x[j]
+			}
+			dst[i] = sum
+		}
+	}
+
+	vecs := [2][]float64{make([]float64, n), make([]float64, n)}
+	for _, vec := range vecs {
+		for i := range vec {
+			vec[i] = r.Float64() - 0.5
+		}
+	}
+	orthonormalize(vecs[:])
+
+	next := [2][]float64{make([]float64, n), make([]float64, n)}
+	for range spectralIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for i := range vecs {
+			multiply(next[i], vecs[i])
+		}
+		orthonormalize(next[:])
+
+		var change float64
+		for i := range vecs {
+			for j := range vecs[i] {
+				change = max(change, math.Abs(next[i][j]-vecs[i][j]))
+			}
+		}
+
+		vecs, next = next, vecs
+		if change < spectralTolerance {
+			break
+		}
+	}
+
+	var largest float64
+	for _, vec := range vecs {
+		for _, v := range vec {
+			largest = max(largest, math.Abs(v))
+		}
+	}
+
+	positions := make([][2]float64, n)
+	for i := range positions {
+		positions[i] = [2]float64{vecs[0][i] / largest * scale, vecs[1][i] / largest * scale}
+	}
+
+	setPositions(nodes, positions)
+	resp.ClearLayout()
+	return resp, nil
+}
+
+// orthonormalize makes the vectors orthogonal to each other and to the constant vector, and normalizes
+// them, using Gram–Schmidt.
+func orthonormalize(vecs [][]float64) {
+	for i, vec := range vecs {
+		var mean float64
+		for _, v := range vec {
+			mean += v
+		}
+		mean /= float64(len(vec))
+		for j := range vec {
+			vec[j] -= mean
+		}
+
+		for _, prev := range vecs[:i] {
+			var dot float64
+			for j := range vec {
+				dot += vec[j] * prev[j]
+			}
+			for j := range vec {
+				vec[j] -= dot * prev[j]
+			}
+		}
+
+		var norm float64
+		for _, v := range vec {
+			norm += v * v
+		}
+		norm = math.Sqrt(norm)
+		if norm == 0 {
+			continue
+		}
+		for j := range vec {
+			vec[j] /= norm
+		}
+	}
+}
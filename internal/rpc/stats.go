@@ -0,0 +1,223 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// ComputeGraphStats describes the structure of the graph. Directed graphs are measured as if they were
+// undirected, parallel edges count once and self-loops are ignored. Path lengths are measured within
+// the largest component. Statistics that are undefined for the graph, such as the assortativity of a
+// regular graph, are 0.
+//
+// The small-world coefficients compare the graph with a random graph and a ring lattice of the same
+// size and mean degree k, using their analytic clustering and path lengths: Cr = k/n, Lr = ln(n)/ln(k)
+// and Cl = 3(k-2)/(4(k-1)). Then sigma = (C/Cr)/(L/Lr), where sigma > 1 indicates a small world, and
+// omega = Lr/L - C/Cl, which is near 0 for a small world, near -1 for a lattice and near 1 for a random
+// graph.
+//
+// Measuring the path lengths takes a breadth-first search from every node, so the computation stops
+// with the error of the context once it is done.
+func ComputeGraphStats(ctx context.Context, resp *rpcv1.RandomGraphResponse) (*rpcv1.GraphStats, error) {
+	index := indexNodes(resp.GetNodes())
+	adjacency := simpleAdjacency(len(resp.GetNodes()), indexEdges(resp, index))
+
+	stats := &rpcv1.GraphStats{}
+	stats.SetNumNodes(int64(len(adjacency)))
+	if len(adjacency) == 0 {
+		return stats, nil
+	}
+
+	var numEdges, maxDegree int
+	minDegree := math.MaxInt
+	for _, neighbors := range adjacency {
+		numEdges += len(neighbors)
+		minDegree = min(minDegree, len(neighbors))
+		maxDegree = max(maxDegree, len(neighbors))
+	}
+	numEdges /= 2
+
+	histogram := make([]int64, maxDegree+1)
+	for _, neighbors := range adjacency {
+		histogram[len(neighbors)]++
+	}
+
+	meanDegree := 2 * float64(numEdges) / float64(len(adjacency))
+	stats.SetNumEdges(int64(numEdges))
+	stats.SetMinDegree(int64(minDegree))
+	stats.SetMaxDegree(int64(maxDegree))
+	stats.SetMeanDegree(meanDegree)
+	stats.SetDegreeHistogram(histogram)
+
+	clustering, err := averageClustering(ctx, adjacency)
+	if err != nil {
+		return nil, err
+	}
+	stats.SetAverageClustering(clustering)
+	stats.SetAssortativity(assortativity(adjacency))
+
+	components := connectedComponents(adjacency)
+	largest := slices.MaxFunc(components, func(a, b []int) int { return len(a) - len(b) })
+	stats.SetNumComponents(int64(len(components)))
+	stats.SetLargestComponentSize(int64(len(largest)))
+
+	pathLength, diameter, err := pathLengths(ctx, adjacency, largest)
+	if err != nil {
+		return nil, err
+	}
+	stats.SetAveragePathLength(pathLength)
+	stats.SetDiameter(int64(diameter))
+
+	n := float64(len(adjacency))
+	if meanDegree > 2 && meanDegree < n && clustering > 0 && pathLength > 0 {
+		randomClustering := meanDegree / n
+		randomPathLength := math.Log(n) / math.Log(meanDegree)
+		latticeClustering := 3 * (meanDegree - 2) / (4 * (meanDegree - 1))
+
+		stats.SetSigma((clustering / randomClustering) / (pathLength / randomPathLength))
+		stats.SetOmega(randomPathLength/pathLength - clustering/latticeClustering)
+	}
+	return stats, nil
+}
+
+// simpleAdjacency returns the sorted neighbors of every node in the simple undirected graph underlying
+// the edges.
+func simpleAdjacency(n int, edges [][2]int) [][]int {
+	adjacency := indexAdjacency(n, edges)
+	for i, neighbors := range adjacency {
+		neighbors = slices.DeleteFunc(neighbors, func(j int) bool { return j == i })
+		slices.Sort(neighbors)
+		adjacency[i] = slices.Compact(neighbors)
+	}
+	return adjacency
+}
+
+// averageClustering returns the mean over all nodes of the fraction of pairs of neighbors that are
+// neighbors themselves. Nodes with fewer than two neighbors count as 0.
+func averageClustering(ctx context.Context, adjacency [][]int) (float64, error) {
+	marked := make([]bool, len(adjacency))
+	var sum float64
+	for _, neighbors := range adjacency {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if len(neighbors) < 2 {
+			continue
+		}
+
+		for _, j := range neighbors {
+			marked[j] = true
+		}
+
+		// every link between two neighbors is seen from both ends.
+		var links int
+		for _, j := range neighbors {
+			for _, l := range adjacency[j] {
+				if marked[l] {
+					links++
+				}
+			}
+		}
+
+		for _, j := range neighbors {
+			marked[j] = false
+		}
+
+		d := float64(len(neighbors))
+		sum += float64(links) / (d * (d - 1))
+	}
+	return sum / float64(len(adjacency)), nil
+}
+
+// assortativity returns the Pearson correlation between the degrees at either end of the edges.
+func assortativity(adjacency [][]int) float64 {
+	var m, product, sum, squares float64
+	for i, neighbors := range adjacency {
+		for _, j := range neighbors {
+			if j < i {
+				continue
+			}
+
+			a, b := float64(len(neighbors)), float64(len(adjacency[j]))
+			m++
+			product += a * b
+			sum += (a + b) / 2
+			squares += (a*a + b*b) / 2
+		}
+	}
+	if m == 0 {
+		return 0
+	}
+
+	mean := sum / m
+	variance := squares/m - mean*mean
+	if variance < 1e-12 {
+		return 0
+	}
+	return (product/m - mean*mean) / variance
+}
+
+// connectedComponents returns the nodes of every component, in the order of their first node.
+func connectedComponents(adjacency [][]int) [][]int {
+	seen := make([]bool, len(adjacency))
+	var components [][]int
+	for start := range adjacency {
+		if seen[start] {
+			continue
+		}
+
+		seen[start] = true
+		component := []int{start}
+		for head := 0; head < len(component); head++ {
+			for _, j := range adjacency[component[head]] {
+				if !seen[j] {
+					seen[j] = true
+					component = append(component, j)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// pathLengths returns the mean hop count over all pairs of distinct nodes in the component, and the
+// longest of them, with a breadth-first search from every node.
+func pathLengths(ctx context.Context, adjacency [][]int, component []int) (mean float64, diameter int, err error) {
+	if len(component) < 2 {
+		return 0, 0, nil
+	}
+
+	dist := make([]int, len(adjacency))
+	queue := make([]int, 0, len(component))
+	var total int
+	for _, source := range component {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+
+		for _, v := range component {
+			dist[v] = -1
+		}
+		dist[source] = 0
+
+		queue = append(queue[:0], source)
+		for head := 0; head < len(queue); head++ {
+			v := queue[head]
+			total += dist[v]
+			diameter = max(diameter, dist[v])
+			for _, j := range adjacency[v] {
+				if dist[j] < 0 {
+					dist[j] = dist[v] + 1
+					queue = append(queue, j)
+				}
+			}
+		}
+	}
+
+	pairs := len(component) * (len(component) - 1)
+	return float64(total) / float64(pairs), diameter, nil
+}
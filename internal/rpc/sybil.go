@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// AttachSybilRegion adds a region of n Sybil nodes to the (honest) graph. Inside the region the nodes are
+// connected as a Watts–Strogatz graph with parameters k and beta, and the region is connected to the
+// honest nodes with exactly attackEdges edges (capped at the number of possible edges). Sybil nodes get
+// the "sybilNode" type, edges inside the region "sybilEdge" and the edges to the honest region
+// "attackEdge". The nodes and edges are numbered like "sybil-0" and "e-42", skipping ids the graph
+// already has. It returns the set of ids of the Sybil nodes, or the error of the context once it is done.
+//
+//nolint:varnamelen
+func AttachSybilRegion(
+	ctx context.Context,
+	r *rand.Rand,
+	resp *rpcv1.RandomGraphResponse,
+	n, k int,
+	beta float64,
+	attackEdges int,
+) (map[string]bool, error) {
+	honest := resp.GetNodes()
+	if n <= 0 {
+		return nil, nil
+	}
+
+	adjacency, err := wattsStrogatzAdjacency(ctx, r, n, k, beta)
+	if err != nil {
+		return nil, err
+	}
+
+	// the region is positioned as a smaller circle next to the honest ring.
+	sybil := make(map[string]bool, n)
+	nodes := make([]*rpcv1.Node, 0, len(honest)+n)
+	nodes = append(nodes, honest...)
+	nodeIDs := newIDSequence("sybil-%d", 0, honest)
+	sybilIDs := make([]string, n)
+	radius := 150.0
+	for i := range n {
+		angle := 2.0 * math.Pi * float64(i) / float64(n)
+		sybilIDs[i] = nodeIDs.id()
+
+		node := &rpcv1.Node{}
+		node.SetId(sybilIDs[i])
+		node.SetType("sybilNode")
+
+		pos := &rpcv1.Position{}
+		pos.SetX(int64(800 + radius*math.Cos(angle)))
+		pos.SetY(int64(radius * math.Sin(angle)))
+		node.SetPosition(pos)
+
+		nodes = append(nodes, node)
+		sybil[node.GetId()] = true
+	}
+
+	edges := resp.GetEdges()
+	edgeIDs := newIDSequence("e-%d", len(edges), edges)
+	addEdge := func(source, target, typ string) {
+		e := &rpcv1.Edge{}
+		e.SetId(edgeIDs.id())
+		e.SetSource(source)
+		e.SetTarget(target)
+		e.SetType(typ)
+		edges = append(edges, e)
+	}
+
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for j := i + 1; j < n; j++ {
+			if adjacency[i][j] {
+				addEdge(sybilIDs[i], sybilIDs[j], "sybilEdge")
+			}
+		}
+	}
+
+	// attack edges connect a random Sybil node to a random honest node, without duplicates.
+	attackEdges = min(attackEdges, n*len(honest))
+	attacked := make(map[[2]int]bool, attackEdges)
+	for len(attacked) < attackEdges {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		key := [2]int{r.IntN(n), r.IntN(len(honest))}
+		if attacked[key] {
+			continue
+		}
+		attacked[key] = true
+		addEdge(honest[key[1]].GetId(), sybilIDs[key[0]], "attackEdge")
+	}
+
+	resp.SetNodes(nodes)
+	resp.SetEdges(edges)
+	return sybil, nil
+}
+
+// sybilNodes returns the set of ids of the Sybil nodes in a graph that has not been walked yet.
+func sybilNodes(resp *rpcv1.RandomGraphResponse) map[string]bool {
+	sybil := map[string]bool{}
+	for _, node := range resp.GetNodes() {
+		if node.GetType() == "sybilNode" {
+			sybil[node.GetId()] = true
+		}
+	}
+	return sybil
+}
+
+// markEscapes records, for every walk, whether and at which step it first entered the Sybil region.
+func markEscapes(walks []*rpcv1.Walk, sybil map[string]bool) {
+	for _, walk := range walks {
+		for step, id := range walk.GetNodeIds() {
+			if sybil[id] {
+				walk.SetEscaped(true)
+				walk.SetEscapeStep(int64(step))
+				break
+			}
+		}
+	}
+}
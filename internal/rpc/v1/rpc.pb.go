@@ -1,12 +1,13 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.10
 // 	protoc        (unknown)
 // source: internal/rpc/v1/rpc.proto
 
 package rpcv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,6 +21,512 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Party identifies one of the two highlighted participants in the graph.
+type Party int32
+
+const (
+	Party_PARTY_UNSPECIFIED Party = 0
+	Party_PARTY_BOB         Party = 1
+	Party_PARTY_ADA         Party = 2
+)
+
+// Enum value maps for Party.
+var (
+	Party_name = map[int32]string{
+		0: "PARTY_UNSPECIFIED",
+		1: "PARTY_BOB",
+		2: "PARTY_ADA",
+	}
+	Party_value = map[string]int32{
+		"PARTY_UNSPECIFIED": 0,
+		"PARTY_BOB":         1,
+		"PARTY_ADA":         2,
+	}
+)
+
+func (x Party) Enum() *Party {
+	p := new(Party)
+	*p = x
+	return p
+}
+
+func (x Party) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Party) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[0].Descriptor()
+}
+
+func (Party) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[0]
+}
+
+func (x Party) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// WalkMode selects how walks move through the graph.
+type WalkMode int32
+
+const (
+	WalkMode_WALK_MODE_UNSPECIFIED WalkMode = 0
+	// WALK_MODE_RANDOM_WALK picks a uniformly random neighbor on every step.
+	WalkMode_WALK_MODE_RANDOM_WALK WalkMode = 1
+	// WALK_MODE_RANDOM_ROUTE follows SybilGuard/SybilLimit style random routes.
+	WalkMode_WALK_MODE_RANDOM_ROUTE WalkMode = 2
+	// WALK_MODE_WEIGHTED picks a neighbor with a probability proportional to the weight of the edge.
+	WalkMode_WALK_MODE_WEIGHTED WalkMode = 3
+)
+
+// Enum value maps for WalkMode.
+var (
+	WalkMode_name = map[int32]string{
+		0: "WALK_MODE_UNSPECIFIED",
+		1: "WALK_MODE_RANDOM_WALK",
+		2: "WALK_MODE_RANDOM_ROUTE",
+		3: "WALK_MODE_WEIGHTED",
+	}
+	WalkMode_value = map[string]int32{
+		"WALK_MODE_UNSPECIFIED":  0,
+		"WALK_MODE_RANDOM_WALK":  1,
+		"WALK_MODE_RANDOM_ROUTE": 2,
+		"WALK_MODE_WEIGHTED":     3,
+	}
+)
+
+func (x WalkMode) Enum() *WalkMode {
+	p := new(WalkMode)
+	*p = x
+	return p
+}
+
+func (x WalkMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalkMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[1].Descriptor()
+}
+
+func (WalkMode) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[1]
+}
+
+func (x WalkMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// WeightDistribution selects the distribution edge weights are drawn from.
+type WeightDistribution int32
+
+const (
+	// WEIGHT_DISTRIBUTION_UNSPECIFIED assigns no weights.
+	WeightDistribution_WEIGHT_DISTRIBUTION_UNSPECIFIED WeightDistribution = 0
+	// WEIGHT_DISTRIBUTION_UNIFORM draws weights uniformly from [min, max).
+	WeightDistribution_WEIGHT_DISTRIBUTION_UNIFORM WeightDistribution = 1
+	// WEIGHT_DISTRIBUTION_EXPONENTIAL draws weights from an exponential distribution with the given mean.
+	WeightDistribution_WEIGHT_DISTRIBUTION_EXPONENTIAL WeightDistribution = 2
+	// WEIGHT_DISTRIBUTION_LOG_NORMAL draws weights whose logarithm is normally distributed with mu and sigma.
+	WeightDistribution_WEIGHT_DISTRIBUTION_LOG_NORMAL WeightDistribution = 3
+	// WEIGHT_DISTRIBUTION_BETA draws weights in [0, 1] from a beta distribution with alpha and beta.
+	WeightDistribution_WEIGHT_DISTRIBUTION_BETA WeightDistribution = 4
+)
+
+// Enum value maps for WeightDistribution.
+var (
+	WeightDistribution_name = map[int32]string{
+		0: "WEIGHT_DISTRIBUTION_UNSPECIFIED",
+		1: "WEIGHT_DISTRIBUTION_UNIFORM",
+		2: "WEIGHT_DISTRIBUTION_EXPONENTIAL",
+		3: "WEIGHT_DISTRIBUTION_LOG_NORMAL",
+		4: "WEIGHT_DISTRIBUTION_BETA",
+	}
+	WeightDistribution_value = map[string]int32{
+		"WEIGHT_DISTRIBUTION_UNSPECIFIED": 0,
+		"WEIGHT_DISTRIBUTION_UNIFORM":     1,
+		"WEIGHT_DISTRIBUTION_EXPONENTIAL": 2,
+		"WEIGHT_DISTRIBUTION_LOG_NORMAL":  3,
+		"WEIGHT_DISTRIBUTION_BETA":        4,
+	}
+)
+
+func (x WeightDistribution) Enum() *WeightDistribution {
+	p := new(WeightDistribution)
+	*p = x
+	return p
+}
+
+func (x WeightDistribution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WeightDistribution) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[2].Descriptor()
+}
+
+func (WeightDistribution) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[2]
+}
+
+func (x WeightDistribution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// RepulsionAlgorithm selects how the repulsive forces of the force-directed layout are computed.
+type RepulsionAlgorithm int32
+
+const (
+	RepulsionAlgorithm_REPULSION_ALGORITHM_UNSPECIFIED RepulsionAlgorithm = 0
+	// REPULSION_ALGORITHM_EXACT computes the forces between every pair of nodes, it is the default.
+	RepulsionAlgorithm_REPULSION_ALGORITHM_EXACT RepulsionAlgorithm = 1
+	// REPULSION_ALGORITHM_BARNES_HUT approximates the forces with a quadtree, for large graphs.
+	RepulsionAlgorithm_REPULSION_ALGORITHM_BARNES_HUT RepulsionAlgorithm = 2
+)
+
+// Enum value maps for RepulsionAlgorithm.
+var (
+	RepulsionAlgorithm_name = map[int32]string{
+		0: "REPULSION_ALGORITHM_UNSPECIFIED",
+		1: "REPULSION_ALGORITHM_EXACT",
+		2: "REPULSION_ALGORITHM_BARNES_HUT",
+	}
+	RepulsionAlgorithm_value = map[string]int32{
+		"REPULSION_ALGORITHM_UNSPECIFIED": 0,
+		"REPULSION_ALGORITHM_EXACT":       1,
+		"REPULSION_ALGORITHM_BARNES_HUT":  2,
+	}
+)
+
+func (x RepulsionAlgorithm) Enum() *RepulsionAlgorithm {
+	p := new(RepulsionAlgorithm)
+	*p = x
+	return p
+}
+
+func (x RepulsionAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RepulsionAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[3].Descriptor()
+}
+
+func (RepulsionAlgorithm) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[3]
+}
+
+func (x RepulsionAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// CoolingSchedule selects how the temperature of the force-directed layout, the maximum distance a node
+// moves per iteration, decreases over the iterations.
+type CoolingSchedule int32
+
+const (
+	// COOLING_SCHEDULE_UNSPECIFIED keeps the maximum step fixed at 10 units, the layout doesn't cool down.
+	CoolingSchedule_COOLING_SCHEDULE_UNSPECIFIED CoolingSchedule = 0
+	// COOLING_SCHEDULE_LINEAR decreases the temperature linearly to zero at the last iteration.
+	CoolingSchedule_COOLING_SCHEDULE_LINEAR CoolingSchedule = 1
+	// COOLING_SCHEDULE_EXPONENTIAL multiplies the temperature by 0.95 every iteration.
+	CoolingSchedule_COOLING_SCHEDULE_EXPONENTIAL CoolingSchedule = 2
+	// COOLING_SCHEDULE_ADAPTIVE cools down while the energy doesn't decrease, and heats up again after
+	// several iterations in which it did (Hu, 2005).
+	CoolingSchedule_COOLING_SCHEDULE_ADAPTIVE CoolingSchedule = 3
+)
+
+// Enum value maps for CoolingSchedule.
+var (
+	CoolingSchedule_name = map[int32]string{
+		0: "COOLING_SCHEDULE_UNSPECIFIED",
+		1: "COOLING_SCHEDULE_LINEAR",
+		2: "COOLING_SCHEDULE_EXPONENTIAL",
+		3: "COOLING_SCHEDULE_ADAPTIVE",
+	}
+	CoolingSchedule_value = map[string]int32{
+		"COOLING_SCHEDULE_UNSPECIFIED": 0,
+		"COOLING_SCHEDULE_LINEAR":      1,
+		"COOLING_SCHEDULE_EXPONENTIAL": 2,
+		"COOLING_SCHEDULE_ADAPTIVE":    3,
+	}
+)
+
+func (x CoolingSchedule) Enum() *CoolingSchedule {
+	p := new(CoolingSchedule)
+	*p = x
+	return p
+}
+
+func (x CoolingSchedule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoolingSchedule) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[4].Descriptor()
+}
+
+func (CoolingSchedule) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[4]
+}
+
+func (x CoolingSchedule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// GraphFormat identifies a graph file format.
+type GraphFormat int32
+
+const (
+	GraphFormat_GRAPH_FORMAT_UNSPECIFIED GraphFormat = 0
+	GraphFormat_GRAPH_FORMAT_EDGE_LIST   GraphFormat = 1
+	GraphFormat_GRAPH_FORMAT_GRAPHML     GraphFormat = 2
+	GraphFormat_GRAPH_FORMAT_GEXF        GraphFormat = 3
+	// GRAPH_FORMAT_DOT is the Graphviz DOT language, it is only supported for exports.
+	GraphFormat_GRAPH_FORMAT_DOT GraphFormat = 4
+	// GRAPH_FORMAT_JSON is trustd's stable JSON schema, it is only supported for exports.
+	GraphFormat_GRAPH_FORMAT_JSON GraphFormat = 5
+)
+
+// Enum value maps for GraphFormat.
+var (
+	GraphFormat_name = map[int32]string{
+		0: "GRAPH_FORMAT_UNSPECIFIED",
+		1: "GRAPH_FORMAT_EDGE_LIST",
+		2: "GRAPH_FORMAT_GRAPHML",
+		3: "GRAPH_FORMAT_GEXF",
+		4: "GRAPH_FORMAT_DOT",
+		5: "GRAPH_FORMAT_JSON",
+	}
+	GraphFormat_value = map[string]int32{
+		"GRAPH_FORMAT_UNSPECIFIED": 0,
+		"GRAPH_FORMAT_EDGE_LIST":   1,
+		"GRAPH_FORMAT_GRAPHML":     2,
+		"GRAPH_FORMAT_GEXF":        3,
+		"GRAPH_FORMAT_DOT":         4,
+		"GRAPH_FORMAT_JSON":        5,
+	}
+)
+
+func (x GraphFormat) Enum() *GraphFormat {
+	p := new(GraphFormat)
+	*p = x
+	return p
+}
+
+func (x GraphFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GraphFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[5].Descriptor()
+}
+
+func (GraphFormat) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[5]
+}
+
+func (x GraphFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// PageRankMethod selects how personalized PageRank is computed.
+type PageRankMethod int32
+
+const (
+	// PAGE_RANK_METHOD_UNSPECIFIED uses power iteration.
+	PageRankMethod_PAGE_RANK_METHOD_UNSPECIFIED PageRankMethod = 0
+	// PAGE_RANK_METHOD_POWER_ITERATION iterates the PageRank equation until the scores converge.
+	PageRankMethod_PAGE_RANK_METHOD_POWER_ITERATION PageRankMethod = 1
+	// PAGE_RANK_METHOD_MONTE_CARLO estimates the scores by the end points of random walks from the source.
+	PageRankMethod_PAGE_RANK_METHOD_MONTE_CARLO PageRankMethod = 2
+)
+
+// Enum value maps for PageRankMethod.
+var (
+	PageRankMethod_name = map[int32]string{
+		0: "PAGE_RANK_METHOD_UNSPECIFIED",
+		1: "PAGE_RANK_METHOD_POWER_ITERATION",
+		2: "PAGE_RANK_METHOD_MONTE_CARLO",
+	}
+	PageRankMethod_value = map[string]int32{
+		"PAGE_RANK_METHOD_UNSPECIFIED":     0,
+		"PAGE_RANK_METHOD_POWER_ITERATION": 1,
+		"PAGE_RANK_METHOD_MONTE_CARLO":     2,
+	}
+)
+
+func (x PageRankMethod) Enum() *PageRankMethod {
+	p := new(PageRankMethod)
+	*p = x
+	return p
+}
+
+func (x PageRankMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PageRankMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[6].Descriptor()
+}
+
+func (PageRankMethod) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[6]
+}
+
+func (x PageRankMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// FlowCapacity selects how much flow every edge can carry.
+type FlowCapacity int32
+
+const (
+	// UNSPECIFIED defaults to unit capacities.
+	FlowCapacity_FLOW_CAPACITY_UNSPECIFIED FlowCapacity = 0
+	// UNIT gives every edge a capacity of 1, so the flow is the edge connectivity.
+	FlowCapacity_FLOW_CAPACITY_UNIT FlowCapacity = 1
+	// TRUST uses the edge weights as capacities, edges without a weight carry 1.
+	FlowCapacity_FLOW_CAPACITY_TRUST FlowCapacity = 2
+)
+
+// Enum value maps for FlowCapacity.
+var (
+	FlowCapacity_name = map[int32]string{
+		0: "FLOW_CAPACITY_UNSPECIFIED",
+		1: "FLOW_CAPACITY_UNIT",
+		2: "FLOW_CAPACITY_TRUST",
+	}
+	FlowCapacity_value = map[string]int32{
+		"FLOW_CAPACITY_UNSPECIFIED": 0,
+		"FLOW_CAPACITY_UNIT":        1,
+		"FLOW_CAPACITY_TRUST":       2,
+	}
+)
+
+func (x FlowCapacity) Enum() *FlowCapacity {
+	p := new(FlowCapacity)
+	*p = x
+	return p
+}
+
+func (x FlowCapacity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlowCapacity) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[7].Descriptor()
+}
+
+func (FlowCapacity) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[7]
+}
+
+func (x FlowCapacity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// PathCost selects what makes a path short.
+type PathCost int32
+
+const (
+	// UNSPECIFIED defaults to hop counts.
+	PathCost_PATH_COST_UNSPECIFIED PathCost = 0
+	// HOPS counts the edges along the path.
+	PathCost_PATH_COST_HOPS PathCost = 1
+	// TRUST sums -log(trust) over the edges, with the edge weight as trust, so the shortest path is the one
+	// whose trust product is highest. Edges without a weight have a trust of 1, trust above 1 is capped at
+	// 1 and edges with a trust of 0 or less are never followed.
+	PathCost_PATH_COST_TRUST PathCost = 2
+)
+
+// Enum value maps for PathCost.
+var (
+	PathCost_name = map[int32]string{
+		0: "PATH_COST_UNSPECIFIED",
+		1: "PATH_COST_HOPS",
+		2: "PATH_COST_TRUST",
+	}
+	PathCost_value = map[string]int32{
+		"PATH_COST_UNSPECIFIED": 0,
+		"PATH_COST_HOPS":        1,
+		"PATH_COST_TRUST":       2,
+	}
+)
+
+func (x PathCost) Enum() *PathCost {
+	p := new(PathCost)
+	*p = x
+	return p
+}
+
+func (x PathCost) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PathCost) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[8].Descriptor()
+}
+
+func (PathCost) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[8]
+}
+
+func (x PathCost) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// RecordKind tells what a record of the graph store holds.
+type RecordKind int32
+
+const (
+	RecordKind_RECORD_KIND_UNSPECIFIED RecordKind = 0
+	// RECORD_KIND_GENERATED is a graph that was created from a RandomGraphRequest.
+	RecordKind_RECORD_KIND_GENERATED RecordKind = 1
+	// RECORD_KIND_IMPORTED is a graph that was loaded from a file.
+	RecordKind_RECORD_KIND_IMPORTED RecordKind = 2
+	// RECORD_KIND_EXPERIMENT holds the results of an experiment, instead of a graph.
+	RecordKind_RECORD_KIND_EXPERIMENT RecordKind = 3
+)
+
+// Enum value maps for RecordKind.
+var (
+	RecordKind_name = map[int32]string{
+		0: "RECORD_KIND_UNSPECIFIED",
+		1: "RECORD_KIND_GENERATED",
+		2: "RECORD_KIND_IMPORTED",
+		3: "RECORD_KIND_EXPERIMENT",
+	}
+	RecordKind_value = map[string]int32{
+		"RECORD_KIND_UNSPECIFIED": 0,
+		"RECORD_KIND_GENERATED":   1,
+		"RECORD_KIND_IMPORTED":    2,
+		"RECORD_KIND_EXPERIMENT":  3,
+	}
+)
+
+func (x RecordKind) Enum() *RecordKind {
+	p := new(RecordKind)
+	*p = x
+	return p
+}
+
+func (x RecordKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecordKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_rpc_v1_rpc_proto_enumTypes[9].Descriptor()
+}
+
+func (RecordKind) Type() protoreflect.EnumType {
+	return &file_internal_rpc_v1_rpc_proto_enumTypes[9]
+}
+
+func (x RecordKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type Position struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_X           int64                  `protobuf:"varint,1,opt,name=x"`
@@ -370,6 +877,7 @@ type Edge struct {
 	xxx_hidden_Source      *string                `protobuf:"bytes,2,opt,name=source"`
 	xxx_hidden_Target      *string                `protobuf:"bytes,3,opt,name=target"`
 	xxx_hidden_Type        *string                `protobuf:"bytes,4,opt,name=type"`
+	xxx_hidden_Weight      float64                `protobuf:"fixed64,5,opt,name=weight"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
@@ -441,24 +949,36 @@ func (x *Edge) GetType() string {
 	return ""
 }
 
+func (x *Edge) GetWeight() float64 {
+	if x != nil {
+		return x.xxx_hidden_Weight
+	}
+	return 0
+}
+
 func (x *Edge) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
 }
 
 func (x *Edge) SetSource(v string) {
 	x.xxx_hidden_Source = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
 }
 
 func (x *Edge) SetTarget(v string) {
 	x.xxx_hidden_Target = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
 }
 
 func (x *Edge) SetType(v string) {
 	x.xxx_hidden_Type = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *Edge) SetWeight(v float64) {
+	x.xxx_hidden_Weight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
 }
 
 func (x *Edge) HasId() bool {
@@ -489,6 +1009,13 @@ func (x *Edge) HasType() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
+func (x *Edge) HasWeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
 func (x *Edge) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
@@ -509,6 +1036,11 @@ func (x *Edge) ClearType() {
 	x.xxx_hidden_Type = nil
 }
 
+func (x *Edge) ClearWeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Weight = 0
+}
+
 type Edge_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -516,6 +1048,8 @@ type Edge_builder struct {
 	Source *string
 	Target *string
 	Type   *string
+	// weight is the trust score of the edge, weighted walks treat edges without a weight as weight 1.
+	Weight *float64
 }
 
 func (b0 Edge_builder) Build() *Edge {
@@ -523,57 +1057,58 @@ func (b0 Edge_builder) Build() *Edge {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
 		x.xxx_hidden_Id = b.Id
 	}
 	if b.Source != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
 		x.xxx_hidden_Source = b.Source
 	}
 	if b.Target != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
 		x.xxx_hidden_Target = b.Target
 	}
 	if b.Type != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
 		x.xxx_hidden_Type = b.Type
 	}
+	if b.Weight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Weight = *b.Weight
+	}
 	return m0
 }
 
-type RandomGraphRequest struct {
-	state                          protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Seed1               uint64                 `protobuf:"varint,1,opt,name=seed1"`
-	xxx_hidden_Seed2               uint64                 `protobuf:"varint,2,opt,name=seed2"`
-	xxx_hidden_NumNodes            int64                  `protobuf:"varint,3,opt,name=num_nodes,json=numNodes"`
-	xxx_hidden_InitialConnected    int64                  `protobuf:"varint,4,opt,name=initial_connected,json=initialConnected"`
-	xxx_hidden_RewiringProbability float64                `protobuf:"fixed64,5,opt,name=rewiring_probability,json=rewiringProbability"`
-	xxx_hidden_LayoutIterations    int64                  `protobuf:"varint,6,opt,name=layout_iterations,json=layoutIterations"`
-	xxx_hidden_LayoutArea          float64                `protobuf:"fixed64,7,opt,name=layout_area,json=layoutArea"`
-	xxx_hidden_WalkLength          int64                  `protobuf:"varint,8,opt,name=walk_length,json=walkLength"`
-	xxx_hidden_NumWalks            int64                  `protobuf:"varint,9,opt,name=num_walks,json=numWalks"`
-	xxx_hidden_Seed3               uint64                 `protobuf:"varint,10,opt,name=seed3"`
-	xxx_hidden_Seed4               uint64                 `protobuf:"varint,11,opt,name=seed4"`
-	XXX_raceDetectHookData         protoimpl.RaceDetectHookData
-	XXX_presence                   [1]uint32
-	unknownFields                  protoimpl.UnknownFields
-	sizeCache                      protoimpl.SizeCache
+// Walk describes a single random walk over the graph.
+type Walk struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Owner       Party                  `protobuf:"varint,1,opt,name=owner,enum=internal.rpc.v1.Party"`
+	xxx_hidden_StartNode   *string                `protobuf:"bytes,2,opt,name=start_node,json=startNode"`
+	xxx_hidden_NodeIds     []string               `protobuf:"bytes,3,rep,name=node_ids,json=nodeIds"`
+	xxx_hidden_EdgeIds     []string               `protobuf:"bytes,4,rep,name=edge_ids,json=edgeIds"`
+	xxx_hidden_Instance    int64                  `protobuf:"varint,5,opt,name=instance"`
+	xxx_hidden_Escaped     bool                   `protobuf:"varint,6,opt,name=escaped"`
+	xxx_hidden_EscapeStep  int64                  `protobuf:"varint,7,opt,name=escape_step,json=escapeStep"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *RandomGraphRequest) Reset() {
-	*x = RandomGraphRequest{}
+func (x *Walk) Reset() {
+	*x = Walk{}
 	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RandomGraphRequest) String() string {
+func (x *Walk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandomGraphRequest) ProtoMessage() {}
+func (*Walk) ProtoMessage() {}
 
-func (x *RandomGraphRequest) ProtoReflect() protoreflect.Message {
+func (x *Walk) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,360 +1120,10439 @@ func (x *RandomGraphRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *RandomGraphRequest) GetSeed1() uint64 {
+func (x *Walk) GetOwner() Party {
 	if x != nil {
-		return x.xxx_hidden_Seed1
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Owner
+		}
 	}
-	return 0
+	return Party_PARTY_UNSPECIFIED
 }
 
-func (x *RandomGraphRequest) GetSeed2() uint64 {
+func (x *Walk) GetStartNode() string {
 	if x != nil {
-		return x.xxx_hidden_Seed2
+		if x.xxx_hidden_StartNode != nil {
+			return *x.xxx_hidden_StartNode
+		}
+		return ""
 	}
-	return 0
+	return ""
 }
 
-func (x *RandomGraphRequest) GetNumNodes() int64 {
+func (x *Walk) GetNodeIds() []string {
 	if x != nil {
-		return x.xxx_hidden_NumNodes
+		return x.xxx_hidden_NodeIds
 	}
-	return 0
+	return nil
 }
 
-func (x *RandomGraphRequest) GetInitialConnected() int64 {
+func (x *Walk) GetEdgeIds() []string {
 	if x != nil {
-		return x.xxx_hidden_InitialConnected
+		return x.xxx_hidden_EdgeIds
 	}
-	return 0
+	return nil
 }
 
-func (x *RandomGraphRequest) GetRewiringProbability() float64 {
+func (x *Walk) GetInstance() int64 {
 	if x != nil {
-		return x.xxx_hidden_RewiringProbability
+		return x.xxx_hidden_Instance
 	}
 	return 0
 }
 
-func (x *RandomGraphRequest) GetLayoutIterations() int64 {
+func (x *Walk) GetEscaped() bool {
 	if x != nil {
-		return x.xxx_hidden_LayoutIterations
+		return x.xxx_hidden_Escaped
 	}
-	return 0
+	return false
 }
 
-func (x *RandomGraphRequest) GetLayoutArea() float64 {
+func (x *Walk) GetEscapeStep() int64 {
 	if x != nil {
-		return x.xxx_hidden_LayoutArea
+		return x.xxx_hidden_EscapeStep
 	}
 	return 0
 }
 
-func (x *RandomGraphRequest) GetWalkLength() int64 {
-	if x != nil {
-		return x.xxx_hidden_WalkLength
-	}
-	return 0
+func (x *Walk) SetOwner(v Party) {
+	x.xxx_hidden_Owner = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
 }
 
-func (x *RandomGraphRequest) GetNumWalks() int64 {
-	if x != nil {
-		return x.xxx_hidden_NumWalks
-	}
-	return 0
+func (x *Walk) SetStartNode(v string) {
+	x.xxx_hidden_StartNode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
 }
 
-func (x *RandomGraphRequest) GetSeed3() uint64 {
+func (x *Walk) SetNodeIds(v []string) {
+	x.xxx_hidden_NodeIds = v
+}
+
+func (x *Walk) SetEdgeIds(v []string) {
+	x.xxx_hidden_EdgeIds = v
+}
+
+func (x *Walk) SetInstance(v int64) {
+	x.xxx_hidden_Instance = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *Walk) SetEscaped(v bool) {
+	x.xxx_hidden_Escaped = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *Walk) SetEscapeStep(v int64) {
+	x.xxx_hidden_EscapeStep = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *Walk) HasOwner() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Walk) HasStartNode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Walk) HasInstance() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Walk) HasEscaped() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Walk) HasEscapeStep() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Walk) ClearOwner() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Owner = Party_PARTY_UNSPECIFIED
+}
+
+func (x *Walk) ClearStartNode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_StartNode = nil
+}
+
+func (x *Walk) ClearInstance() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Instance = 0
+}
+
+func (x *Walk) ClearEscaped() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Escaped = false
+}
+
+func (x *Walk) ClearEscapeStep() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_EscapeStep = 0
+}
+
+type Walk_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// owner is the party that performed the walk.
+	Owner *Party
+	// start_node is the id of the node the walk started from.
+	StartNode *string
+	// node_ids holds the visited nodes in order, starting with the start node.
+	NodeIds []string
+	// edge_ids holds the traversed edges in order, edge i connects node i and i+1. It is empty when a lazy
+	// walk stayed at node i.
+	EdgeIds []string
+	// instance is the index of the walk among the walks of its owner. Random routes of the same
+	// instance share their routing tables.
+	Instance *int64
+	// escaped is set when the walk entered the Sybil region.
+	Escaped *bool
+	// escape_step is the step at which the walk first entered the Sybil region.
+	EscapeStep *int64
+}
+
+func (b0 Walk_builder) Build() *Walk {
+	m0 := &Walk{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Owner != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_Owner = *b.Owner
+	}
+	if b.StartNode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_StartNode = b.StartNode
+	}
+	x.xxx_hidden_NodeIds = b.NodeIds
+	x.xxx_hidden_EdgeIds = b.EdgeIds
+	if b.Instance != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_Instance = *b.Instance
+	}
+	if b.Escaped != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_Escaped = *b.Escaped
+	}
+	if b.EscapeStep != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_EscapeStep = *b.EscapeStep
+	}
+	return m0
+}
+
+// WalkIntersection describes where a walk of Bob first meets a walk of Ada.
+type WalkIntersection struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BobWalk     int64                  `protobuf:"varint,1,opt,name=bob_walk,json=bobWalk"`
+	xxx_hidden_AdaWalk     int64                  `protobuf:"varint,2,opt,name=ada_walk,json=adaWalk"`
+	xxx_hidden_NodeId      *string                `protobuf:"bytes,3,opt,name=node_id,json=nodeId"`
+	xxx_hidden_EdgeId      *string                `protobuf:"bytes,4,opt,name=edge_id,json=edgeId"`
+	xxx_hidden_BobStep     int64                  `protobuf:"varint,5,opt,name=bob_step,json=bobStep"`
+	xxx_hidden_AdaStep     int64                  `protobuf:"varint,6,opt,name=ada_step,json=adaStep"`
+	xxx_hidden_PathNodeIds []string               `protobuf:"bytes,7,rep,name=path_node_ids,json=pathNodeIds"`
+	xxx_hidden_PathEdgeIds []string               `protobuf:"bytes,8,rep,name=path_edge_ids,json=pathEdgeIds"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *WalkIntersection) Reset() {
+	*x = WalkIntersection{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalkIntersection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkIntersection) ProtoMessage() {}
+
+func (x *WalkIntersection) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[5]
 	if x != nil {
-		return x.xxx_hidden_Seed3
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WalkIntersection) GetBobWalk() int64 {
+	if x != nil {
+		return x.xxx_hidden_BobWalk
 	}
 	return 0
 }
 
-func (x *RandomGraphRequest) GetSeed4() uint64 {
+func (x *WalkIntersection) GetAdaWalk() int64 {
 	if x != nil {
-		return x.xxx_hidden_Seed4
+		return x.xxx_hidden_AdaWalk
+	}
+	return 0
+}
+
+func (x *WalkIntersection) GetNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_NodeId != nil {
+			return *x.xxx_hidden_NodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *WalkIntersection) GetEdgeId() string {
+	if x != nil {
+		if x.xxx_hidden_EdgeId != nil {
+			return *x.xxx_hidden_EdgeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *WalkIntersection) GetBobStep() int64 {
+	if x != nil {
+		return x.xxx_hidden_BobStep
+	}
+	return 0
+}
+
+func (x *WalkIntersection) GetAdaStep() int64 {
+	if x != nil {
+		return x.xxx_hidden_AdaStep
+	}
+	return 0
+}
+
+func (x *WalkIntersection) GetPathNodeIds() []string {
+	if x != nil {
+		return x.xxx_hidden_PathNodeIds
+	}
+	return nil
+}
+
+func (x *WalkIntersection) GetPathEdgeIds() []string {
+	if x != nil {
+		return x.xxx_hidden_PathEdgeIds
+	}
+	return nil
+}
+
+func (x *WalkIntersection) SetBobWalk(v int64) {
+	x.xxx_hidden_BobWalk = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *WalkIntersection) SetAdaWalk(v int64) {
+	x.xxx_hidden_AdaWalk = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *WalkIntersection) SetNodeId(v string) {
+	x.xxx_hidden_NodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *WalkIntersection) SetEdgeId(v string) {
+	x.xxx_hidden_EdgeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *WalkIntersection) SetBobStep(v int64) {
+	x.xxx_hidden_BobStep = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *WalkIntersection) SetAdaStep(v int64) {
+	x.xxx_hidden_AdaStep = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *WalkIntersection) SetPathNodeIds(v []string) {
+	x.xxx_hidden_PathNodeIds = v
+}
+
+func (x *WalkIntersection) SetPathEdgeIds(v []string) {
+	x.xxx_hidden_PathEdgeIds = v
+}
+
+func (x *WalkIntersection) HasBobWalk() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *WalkIntersection) HasAdaWalk() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *WalkIntersection) HasNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *WalkIntersection) HasEdgeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *WalkIntersection) HasBobStep() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *WalkIntersection) HasAdaStep() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *WalkIntersection) ClearBobWalk() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_BobWalk = 0
+}
+
+func (x *WalkIntersection) ClearAdaWalk() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_AdaWalk = 0
+}
+
+func (x *WalkIntersection) ClearNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_NodeId = nil
+}
+
+func (x *WalkIntersection) ClearEdgeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_EdgeId = nil
+}
+
+func (x *WalkIntersection) ClearBobStep() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_BobStep = 0
+}
+
+func (x *WalkIntersection) ClearAdaStep() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_AdaStep = 0
+}
+
+type WalkIntersection_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// bob_walk is the index of Bob's walk in the response's walks.
+	BobWalk *int64
+	// ada_walk is the index of Ada's walk in the response's walks.
+	AdaWalk *int64
+	// node_id is the node where the walks meet.
+	NodeId *string
+	// edge_id is set when both walks entered the meeting node over the same edge.
+	EdgeId *string
+	// bob_step is the step at which Bob's walk reached the meeting node.
+	BobStep *int64
+	// ada_step is the step at which Ada's walk reached the meeting node.
+	AdaStep *int64
+	// path_node_ids is the combined trust path from Bob, through the meeting node, to Ada.
+	PathNodeIds []string
+	// path_edge_ids holds the edges of the combined trust path in order.
+	PathEdgeIds []string
+}
+
+func (b0 WalkIntersection_builder) Build() *WalkIntersection {
+	m0 := &WalkIntersection{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.BobWalk != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_BobWalk = *b.BobWalk
+	}
+	if b.AdaWalk != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_AdaWalk = *b.AdaWalk
+	}
+	if b.NodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_NodeId = b.NodeId
+	}
+	if b.EdgeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_EdgeId = b.EdgeId
+	}
+	if b.BobStep != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_BobStep = *b.BobStep
+	}
+	if b.AdaStep != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_AdaStep = *b.AdaStep
+	}
+	x.xxx_hidden_PathNodeIds = b.PathNodeIds
+	x.xxx_hidden_PathEdgeIds = b.PathEdgeIds
+	return m0
+}
+
+// WattsStrogatzParams configures a Watts–Strogatz small-world graph.
+type WattsStrogatzParams struct {
+	state                          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NumNodes            int64                  `protobuf:"varint,1,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_InitialConnected    int64                  `protobuf:"varint,2,opt,name=initial_connected,json=initialConnected"`
+	xxx_hidden_RewiringProbability float64                `protobuf:"fixed64,3,opt,name=rewiring_probability,json=rewiringProbability"`
+	XXX_raceDetectHookData         protoimpl.RaceDetectHookData
+	XXX_presence                   [1]uint32
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *WattsStrogatzParams) Reset() {
+	*x = WattsStrogatzParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WattsStrogatzParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WattsStrogatzParams) ProtoMessage() {}
+
+func (x *WattsStrogatzParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WattsStrogatzParams) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *WattsStrogatzParams) GetInitialConnected() int64 {
+	if x != nil {
+		return x.xxx_hidden_InitialConnected
+	}
+	return 0
+}
+
+func (x *WattsStrogatzParams) GetRewiringProbability() float64 {
+	if x != nil {
+		return x.xxx_hidden_RewiringProbability
+	}
+	return 0
+}
+
+func (x *WattsStrogatzParams) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *WattsStrogatzParams) SetInitialConnected(v int64) {
+	x.xxx_hidden_InitialConnected = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *WattsStrogatzParams) SetRewiringProbability(v float64) {
+	x.xxx_hidden_RewiringProbability = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *WattsStrogatzParams) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *WattsStrogatzParams) HasInitialConnected() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *WattsStrogatzParams) HasRewiringProbability() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *WattsStrogatzParams) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *WattsStrogatzParams) ClearInitialConnected() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_InitialConnected = 0
+}
+
+func (x *WattsStrogatzParams) ClearRewiringProbability() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_RewiringProbability = 0
+}
+
+type WattsStrogatzParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NumNodes *int64
+	// initial_connected is the number of ring neighbors each node starts out with.
+	InitialConnected    *int64
+	RewiringProbability *float64
+}
+
+func (b0 WattsStrogatzParams_builder) Build() *WattsStrogatzParams {
+	m0 := &WattsStrogatzParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.InitialConnected != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_InitialConnected = *b.InitialConnected
+	}
+	if b.RewiringProbability != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_RewiringProbability = *b.RewiringProbability
+	}
+	return m0
+}
+
+// ErdosRenyiParams configures a G(n, p) Erdős–Rényi graph.
+type ErdosRenyiParams struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NumNodes        int64                  `protobuf:"varint,1,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_EdgeProbability float64                `protobuf:"fixed64,2,opt,name=edge_probability,json=edgeProbability"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ErdosRenyiParams) Reset() {
+	*x = ErdosRenyiParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErdosRenyiParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErdosRenyiParams) ProtoMessage() {}
+
+func (x *ErdosRenyiParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ErdosRenyiParams) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *ErdosRenyiParams) GetEdgeProbability() float64 {
+	if x != nil {
+		return x.xxx_hidden_EdgeProbability
+	}
+	return 0
+}
+
+func (x *ErdosRenyiParams) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ErdosRenyiParams) SetEdgeProbability(v float64) {
+	x.xxx_hidden_EdgeProbability = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ErdosRenyiParams) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ErdosRenyiParams) HasEdgeProbability() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ErdosRenyiParams) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *ErdosRenyiParams) ClearEdgeProbability() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EdgeProbability = 0
+}
+
+type ErdosRenyiParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NumNodes        *int64
+	EdgeProbability *float64
+}
+
+func (b0 ErdosRenyiParams_builder) Build() *ErdosRenyiParams {
+	m0 := &ErdosRenyiParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.EdgeProbability != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EdgeProbability = *b.EdgeProbability
+	}
+	return m0
+}
+
+// BarabasiAlbertParams configures a Barabási–Albert preferential attachment graph.
+type BarabasiAlbertParams struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NumNodes     int64                  `protobuf:"varint,1,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_EdgesPerNode int64                  `protobuf:"varint,2,opt,name=edges_per_node,json=edgesPerNode"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *BarabasiAlbertParams) Reset() {
+	*x = BarabasiAlbertParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarabasiAlbertParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarabasiAlbertParams) ProtoMessage() {}
+
+func (x *BarabasiAlbertParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BarabasiAlbertParams) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *BarabasiAlbertParams) GetEdgesPerNode() int64 {
+	if x != nil {
+		return x.xxx_hidden_EdgesPerNode
+	}
+	return 0
+}
+
+func (x *BarabasiAlbertParams) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *BarabasiAlbertParams) SetEdgesPerNode(v int64) {
+	x.xxx_hidden_EdgesPerNode = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *BarabasiAlbertParams) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *BarabasiAlbertParams) HasEdgesPerNode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *BarabasiAlbertParams) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *BarabasiAlbertParams) ClearEdgesPerNode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EdgesPerNode = 0
+}
+
+type BarabasiAlbertParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NumNodes *int64
+	// edges_per_node is the number of edges each new node attaches with.
+	EdgesPerNode *int64
+}
+
+func (b0 BarabasiAlbertParams_builder) Build() *BarabasiAlbertParams {
+	m0 := &BarabasiAlbertParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.EdgesPerNode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EdgesPerNode = *b.EdgesPerNode
+	}
+	return m0
+}
+
+// StochasticBlockParams configures a stochastic block model with uniform in- and out-block probabilities.
+type StochasticBlockParams struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BlockSizes  []int64                `protobuf:"varint,1,rep,packed,name=block_sizes,json=blockSizes"`
+	xxx_hidden_PIn         float64                `protobuf:"fixed64,2,opt,name=p_in,json=pIn"`
+	xxx_hidden_POut        float64                `protobuf:"fixed64,3,opt,name=p_out,json=pOut"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *StochasticBlockParams) Reset() {
+	*x = StochasticBlockParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StochasticBlockParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StochasticBlockParams) ProtoMessage() {}
+
+func (x *StochasticBlockParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StochasticBlockParams) GetBlockSizes() []int64 {
+	if x != nil {
+		return x.xxx_hidden_BlockSizes
+	}
+	return nil
+}
+
+func (x *StochasticBlockParams) GetPIn() float64 {
+	if x != nil {
+		return x.xxx_hidden_PIn
+	}
+	return 0
+}
+
+func (x *StochasticBlockParams) GetPOut() float64 {
+	if x != nil {
+		return x.xxx_hidden_POut
+	}
+	return 0
+}
+
+func (x *StochasticBlockParams) SetBlockSizes(v []int64) {
+	x.xxx_hidden_BlockSizes = v
+}
+
+func (x *StochasticBlockParams) SetPIn(v float64) {
+	x.xxx_hidden_PIn = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *StochasticBlockParams) SetPOut(v float64) {
+	x.xxx_hidden_POut = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *StochasticBlockParams) HasPIn() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *StochasticBlockParams) HasPOut() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *StochasticBlockParams) ClearPIn() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PIn = 0
+}
+
+func (x *StochasticBlockParams) ClearPOut() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_POut = 0
+}
+
+type StochasticBlockParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BlockSizes []int64
+	// p_in is the edge probability between nodes of the same block.
+	PIn *float64
+	// p_out is the edge probability between nodes of different blocks.
+	POut *float64
+}
+
+func (b0 StochasticBlockParams_builder) Build() *StochasticBlockParams {
+	m0 := &StochasticBlockParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_BlockSizes = b.BlockSizes
+	if b.PIn != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_PIn = *b.PIn
+	}
+	if b.POut != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_POut = *b.POut
+	}
+	return m0
+}
+
+// RandomRegularParams configures a random graph where every node has the same degree.
+type RandomRegularParams struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NumNodes    int64                  `protobuf:"varint,1,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_Degree      int64                  `protobuf:"varint,2,opt,name=degree"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RandomRegularParams) Reset() {
+	*x = RandomRegularParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomRegularParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomRegularParams) ProtoMessage() {}
+
+func (x *RandomRegularParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RandomRegularParams) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *RandomRegularParams) GetDegree() int64 {
+	if x != nil {
+		return x.xxx_hidden_Degree
+	}
+	return 0
+}
+
+func (x *RandomRegularParams) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *RandomRegularParams) SetDegree(v int64) {
+	x.xxx_hidden_Degree = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *RandomRegularParams) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RandomRegularParams) HasDegree() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RandomRegularParams) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *RandomRegularParams) ClearDegree() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Degree = 0
+}
+
+type RandomRegularParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NumNodes *int64
+	Degree   *int64
+}
+
+func (b0 RandomRegularParams_builder) Build() *RandomRegularParams {
+	m0 := &RandomRegularParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.Degree != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Degree = *b.Degree
+	}
+	return m0
+}
+
+// LatticeParams configures a 2D grid graph.
+type LatticeParams struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Rows        int64                  `protobuf:"varint,1,opt,name=rows"`
+	xxx_hidden_Columns     int64                  `protobuf:"varint,2,opt,name=columns"`
+	xxx_hidden_Periodic    bool                   `protobuf:"varint,3,opt,name=periodic"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LatticeParams) Reset() {
+	*x = LatticeParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatticeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatticeParams) ProtoMessage() {}
+
+func (x *LatticeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *LatticeParams) GetRows() int64 {
+	if x != nil {
+		return x.xxx_hidden_Rows
+	}
+	return 0
+}
+
+func (x *LatticeParams) GetColumns() int64 {
+	if x != nil {
+		return x.xxx_hidden_Columns
+	}
+	return 0
+}
+
+func (x *LatticeParams) GetPeriodic() bool {
+	if x != nil {
+		return x.xxx_hidden_Periodic
+	}
+	return false
+}
+
+func (x *LatticeParams) SetRows(v int64) {
+	x.xxx_hidden_Rows = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *LatticeParams) SetColumns(v int64) {
+	x.xxx_hidden_Columns = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *LatticeParams) SetPeriodic(v bool) {
+	x.xxx_hidden_Periodic = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *LatticeParams) HasRows() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *LatticeParams) HasColumns() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *LatticeParams) HasPeriodic() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *LatticeParams) ClearRows() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Rows = 0
+}
+
+func (x *LatticeParams) ClearColumns() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Columns = 0
+}
+
+func (x *LatticeParams) ClearPeriodic() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Periodic = false
+}
+
+type LatticeParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Rows    *int64
+	Columns *int64
+	// periodic wraps the grid around into a torus.
+	Periodic *bool
+}
+
+func (b0 LatticeParams_builder) Build() *LatticeParams {
+	m0 := &LatticeParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Rows != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Rows = *b.Rows
+	}
+	if b.Columns != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Columns = *b.Columns
+	}
+	if b.Periodic != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Periodic = *b.Periodic
+	}
+	return m0
+}
+
+// EdgeWeights configures the weights that are assigned to the edges of the graph that don't have one.
+type EdgeWeights struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Distribution WeightDistribution     `protobuf:"varint,1,opt,name=distribution,enum=internal.rpc.v1.WeightDistribution"`
+	xxx_hidden_Min          float64                `protobuf:"fixed64,2,opt,name=min"`
+	xxx_hidden_Max          float64                `protobuf:"fixed64,3,opt,name=max"`
+	xxx_hidden_Mean         float64                `protobuf:"fixed64,4,opt,name=mean"`
+	xxx_hidden_Mu           float64                `protobuf:"fixed64,5,opt,name=mu"`
+	xxx_hidden_Sigma        float64                `protobuf:"fixed64,6,opt,name=sigma"`
+	xxx_hidden_Alpha        float64                `protobuf:"fixed64,7,opt,name=alpha"`
+	xxx_hidden_Beta         float64                `protobuf:"fixed64,8,opt,name=beta"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *EdgeWeights) Reset() {
+	*x = EdgeWeights{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeWeights) ProtoMessage() {}
+
+func (x *EdgeWeights) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EdgeWeights) GetDistribution() WeightDistribution {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Distribution
+		}
+	}
+	return WeightDistribution_WEIGHT_DISTRIBUTION_UNSPECIFIED
+}
+
+func (x *EdgeWeights) GetMin() float64 {
+	if x != nil {
+		return x.xxx_hidden_Min
+	}
+	return 0
+}
+
+func (x *EdgeWeights) GetMax() float64 {
+	if x != nil {
+		return x.xxx_hidden_Max
+	}
+	return 0
+}
+
+func (x *EdgeWeights) GetMean() float64 {
+	if x != nil {
+		return x.xxx_hidden_Mean
+	}
+	return 0
+}
+
+func (x *EdgeWeights) GetMu() float64 {
+	if x != nil {
+		return x.xxx_hidden_Mu
+	}
+	return 0
+}
+
+func (x *EdgeWeights) GetSigma() float64 {
+	if x != nil {
+		return x.xxx_hidden_Sigma
+	}
+	return 0
+}
+
+func (x *EdgeWeights) GetAlpha() float64 {
+	if x != nil {
+		return x.xxx_hidden_Alpha
+	}
+	return 0
+}
+
+func (x *EdgeWeights) GetBeta() float64 {
+	if x != nil {
+		return x.xxx_hidden_Beta
+	}
+	return 0
+}
+
+func (x *EdgeWeights) SetDistribution(v WeightDistribution) {
+	x.xxx_hidden_Distribution = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *EdgeWeights) SetMin(v float64) {
+	x.xxx_hidden_Min = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *EdgeWeights) SetMax(v float64) {
+	x.xxx_hidden_Max = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *EdgeWeights) SetMean(v float64) {
+	x.xxx_hidden_Mean = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *EdgeWeights) SetMu(v float64) {
+	x.xxx_hidden_Mu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *EdgeWeights) SetSigma(v float64) {
+	x.xxx_hidden_Sigma = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *EdgeWeights) SetAlpha(v float64) {
+	x.xxx_hidden_Alpha = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *EdgeWeights) SetBeta(v float64) {
+	x.xxx_hidden_Beta = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *EdgeWeights) HasDistribution() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *EdgeWeights) HasMin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *EdgeWeights) HasMax() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *EdgeWeights) HasMean() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *EdgeWeights) HasMu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *EdgeWeights) HasSigma() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *EdgeWeights) HasAlpha() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *EdgeWeights) HasBeta() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *EdgeWeights) ClearDistribution() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Distribution = WeightDistribution_WEIGHT_DISTRIBUTION_UNSPECIFIED
+}
+
+func (x *EdgeWeights) ClearMin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Min = 0
+}
+
+func (x *EdgeWeights) ClearMax() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Max = 0
+}
+
+func (x *EdgeWeights) ClearMean() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Mean = 0
+}
+
+func (x *EdgeWeights) ClearMu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Mu = 0
+}
+
+func (x *EdgeWeights) ClearSigma() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Sigma = 0
+}
+
+func (x *EdgeWeights) ClearAlpha() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Alpha = 0
+}
+
+func (x *EdgeWeights) ClearBeta() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Beta = 0
+}
+
+type EdgeWeights_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Distribution *WeightDistribution
+	// min defaults to 0.
+	Min *float64
+	// max defaults to 1.
+	Max *float64
+	// mean defaults to 1.
+	Mean *float64
+	// mu defaults to 0.
+	Mu *float64
+	// sigma defaults to 1.
+	Sigma *float64
+	// alpha defaults to 1.
+	Alpha *float64
+	// beta defaults to 1.
+	Beta *float64
+}
+
+func (b0 EdgeWeights_builder) Build() *EdgeWeights {
+	m0 := &EdgeWeights{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Distribution != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Distribution = *b.Distribution
+	}
+	if b.Min != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_Min = *b.Min
+	}
+	if b.Max != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_Max = *b.Max
+	}
+	if b.Mean != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_Mean = *b.Mean
+	}
+	if b.Mu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_Mu = *b.Mu
+	}
+	if b.Sigma != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_Sigma = *b.Sigma
+	}
+	if b.Alpha != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_Alpha = *b.Alpha
+	}
+	if b.Beta != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_Beta = *b.Beta
+	}
+	return m0
+}
+
+// SybilRegion configures a region of Sybil nodes that is attached to the honest graph.
+type SybilRegion struct {
+	state                          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NumNodes            int64                  `protobuf:"varint,1,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_InitialConnected    int64                  `protobuf:"varint,2,opt,name=initial_connected,json=initialConnected"`
+	xxx_hidden_RewiringProbability float64                `protobuf:"fixed64,3,opt,name=rewiring_probability,json=rewiringProbability"`
+	xxx_hidden_AttackEdges         int64                  `protobuf:"varint,4,opt,name=attack_edges,json=attackEdges"`
+	XXX_raceDetectHookData         protoimpl.RaceDetectHookData
+	XXX_presence                   [1]uint32
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *SybilRegion) Reset() {
+	*x = SybilRegion{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SybilRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SybilRegion) ProtoMessage() {}
+
+func (x *SybilRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SybilRegion) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *SybilRegion) GetInitialConnected() int64 {
+	if x != nil {
+		return x.xxx_hidden_InitialConnected
+	}
+	return 0
+}
+
+func (x *SybilRegion) GetRewiringProbability() float64 {
+	if x != nil {
+		return x.xxx_hidden_RewiringProbability
+	}
+	return 0
+}
+
+func (x *SybilRegion) GetAttackEdges() int64 {
+	if x != nil {
+		return x.xxx_hidden_AttackEdges
+	}
+	return 0
+}
+
+func (x *SybilRegion) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *SybilRegion) SetInitialConnected(v int64) {
+	x.xxx_hidden_InitialConnected = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *SybilRegion) SetRewiringProbability(v float64) {
+	x.xxx_hidden_RewiringProbability = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *SybilRegion) SetAttackEdges(v int64) {
+	x.xxx_hidden_AttackEdges = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *SybilRegion) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SybilRegion) HasInitialConnected() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SybilRegion) HasRewiringProbability() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SybilRegion) HasAttackEdges() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SybilRegion) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *SybilRegion) ClearInitialConnected() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_InitialConnected = 0
+}
+
+func (x *SybilRegion) ClearRewiringProbability() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_RewiringProbability = 0
+}
+
+func (x *SybilRegion) ClearAttackEdges() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_AttackEdges = 0
+}
+
+type SybilRegion_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// num_nodes is the number of Sybil nodes, no region is attached when it is zero.
+	NumNodes *int64
+	// initial_connected configures the Watts–Strogatz topology inside the region.
+	InitialConnected *int64
+	// rewiring_probability configures the Watts–Strogatz topology inside the region.
+	RewiringProbability *float64
+	// attack_edges is the number of edges between the Sybil region and the honest region, at most the
+	// configured maximum number of nodes.
+	AttackEdges *int64
+}
+
+func (b0 SybilRegion_builder) Build() *SybilRegion {
+	m0 := &SybilRegion{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.InitialConnected != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_InitialConnected = *b.InitialConnected
+	}
+	if b.RewiringProbability != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_RewiringProbability = *b.RewiringProbability
+	}
+	if b.AttackEdges != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_AttackEdges = *b.AttackEdges
+	}
+	return m0
+}
+
+// ForceDirectedParams configures the Fruchterman–Reingold force-directed layout.
+type ForceDirectedParams struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Iterations  int64                  `protobuf:"varint,1,opt,name=iterations"`
+	xxx_hidden_Area        float64                `protobuf:"fixed64,2,opt,name=area"`
+	xxx_hidden_Repulsion   RepulsionAlgorithm     `protobuf:"varint,3,opt,name=repulsion,enum=internal.rpc.v1.RepulsionAlgorithm"`
+	xxx_hidden_Theta       float64                `protobuf:"fixed64,4,opt,name=theta"`
+	xxx_hidden_Cooling     CoolingSchedule        `protobuf:"varint,5,opt,name=cooling,enum=internal.rpc.v1.CoolingSchedule"`
+	xxx_hidden_Tolerance   float64                `protobuf:"fixed64,6,opt,name=tolerance"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ForceDirectedParams) Reset() {
+	*x = ForceDirectedParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDirectedParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDirectedParams) ProtoMessage() {}
+
+func (x *ForceDirectedParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ForceDirectedParams) GetIterations() int64 {
+	if x != nil {
+		return x.xxx_hidden_Iterations
+	}
+	return 0
+}
+
+func (x *ForceDirectedParams) GetArea() float64 {
+	if x != nil {
+		return x.xxx_hidden_Area
+	}
+	return 0
+}
+
+func (x *ForceDirectedParams) GetRepulsion() RepulsionAlgorithm {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Repulsion
+		}
+	}
+	return RepulsionAlgorithm_REPULSION_ALGORITHM_UNSPECIFIED
+}
+
+func (x *ForceDirectedParams) GetTheta() float64 {
+	if x != nil {
+		return x.xxx_hidden_Theta
+	}
+	return 0
+}
+
+func (x *ForceDirectedParams) GetCooling() CoolingSchedule {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_Cooling
+		}
+	}
+	return CoolingSchedule_COOLING_SCHEDULE_UNSPECIFIED
+}
+
+func (x *ForceDirectedParams) GetTolerance() float64 {
+	if x != nil {
+		return x.xxx_hidden_Tolerance
+	}
+	return 0
+}
+
+func (x *ForceDirectedParams) SetIterations(v int64) {
+	x.xxx_hidden_Iterations = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *ForceDirectedParams) SetArea(v float64) {
+	x.xxx_hidden_Area = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *ForceDirectedParams) SetRepulsion(v RepulsionAlgorithm) {
+	x.xxx_hidden_Repulsion = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *ForceDirectedParams) SetTheta(v float64) {
+	x.xxx_hidden_Theta = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *ForceDirectedParams) SetCooling(v CoolingSchedule) {
+	x.xxx_hidden_Cooling = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *ForceDirectedParams) SetTolerance(v float64) {
+	x.xxx_hidden_Tolerance = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *ForceDirectedParams) HasIterations() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ForceDirectedParams) HasArea() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ForceDirectedParams) HasRepulsion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ForceDirectedParams) HasTheta() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ForceDirectedParams) HasCooling() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ForceDirectedParams) HasTolerance() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ForceDirectedParams) ClearIterations() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Iterations = 0
+}
+
+func (x *ForceDirectedParams) ClearArea() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Area = 0
+}
+
+func (x *ForceDirectedParams) ClearRepulsion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Repulsion = RepulsionAlgorithm_REPULSION_ALGORITHM_UNSPECIFIED
+}
+
+func (x *ForceDirectedParams) ClearTheta() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Theta = 0
+}
+
+func (x *ForceDirectedParams) ClearCooling() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Cooling = CoolingSchedule_COOLING_SCHEDULE_UNSPECIFIED
+}
+
+func (x *ForceDirectedParams) ClearTolerance() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Tolerance = 0
+}
+
+type ForceDirectedParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Iterations *int64
+	Area       *float64
+	Repulsion  *RepulsionAlgorithm
+	// theta is the Barnes–Hut opening angle, lower is more accurate. Defaults to 0.8.
+	Theta   *float64
+	Cooling *CoolingSchedule
+	// tolerance stops the layout early once the nodes move less than this fraction of the ideal edge length
+	// on average. Zero runs all iterations.
+	Tolerance *float64
+}
+
+func (b0 ForceDirectedParams_builder) Build() *ForceDirectedParams {
+	m0 := &ForceDirectedParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Iterations != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Iterations = *b.Iterations
+	}
+	if b.Area != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_Area = *b.Area
+	}
+	if b.Repulsion != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_Repulsion = *b.Repulsion
+	}
+	if b.Theta != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_Theta = *b.Theta
+	}
+	if b.Cooling != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_Cooling = *b.Cooling
+	}
+	if b.Tolerance != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_Tolerance = *b.Tolerance
+	}
+	return m0
+}
+
+// CircularParams configures a layout that places the nodes on a circle, in the order of the graph.
+type CircularParams struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Radius      float64                `protobuf:"fixed64,1,opt,name=radius"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CircularParams) Reset() {
+	*x = CircularParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircularParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircularParams) ProtoMessage() {}
+
+func (x *CircularParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CircularParams) GetRadius() float64 {
+	if x != nil {
+		return x.xxx_hidden_Radius
+	}
+	return 0
+}
+
+func (x *CircularParams) SetRadius(v float64) {
+	x.xxx_hidden_Radius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *CircularParams) HasRadius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CircularParams) ClearRadius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Radius = 0
+}
+
+type CircularParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// radius of the circle, defaults to 300.
+	Radius *float64
+}
+
+func (b0 CircularParams_builder) Build() *CircularParams {
+	m0 := &CircularParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Radius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Radius = *b.Radius
+	}
+	return m0
+}
+
+// SpectralParams configures a layout that positions the nodes by the eigenvectors of the graph Laplacian
+// with the two smallest non-zero eigenvalues.
+type SpectralParams struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Scale       float64                `protobuf:"fixed64,1,opt,name=scale"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SpectralParams) Reset() {
+	*x = SpectralParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectralParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectralParams) ProtoMessage() {}
+
+func (x *SpectralParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SpectralParams) GetScale() float64 {
+	if x != nil {
+		return x.xxx_hidden_Scale
+	}
+	return 0
+}
+
+func (x *SpectralParams) SetScale(v float64) {
+	x.xxx_hidden_Scale = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *SpectralParams) HasScale() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SpectralParams) ClearScale() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Scale = 0
+}
+
+type SpectralParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// scale is the largest coordinate of the layout, defaults to 300.
+	Scale *float64
+}
+
+func (b0 SpectralParams_builder) Build() *SpectralParams {
+	m0 := &SpectralParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Scale != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Scale = *b.Scale
+	}
+	return m0
+}
+
+// KamadaKawaiParams configures a layout that minimizes the Kamada–Kawai stress: the difference between
+// the distance of the nodes in the layout and their shortest path distance in the graph.
+type KamadaKawaiParams struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Iterations  int64                  `protobuf:"varint,1,opt,name=iterations"`
+	xxx_hidden_EdgeLength  float64                `protobuf:"fixed64,2,opt,name=edge_length,json=edgeLength"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *KamadaKawaiParams) Reset() {
+	*x = KamadaKawaiParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KamadaKawaiParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KamadaKawaiParams) ProtoMessage() {}
+
+func (x *KamadaKawaiParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *KamadaKawaiParams) GetIterations() int64 {
+	if x != nil {
+		return x.xxx_hidden_Iterations
+	}
+	return 0
+}
+
+func (x *KamadaKawaiParams) GetEdgeLength() float64 {
+	if x != nil {
+		return x.xxx_hidden_EdgeLength
+	}
+	return 0
+}
+
+func (x *KamadaKawaiParams) SetIterations(v int64) {
+	x.xxx_hidden_Iterations = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *KamadaKawaiParams) SetEdgeLength(v float64) {
+	x.xxx_hidden_EdgeLength = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *KamadaKawaiParams) HasIterations() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *KamadaKawaiParams) HasEdgeLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *KamadaKawaiParams) ClearIterations() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Iterations = 0
+}
+
+func (x *KamadaKawaiParams) ClearEdgeLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EdgeLength = 0
+}
+
+type KamadaKawaiParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// iterations is the maximum number of stress majorization iterations, defaults to 100.
+	Iterations *int64
+	// edge_length is the ideal length of an edge, defaults to 50.
+	EdgeLength *float64
+}
+
+func (b0 KamadaKawaiParams_builder) Build() *KamadaKawaiParams {
+	m0 := &KamadaKawaiParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Iterations != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Iterations = *b.Iterations
+	}
+	if b.EdgeLength != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EdgeLength = *b.EdgeLength
+	}
+	return m0
+}
+
+// HierarchicalParams configures a layout that places the nodes in layers by their breadth-first distance
+// from Bob.
+type HierarchicalParams struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_LayerSpacing float64                `protobuf:"fixed64,1,opt,name=layer_spacing,json=layerSpacing"`
+	xxx_hidden_NodeSpacing  float64                `protobuf:"fixed64,2,opt,name=node_spacing,json=nodeSpacing"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *HierarchicalParams) Reset() {
+	*x = HierarchicalParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HierarchicalParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HierarchicalParams) ProtoMessage() {}
+
+func (x *HierarchicalParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *HierarchicalParams) GetLayerSpacing() float64 {
+	if x != nil {
+		return x.xxx_hidden_LayerSpacing
+	}
+	return 0
+}
+
+func (x *HierarchicalParams) GetNodeSpacing() float64 {
+	if x != nil {
+		return x.xxx_hidden_NodeSpacing
+	}
+	return 0
+}
+
+func (x *HierarchicalParams) SetLayerSpacing(v float64) {
+	x.xxx_hidden_LayerSpacing = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *HierarchicalParams) SetNodeSpacing(v float64) {
+	x.xxx_hidden_NodeSpacing = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *HierarchicalParams) HasLayerSpacing() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *HierarchicalParams) HasNodeSpacing() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *HierarchicalParams) ClearLayerSpacing() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_LayerSpacing = 0
+}
+
+func (x *HierarchicalParams) ClearNodeSpacing() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NodeSpacing = 0
+}
+
+type HierarchicalParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// layer_spacing is the vertical distance between layers, defaults to 100.
+	LayerSpacing *float64
+	// node_spacing is the horizontal distance between nodes in a layer, defaults to 50.
+	NodeSpacing *float64
+}
+
+func (b0 HierarchicalParams_builder) Build() *HierarchicalParams {
+	m0 := &HierarchicalParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.LayerSpacing != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_LayerSpacing = *b.LayerSpacing
+	}
+	if b.NodeSpacing != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NodeSpacing = *b.NodeSpacing
+	}
+	return m0
+}
+
+// RandomGraphRequest generates a graph and walks it. Besides the buf.validate rules of the fields,
+// Watts–Strogatz graphs need more nodes than initial_connected and the server caps the number of nodes,
+// the iterations and the walk steps. Invalid requests fail with an invalid argument error that carries a
+// Violations detail.
+type RandomGraphRequest struct {
+	state                          protoimpl.MessageState         `protogen:"opaque.v1"`
+	xxx_hidden_Seed1               uint64                         `protobuf:"varint,1,opt,name=seed1"`
+	xxx_hidden_Seed2               uint64                         `protobuf:"varint,2,opt,name=seed2"`
+	xxx_hidden_NumNodes            int64                          `protobuf:"varint,3,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_InitialConnected    int64                          `protobuf:"varint,4,opt,name=initial_connected,json=initialConnected"`
+	xxx_hidden_RewiringProbability float64                        `protobuf:"fixed64,5,opt,name=rewiring_probability,json=rewiringProbability"`
+	xxx_hidden_LayoutIterations    int64                          `protobuf:"varint,6,opt,name=layout_iterations,json=layoutIterations"`
+	xxx_hidden_LayoutArea          float64                        `protobuf:"fixed64,7,opt,name=layout_area,json=layoutArea"`
+	xxx_hidden_WalkLength          int64                          `protobuf:"varint,8,opt,name=walk_length,json=walkLength"`
+	xxx_hidden_NumWalks            int64                          `protobuf:"varint,9,opt,name=num_walks,json=numWalks"`
+	xxx_hidden_Seed3               uint64                         `protobuf:"varint,10,opt,name=seed3"`
+	xxx_hidden_Seed4               uint64                         `protobuf:"varint,11,opt,name=seed4"`
+	xxx_hidden_WalkMode            WalkMode                       `protobuf:"varint,12,opt,name=walk_mode,json=walkMode,enum=internal.rpc.v1.WalkMode"`
+	xxx_hidden_SybilRegion         *SybilRegion                   `protobuf:"bytes,13,opt,name=sybil_region,json=sybilRegion"`
+	xxx_hidden_Generator           isRandomGraphRequest_Generator `protobuf_oneof:"generator"`
+	xxx_hidden_LayoutRepulsion     RepulsionAlgorithm             `protobuf:"varint,21,opt,name=layout_repulsion,json=layoutRepulsion,enum=internal.rpc.v1.RepulsionAlgorithm"`
+	xxx_hidden_LayoutTheta         float64                        `protobuf:"fixed64,22,opt,name=layout_theta,json=layoutTheta"`
+	xxx_hidden_LayoutCooling       CoolingSchedule                `protobuf:"varint,23,opt,name=layout_cooling,json=layoutCooling,enum=internal.rpc.v1.CoolingSchedule"`
+	xxx_hidden_LayoutTolerance     float64                        `protobuf:"fixed64,24,opt,name=layout_tolerance,json=layoutTolerance"`
+	xxx_hidden_Layout              isRandomGraphRequest_Layout    `protobuf_oneof:"layout"`
+	xxx_hidden_EdgeWeights         *EdgeWeights                   `protobuf:"bytes,30,opt,name=edge_weights,json=edgeWeights"`
+	xxx_hidden_Laziness            float64                        `protobuf:"fixed64,31,opt,name=laziness"`
+	xxx_hidden_Directed            bool                           `protobuf:"varint,32,opt,name=directed"`
+	xxx_hidden_Reciprocity         float64                        `protobuf:"fixed64,33,opt,name=reciprocity"`
+	xxx_hidden_IncludeStats        bool                           `protobuf:"varint,34,opt,name=include_stats,json=includeStats"`
+	XXX_raceDetectHookData         protoimpl.RaceDetectHookData
+	XXX_presence                   [1]uint32
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *RandomGraphRequest) Reset() {
+	*x = RandomGraphRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomGraphRequest) ProtoMessage() {}
+
+func (x *RandomGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RandomGraphRequest) GetSeed1() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seed1
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetSeed2() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seed2
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetInitialConnected() int64 {
+	if x != nil {
+		return x.xxx_hidden_InitialConnected
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetRewiringProbability() float64 {
+	if x != nil {
+		return x.xxx_hidden_RewiringProbability
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetLayoutIterations() int64 {
+	if x != nil {
+		return x.xxx_hidden_LayoutIterations
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetLayoutArea() float64 {
+	if x != nil {
+		return x.xxx_hidden_LayoutArea
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetWalkLength() int64 {
+	if x != nil {
+		return x.xxx_hidden_WalkLength
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetNumWalks() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumWalks
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetSeed3() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seed3
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetSeed4() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seed4
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetWalkMode() WalkMode {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 11) {
+			return x.xxx_hidden_WalkMode
+		}
+	}
+	return WalkMode_WALK_MODE_UNSPECIFIED
+}
+
+func (x *RandomGraphRequest) GetSybilRegion() *SybilRegion {
+	if x != nil {
+		return x.xxx_hidden_SybilRegion
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetWattsStrogatz() *WattsStrogatzParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Generator.(*randomGraphRequest_WattsStrogatz); ok {
+			return x.WattsStrogatz
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetErdosRenyi() *ErdosRenyiParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Generator.(*randomGraphRequest_ErdosRenyi); ok {
+			return x.ErdosRenyi
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetBarabasiAlbert() *BarabasiAlbertParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Generator.(*randomGraphRequest_BarabasiAlbert); ok {
+			return x.BarabasiAlbert
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetStochasticBlock() *StochasticBlockParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Generator.(*randomGraphRequest_StochasticBlock); ok {
+			return x.StochasticBlock
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetRandomRegular() *RandomRegularParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Generator.(*randomGraphRequest_RandomRegular); ok {
+			return x.RandomRegular
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetLattice() *LatticeParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Generator.(*randomGraphRequest_Lattice); ok {
+			return x.Lattice
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetGraphId() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Generator.(*randomGraphRequest_GraphId); ok {
+			return x.GraphId
+		}
+	}
+	return ""
+}
+
+func (x *RandomGraphRequest) GetLayoutRepulsion() RepulsionAlgorithm {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 14) {
+			return x.xxx_hidden_LayoutRepulsion
+		}
+	}
+	return RepulsionAlgorithm_REPULSION_ALGORITHM_UNSPECIFIED
+}
+
+func (x *RandomGraphRequest) GetLayoutTheta() float64 {
+	if x != nil {
+		return x.xxx_hidden_LayoutTheta
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetLayoutCooling() CoolingSchedule {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 16) {
+			return x.xxx_hidden_LayoutCooling
+		}
+	}
+	return CoolingSchedule_COOLING_SCHEDULE_UNSPECIFIED
+}
+
+func (x *RandomGraphRequest) GetLayoutTolerance() float64 {
+	if x != nil {
+		return x.xxx_hidden_LayoutTolerance
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetForceDirected() *ForceDirectedParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Layout.(*randomGraphRequest_ForceDirected); ok {
+			return x.ForceDirected
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetCircular() *CircularParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Circular); ok {
+			return x.Circular
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetSpectral() *SpectralParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Spectral); ok {
+			return x.Spectral
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetKamadaKawai() *KamadaKawaiParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Layout.(*randomGraphRequest_KamadaKawai); ok {
+			return x.KamadaKawai
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetHierarchical() *HierarchicalParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Hierarchical); ok {
+			return x.Hierarchical
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetEdgeWeights() *EdgeWeights {
+	if x != nil {
+		return x.xxx_hidden_EdgeWeights
+	}
+	return nil
+}
+
+func (x *RandomGraphRequest) GetLaziness() float64 {
+	if x != nil {
+		return x.xxx_hidden_Laziness
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetDirected() bool {
+	if x != nil {
+		return x.xxx_hidden_Directed
+	}
+	return false
+}
+
+func (x *RandomGraphRequest) GetReciprocity() float64 {
+	if x != nil {
+		return x.xxx_hidden_Reciprocity
+	}
+	return 0
+}
+
+func (x *RandomGraphRequest) GetIncludeStats() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeStats
+	}
+	return false
+}
+
+func (x *RandomGraphRequest) SetSeed1(v uint64) {
+	x.xxx_hidden_Seed1 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 24)
+}
+
+func (x *RandomGraphRequest) SetSeed2(v uint64) {
+	x.xxx_hidden_Seed2 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 24)
+}
+
+func (x *RandomGraphRequest) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 24)
+}
+
+func (x *RandomGraphRequest) SetInitialConnected(v int64) {
+	x.xxx_hidden_InitialConnected = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 24)
+}
+
+func (x *RandomGraphRequest) SetRewiringProbability(v float64) {
+	x.xxx_hidden_RewiringProbability = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 24)
+}
+
+func (x *RandomGraphRequest) SetLayoutIterations(v int64) {
+	x.xxx_hidden_LayoutIterations = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 24)
+}
+
+func (x *RandomGraphRequest) SetLayoutArea(v float64) {
+	x.xxx_hidden_LayoutArea = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 24)
+}
+
+func (x *RandomGraphRequest) SetWalkLength(v int64) {
+	x.xxx_hidden_WalkLength = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 24)
+}
+
+func (x *RandomGraphRequest) SetNumWalks(v int64) {
+	x.xxx_hidden_NumWalks = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 24)
+}
+
+func (x *RandomGraphRequest) SetSeed3(v uint64) {
+	x.xxx_hidden_Seed3 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 24)
+}
+
+func (x *RandomGraphRequest) SetSeed4(v uint64) {
+	x.xxx_hidden_Seed4 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 24)
+}
+
+func (x *RandomGraphRequest) SetWalkMode(v WalkMode) {
+	x.xxx_hidden_WalkMode = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 24)
+}
+
+func (x *RandomGraphRequest) SetSybilRegion(v *SybilRegion) {
+	x.xxx_hidden_SybilRegion = v
+}
+
+func (x *RandomGraphRequest) SetWattsStrogatz(v *WattsStrogatzParams) {
+	if v == nil {
+		x.xxx_hidden_Generator = nil
+		return
+	}
+	x.xxx_hidden_Generator = &randomGraphRequest_WattsStrogatz{v}
+}
+
+func (x *RandomGraphRequest) SetErdosRenyi(v *ErdosRenyiParams) {
+	if v == nil {
+		x.xxx_hidden_Generator = nil
+		return
+	}
+	x.xxx_hidden_Generator = &randomGraphRequest_ErdosRenyi{v}
+}
+
+func (x *RandomGraphRequest) SetBarabasiAlbert(v *BarabasiAlbertParams) {
+	if v == nil {
+		x.xxx_hidden_Generator = nil
+		return
+	}
+	x.xxx_hidden_Generator = &randomGraphRequest_BarabasiAlbert{v}
+}
+
+func (x *RandomGraphRequest) SetStochasticBlock(v *StochasticBlockParams) {
+	if v == nil {
+		x.xxx_hidden_Generator = nil
+		return
+	}
+	x.xxx_hidden_Generator = &randomGraphRequest_StochasticBlock{v}
+}
+
+func (x *RandomGraphRequest) SetRandomRegular(v *RandomRegularParams) {
+	if v == nil {
+		x.xxx_hidden_Generator = nil
+		return
+	}
+	x.xxx_hidden_Generator = &randomGraphRequest_RandomRegular{v}
+}
+
+func (x *RandomGraphRequest) SetLattice(v *LatticeParams) {
+	if v == nil {
+		x.xxx_hidden_Generator = nil
+		return
+	}
+	x.xxx_hidden_Generator = &randomGraphRequest_Lattice{v}
+}
+
+func (x *RandomGraphRequest) SetGraphId(v string) {
+	x.xxx_hidden_Generator = &randomGraphRequest_GraphId{v}
+}
+
+func (x *RandomGraphRequest) SetLayoutRepulsion(v RepulsionAlgorithm) {
+	x.xxx_hidden_LayoutRepulsion = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 24)
+}
+
+func (x *RandomGraphRequest) SetLayoutTheta(v float64) {
+	x.xxx_hidden_LayoutTheta = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 24)
+}
+
+func (x *RandomGraphRequest) SetLayoutCooling(v CoolingSchedule) {
+	x.xxx_hidden_LayoutCooling = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 24)
+}
+
+func (x *RandomGraphRequest) SetLayoutTolerance(v float64) {
+	x.xxx_hidden_LayoutTolerance = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 24)
+}
+
+func (x *RandomGraphRequest) SetForceDirected(v *ForceDirectedParams) {
+	if v == nil {
+		x.xxx_hidden_Layout = nil
+		return
+	}
+	x.xxx_hidden_Layout = &randomGraphRequest_ForceDirected{v}
+}
+
+func (x *RandomGraphRequest) SetCircular(v *CircularParams) {
+	if v == nil {
+		x.xxx_hidden_Layout = nil
+		return
+	}
+	x.xxx_hidden_Layout = &randomGraphRequest_Circular{v}
+}
+
+func (x *RandomGraphRequest) SetSpectral(v *SpectralParams) {
+	if v == nil {
+		x.xxx_hidden_Layout = nil
+		return
+	}
+	x.xxx_hidden_Layout = &randomGraphRequest_Spectral{v}
+}
+
+func (x *RandomGraphRequest) SetKamadaKawai(v *KamadaKawaiParams) {
+	if v == nil {
+		x.xxx_hidden_Layout = nil
+		return
+	}
+	x.xxx_hidden_Layout = &randomGraphRequest_KamadaKawai{v}
+}
+
+func (x *RandomGraphRequest) SetHierarchical(v *HierarchicalParams) {
+	if v == nil {
+		x.xxx_hidden_Layout = nil
+		return
+	}
+	x.xxx_hidden_Layout = &randomGraphRequest_Hierarchical{v}
+}
+
+func (x *RandomGraphRequest) SetEdgeWeights(v *EdgeWeights) {
+	x.xxx_hidden_EdgeWeights = v
+}
+
+func (x *RandomGraphRequest) SetLaziness(v float64) {
+	x.xxx_hidden_Laziness = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 24)
+}
+
+func (x *RandomGraphRequest) SetDirected(v bool) {
+	x.xxx_hidden_Directed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 21, 24)
+}
+
+func (x *RandomGraphRequest) SetReciprocity(v float64) {
+	x.xxx_hidden_Reciprocity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 22, 24)
+}
+
+func (x *RandomGraphRequest) SetIncludeStats(v bool) {
+	x.xxx_hidden_IncludeStats = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 23, 24)
+}
+
+func (x *RandomGraphRequest) HasSeed1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RandomGraphRequest) HasSeed2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RandomGraphRequest) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RandomGraphRequest) HasInitialConnected() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RandomGraphRequest) HasRewiringProbability() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RandomGraphRequest) HasLayoutIterations() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *RandomGraphRequest) HasLayoutArea() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *RandomGraphRequest) HasWalkLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *RandomGraphRequest) HasNumWalks() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *RandomGraphRequest) HasSeed3() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *RandomGraphRequest) HasSeed4() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *RandomGraphRequest) HasWalkMode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *RandomGraphRequest) HasSybilRegion() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_SybilRegion != nil
+}
+
+func (x *RandomGraphRequest) HasGenerator() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Generator != nil
+}
+
+func (x *RandomGraphRequest) HasWattsStrogatz() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Generator.(*randomGraphRequest_WattsStrogatz)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasErdosRenyi() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Generator.(*randomGraphRequest_ErdosRenyi)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasBarabasiAlbert() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Generator.(*randomGraphRequest_BarabasiAlbert)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasStochasticBlock() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Generator.(*randomGraphRequest_StochasticBlock)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasRandomRegular() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Generator.(*randomGraphRequest_RandomRegular)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasLattice() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Generator.(*randomGraphRequest_Lattice)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Generator.(*randomGraphRequest_GraphId)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasLayoutRepulsion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *RandomGraphRequest) HasLayoutTheta() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *RandomGraphRequest) HasLayoutCooling() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *RandomGraphRequest) HasLayoutTolerance() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
+func (x *RandomGraphRequest) HasLayout() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Layout != nil
+}
+
+func (x *RandomGraphRequest) HasForceDirected() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Layout.(*randomGraphRequest_ForceDirected)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasCircular() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Circular)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasSpectral() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Spectral)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasKamadaKawai() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Layout.(*randomGraphRequest_KamadaKawai)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasHierarchical() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Hierarchical)
+	return ok
+}
+
+func (x *RandomGraphRequest) HasEdgeWeights() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EdgeWeights != nil
+}
+
+func (x *RandomGraphRequest) HasLaziness() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
+func (x *RandomGraphRequest) HasDirected() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 21)
+}
+
+func (x *RandomGraphRequest) HasReciprocity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 22)
+}
+
+func (x *RandomGraphRequest) HasIncludeStats() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 23)
+}
+
+func (x *RandomGraphRequest) ClearSeed1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Seed1 = 0
+}
+
+func (x *RandomGraphRequest) ClearSeed2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Seed2 = 0
+}
+
+func (x *RandomGraphRequest) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *RandomGraphRequest) ClearInitialConnected() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_InitialConnected = 0
+}
+
+func (x *RandomGraphRequest) ClearRewiringProbability() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_RewiringProbability = 0
+}
+
+func (x *RandomGraphRequest) ClearLayoutIterations() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_LayoutIterations = 0
+}
+
+func (x *RandomGraphRequest) ClearLayoutArea() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_LayoutArea = 0
+}
+
+func (x *RandomGraphRequest) ClearWalkLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_WalkLength = 0
+}
+
+func (x *RandomGraphRequest) ClearNumWalks() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_NumWalks = 0
+}
+
+func (x *RandomGraphRequest) ClearSeed3() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Seed3 = 0
+}
+
+func (x *RandomGraphRequest) ClearSeed4() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Seed4 = 0
+}
+
+func (x *RandomGraphRequest) ClearWalkMode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_WalkMode = WalkMode_WALK_MODE_UNSPECIFIED
+}
+
+func (x *RandomGraphRequest) ClearSybilRegion() {
+	x.xxx_hidden_SybilRegion = nil
+}
+
+func (x *RandomGraphRequest) ClearGenerator() {
+	x.xxx_hidden_Generator = nil
+}
+
+func (x *RandomGraphRequest) ClearWattsStrogatz() {
+	if _, ok := x.xxx_hidden_Generator.(*randomGraphRequest_WattsStrogatz); ok {
+		x.xxx_hidden_Generator = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearErdosRenyi() {
+	if _, ok := x.xxx_hidden_Generator.(*randomGraphRequest_ErdosRenyi); ok {
+		x.xxx_hidden_Generator = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearBarabasiAlbert() {
+	if _, ok := x.xxx_hidden_Generator.(*randomGraphRequest_BarabasiAlbert); ok {
+		x.xxx_hidden_Generator = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearStochasticBlock() {
+	if _, ok := x.xxx_hidden_Generator.(*randomGraphRequest_StochasticBlock); ok {
+		x.xxx_hidden_Generator = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearRandomRegular() {
+	if _, ok := x.xxx_hidden_Generator.(*randomGraphRequest_RandomRegular); ok {
+		x.xxx_hidden_Generator = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearLattice() {
+	if _, ok := x.xxx_hidden_Generator.(*randomGraphRequest_Lattice); ok {
+		x.xxx_hidden_Generator = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearGraphId() {
+	if _, ok := x.xxx_hidden_Generator.(*randomGraphRequest_GraphId); ok {
+		x.xxx_hidden_Generator = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearLayoutRepulsion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_LayoutRepulsion = RepulsionAlgorithm_REPULSION_ALGORITHM_UNSPECIFIED
+}
+
+func (x *RandomGraphRequest) ClearLayoutTheta() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_LayoutTheta = 0
+}
+
+func (x *RandomGraphRequest) ClearLayoutCooling() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_LayoutCooling = CoolingSchedule_COOLING_SCHEDULE_UNSPECIFIED
+}
+
+func (x *RandomGraphRequest) ClearLayoutTolerance() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_LayoutTolerance = 0
+}
+
+func (x *RandomGraphRequest) ClearLayout() {
+	x.xxx_hidden_Layout = nil
+}
+
+func (x *RandomGraphRequest) ClearForceDirected() {
+	if _, ok := x.xxx_hidden_Layout.(*randomGraphRequest_ForceDirected); ok {
+		x.xxx_hidden_Layout = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearCircular() {
+	if _, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Circular); ok {
+		x.xxx_hidden_Layout = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearSpectral() {
+	if _, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Spectral); ok {
+		x.xxx_hidden_Layout = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearKamadaKawai() {
+	if _, ok := x.xxx_hidden_Layout.(*randomGraphRequest_KamadaKawai); ok {
+		x.xxx_hidden_Layout = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearHierarchical() {
+	if _, ok := x.xxx_hidden_Layout.(*randomGraphRequest_Hierarchical); ok {
+		x.xxx_hidden_Layout = nil
+	}
+}
+
+func (x *RandomGraphRequest) ClearEdgeWeights() {
+	x.xxx_hidden_EdgeWeights = nil
+}
+
+func (x *RandomGraphRequest) ClearLaziness() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_Laziness = 0
+}
+
+func (x *RandomGraphRequest) ClearDirected() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 21)
+	x.xxx_hidden_Directed = false
+}
+
+func (x *RandomGraphRequest) ClearReciprocity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 22)
+	x.xxx_hidden_Reciprocity = 0
+}
+
+func (x *RandomGraphRequest) ClearIncludeStats() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 23)
+	x.xxx_hidden_IncludeStats = false
+}
+
+const RandomGraphRequest_Generator_not_set_case case_RandomGraphRequest_Generator = 0
+const RandomGraphRequest_WattsStrogatz_case case_RandomGraphRequest_Generator = 14
+const RandomGraphRequest_ErdosRenyi_case case_RandomGraphRequest_Generator = 15
+const RandomGraphRequest_BarabasiAlbert_case case_RandomGraphRequest_Generator = 16
+const RandomGraphRequest_StochasticBlock_case case_RandomGraphRequest_Generator = 17
+const RandomGraphRequest_RandomRegular_case case_RandomGraphRequest_Generator = 18
+const RandomGraphRequest_Lattice_case case_RandomGraphRequest_Generator = 19
+const RandomGraphRequest_GraphId_case case_RandomGraphRequest_Generator = 20
+
+func (x *RandomGraphRequest) WhichGenerator() case_RandomGraphRequest_Generator {
+	if x == nil {
+		return RandomGraphRequest_Generator_not_set_case
+	}
+	switch x.xxx_hidden_Generator.(type) {
+	case *randomGraphRequest_WattsStrogatz:
+		return RandomGraphRequest_WattsStrogatz_case
+	case *randomGraphRequest_ErdosRenyi:
+		return RandomGraphRequest_ErdosRenyi_case
+	case *randomGraphRequest_BarabasiAlbert:
+		return RandomGraphRequest_BarabasiAlbert_case
+	case *randomGraphRequest_StochasticBlock:
+		return RandomGraphRequest_StochasticBlock_case
+	case *randomGraphRequest_RandomRegular:
+		return RandomGraphRequest_RandomRegular_case
+	case *randomGraphRequest_Lattice:
+		return RandomGraphRequest_Lattice_case
+	case *randomGraphRequest_GraphId:
+		return RandomGraphRequest_GraphId_case
+	default:
+		return RandomGraphRequest_Generator_not_set_case
+	}
+}
+
+const RandomGraphRequest_Layout_not_set_case case_RandomGraphRequest_Layout = 0
+const RandomGraphRequest_ForceDirected_case case_RandomGraphRequest_Layout = 25
+const RandomGraphRequest_Circular_case case_RandomGraphRequest_Layout = 26
+const RandomGraphRequest_Spectral_case case_RandomGraphRequest_Layout = 27
+const RandomGraphRequest_KamadaKawai_case case_RandomGraphRequest_Layout = 28
+const RandomGraphRequest_Hierarchical_case case_RandomGraphRequest_Layout = 29
+
+func (x *RandomGraphRequest) WhichLayout() case_RandomGraphRequest_Layout {
+	if x == nil {
+		return RandomGraphRequest_Layout_not_set_case
+	}
+	switch x.xxx_hidden_Layout.(type) {
+	case *randomGraphRequest_ForceDirected:
+		return RandomGraphRequest_ForceDirected_case
+	case *randomGraphRequest_Circular:
+		return RandomGraphRequest_Circular_case
+	case *randomGraphRequest_Spectral:
+		return RandomGraphRequest_Spectral_case
+	case *randomGraphRequest_KamadaKawai:
+		return RandomGraphRequest_KamadaKawai_case
+	case *randomGraphRequest_Hierarchical:
+		return RandomGraphRequest_Hierarchical_case
+	default:
+		return RandomGraphRequest_Layout_not_set_case
+	}
+}
+
+type RandomGraphRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Seed1               *uint64
+	Seed2               *uint64
+	NumNodes            *int64
+	InitialConnected    *int64
+	RewiringProbability *float64
+	LayoutIterations    *int64
+	LayoutArea          *float64
+	WalkLength          *int64
+	NumWalks            *int64
+	Seed3               *uint64
+	Seed4               *uint64
+	WalkMode            *WalkMode
+	SybilRegion         *SybilRegion
+	// generator selects the graph model, when unset a Watts–Strogatz graph is generated from
+	// num_nodes, initial_connected and rewiring_probability.
+
+	// Fields of oneof xxx_hidden_Generator:
+	WattsStrogatz   *WattsStrogatzParams
+	ErdosRenyi      *ErdosRenyiParams
+	BarabasiAlbert  *BarabasiAlbertParams
+	StochasticBlock *StochasticBlockParams
+	RandomRegular   *RandomRegularParams
+	Lattice         *LatticeParams
+	// graph_id uses a stored graph. The Sybil region, orientation and edge weights of the request only
+	// apply to imported graphs, generated graphs keep those of the request that created them.
+	GraphId *string
+	// -- end of xxx_hidden_Generator
+	LayoutRepulsion *RepulsionAlgorithm
+	// layout_theta is the Barnes–Hut opening angle, lower is more accurate. Defaults to 0.8.
+	LayoutTheta   *float64
+	LayoutCooling *CoolingSchedule
+	// layout_tolerance stops the layout early once the nodes move less than this fraction of the ideal edge
+	// length on average. Zero runs all iterations.
+	LayoutTolerance *float64
+	// layout selects the layout engine, when unset a force-directed layout is configured from the
+	// top-level layout fields.
+
+	// Fields of oneof xxx_hidden_Layout:
+	ForceDirected *ForceDirectedParams
+	Circular      *CircularParams
+	Spectral      *SpectralParams
+	KamadaKawai   *KamadaKawaiParams
+	Hierarchical  *HierarchicalParams
+	// -- end of xxx_hidden_Layout
+	// edge_weights assigns weights to the edges, including those of the Sybil region.
+	EdgeWeights *EdgeWeights
+	// laziness is the probability that a weighted walk stays at its node for a step.
+	Laziness *float64
+	// directed orients the edges of the graph, walks then only follow outgoing edges.
+	Directed *bool
+	// reciprocity is the probability that an edge of a directed graph is reciprocated by an edge in the
+	// opposite direction, otherwise the edge points in a random direction.
+	Reciprocity *float64
+	// include_stats reports the structure of the graph in the response.
+	IncludeStats *bool
+}
+
+func (b0 RandomGraphRequest_builder) Build() *RandomGraphRequest {
+	m0 := &RandomGraphRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Seed1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 24)
+		x.xxx_hidden_Seed1 = *b.Seed1
+	}
+	if b.Seed2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 24)
+		x.xxx_hidden_Seed2 = *b.Seed2
+	}
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 24)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.InitialConnected != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 24)
+		x.xxx_hidden_InitialConnected = *b.InitialConnected
+	}
+	if b.RewiringProbability != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 24)
+		x.xxx_hidden_RewiringProbability = *b.RewiringProbability
+	}
+	if b.LayoutIterations != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 24)
+		x.xxx_hidden_LayoutIterations = *b.LayoutIterations
+	}
+	if b.LayoutArea != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 24)
+		x.xxx_hidden_LayoutArea = *b.LayoutArea
+	}
+	if b.WalkLength != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 24)
+		x.xxx_hidden_WalkLength = *b.WalkLength
+	}
+	if b.NumWalks != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 24)
+		x.xxx_hidden_NumWalks = *b.NumWalks
+	}
+	if b.Seed3 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 24)
+		x.xxx_hidden_Seed3 = *b.Seed3
+	}
+	if b.Seed4 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 24)
+		x.xxx_hidden_Seed4 = *b.Seed4
+	}
+	if b.WalkMode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 24)
+		x.xxx_hidden_WalkMode = *b.WalkMode
+	}
+	x.xxx_hidden_SybilRegion = b.SybilRegion
+	if b.WattsStrogatz != nil {
+		x.xxx_hidden_Generator = &randomGraphRequest_WattsStrogatz{b.WattsStrogatz}
+	}
+	if b.ErdosRenyi != nil {
+		x.xxx_hidden_Generator = &randomGraphRequest_ErdosRenyi{b.ErdosRenyi}
+	}
+	if b.BarabasiAlbert != nil {
+		x.xxx_hidden_Generator = &randomGraphRequest_BarabasiAlbert{b.BarabasiAlbert}
+	}
+	if b.StochasticBlock != nil {
+		x.xxx_hidden_Generator = &randomGraphRequest_StochasticBlock{b.StochasticBlock}
+	}
+	if b.RandomRegular != nil {
+		x.xxx_hidden_Generator = &randomGraphRequest_RandomRegular{b.RandomRegular}
+	}
+	if b.Lattice != nil {
+		x.xxx_hidden_Generator = &randomGraphRequest_Lattice{b.Lattice}
+	}
+	if b.GraphId != nil {
+		x.xxx_hidden_Generator = &randomGraphRequest_GraphId{*b.GraphId}
+	}
+	if b.LayoutRepulsion != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 24)
+		x.xxx_hidden_LayoutRepulsion = *b.LayoutRepulsion
+	}
+	if b.LayoutTheta != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 24)
+		x.xxx_hidden_LayoutTheta = *b.LayoutTheta
+	}
+	if b.LayoutCooling != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 24)
+		x.xxx_hidden_LayoutCooling = *b.LayoutCooling
+	}
+	if b.LayoutTolerance != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 24)
+		x.xxx_hidden_LayoutTolerance = *b.LayoutTolerance
+	}
+	if b.ForceDirected != nil {
+		x.xxx_hidden_Layout = &randomGraphRequest_ForceDirected{b.ForceDirected}
+	}
+	if b.Circular != nil {
+		x.xxx_hidden_Layout = &randomGraphRequest_Circular{b.Circular}
+	}
+	if b.Spectral != nil {
+		x.xxx_hidden_Layout = &randomGraphRequest_Spectral{b.Spectral}
+	}
+	if b.KamadaKawai != nil {
+		x.xxx_hidden_Layout = &randomGraphRequest_KamadaKawai{b.KamadaKawai}
+	}
+	if b.Hierarchical != nil {
+		x.xxx_hidden_Layout = &randomGraphRequest_Hierarchical{b.Hierarchical}
+	}
+	x.xxx_hidden_EdgeWeights = b.EdgeWeights
+	if b.Laziness != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 24)
+		x.xxx_hidden_Laziness = *b.Laziness
+	}
+	if b.Directed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 21, 24)
+		x.xxx_hidden_Directed = *b.Directed
+	}
+	if b.Reciprocity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 22, 24)
+		x.xxx_hidden_Reciprocity = *b.Reciprocity
+	}
+	if b.IncludeStats != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 23, 24)
+		x.xxx_hidden_IncludeStats = *b.IncludeStats
+	}
+	return m0
+}
+
+type case_RandomGraphRequest_Generator protoreflect.FieldNumber
+
+func (x case_RandomGraphRequest_Generator) String() string {
+	md := file_internal_rpc_v1_rpc_proto_msgTypes[19].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type case_RandomGraphRequest_Layout protoreflect.FieldNumber
+
+func (x case_RandomGraphRequest_Layout) String() string {
+	md := file_internal_rpc_v1_rpc_proto_msgTypes[19].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isRandomGraphRequest_Generator interface {
+	isRandomGraphRequest_Generator()
+}
+
+type randomGraphRequest_WattsStrogatz struct {
+	WattsStrogatz *WattsStrogatzParams `protobuf:"bytes,14,opt,name=watts_strogatz,json=wattsStrogatz,oneof"`
+}
+
+type randomGraphRequest_ErdosRenyi struct {
+	ErdosRenyi *ErdosRenyiParams `protobuf:"bytes,15,opt,name=erdos_renyi,json=erdosRenyi,oneof"`
+}
+
+type randomGraphRequest_BarabasiAlbert struct {
+	BarabasiAlbert *BarabasiAlbertParams `protobuf:"bytes,16,opt,name=barabasi_albert,json=barabasiAlbert,oneof"`
+}
+
+type randomGraphRequest_StochasticBlock struct {
+	StochasticBlock *StochasticBlockParams `protobuf:"bytes,17,opt,name=stochastic_block,json=stochasticBlock,oneof"`
+}
+
+type randomGraphRequest_RandomRegular struct {
+	RandomRegular *RandomRegularParams `protobuf:"bytes,18,opt,name=random_regular,json=randomRegular,oneof"`
+}
+
+type randomGraphRequest_Lattice struct {
+	Lattice *LatticeParams `protobuf:"bytes,19,opt,name=lattice,oneof"`
+}
+
+type randomGraphRequest_GraphId struct {
+	// graph_id uses a stored graph. The Sybil region, orientation and edge weights of the request only
+	// apply to imported graphs, generated graphs keep those of the request that created them.
+	GraphId string `protobuf:"bytes,20,opt,name=graph_id,json=graphId,oneof"`
+}
+
+func (*randomGraphRequest_WattsStrogatz) isRandomGraphRequest_Generator() {}
+
+func (*randomGraphRequest_ErdosRenyi) isRandomGraphRequest_Generator() {}
+
+func (*randomGraphRequest_BarabasiAlbert) isRandomGraphRequest_Generator() {}
+
+func (*randomGraphRequest_StochasticBlock) isRandomGraphRequest_Generator() {}
+
+func (*randomGraphRequest_RandomRegular) isRandomGraphRequest_Generator() {}
+
+func (*randomGraphRequest_Lattice) isRandomGraphRequest_Generator() {}
+
+func (*randomGraphRequest_GraphId) isRandomGraphRequest_Generator() {}
+
+type isRandomGraphRequest_Layout interface {
+	isRandomGraphRequest_Layout()
+}
+
+type randomGraphRequest_ForceDirected struct {
+	ForceDirected *ForceDirectedParams `protobuf:"bytes,25,opt,name=force_directed,json=forceDirected,oneof"`
+}
+
+type randomGraphRequest_Circular struct {
+	Circular *CircularParams `protobuf:"bytes,26,opt,name=circular,oneof"`
+}
+
+type randomGraphRequest_Spectral struct {
+	Spectral *SpectralParams `protobuf:"bytes,27,opt,name=spectral,oneof"`
+}
+
+type randomGraphRequest_KamadaKawai struct {
+	KamadaKawai *KamadaKawaiParams `protobuf:"bytes,28,opt,name=kamada_kawai,json=kamadaKawai,oneof"`
+}
+
+type randomGraphRequest_Hierarchical struct {
+	Hierarchical *HierarchicalParams `protobuf:"bytes,29,opt,name=hierarchical,oneof"`
+}
+
+func (*randomGraphRequest_ForceDirected) isRandomGraphRequest_Layout() {}
+
+func (*randomGraphRequest_Circular) isRandomGraphRequest_Layout() {}
+
+func (*randomGraphRequest_Spectral) isRandomGraphRequest_Layout() {}
+
+func (*randomGraphRequest_KamadaKawai) isRandomGraphRequest_Layout() {}
+
+func (*randomGraphRequest_Hierarchical) isRandomGraphRequest_Layout() {}
+
+// LayoutStats reports how an iterative layout went, it is only set by the force-directed and Kamada–Kawai
+// layouts.
+type LayoutStats struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Iterations  int64                  `protobuf:"varint,1,opt,name=iterations"`
+	xxx_hidden_Energy      float64                `protobuf:"fixed64,2,opt,name=energy"`
+	xxx_hidden_Converged   bool                   `protobuf:"varint,3,opt,name=converged"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LayoutStats) Reset() {
+	*x = LayoutStats{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LayoutStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutStats) ProtoMessage() {}
+
+func (x *LayoutStats) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *LayoutStats) GetIterations() int64 {
+	if x != nil {
+		return x.xxx_hidden_Iterations
+	}
+	return 0
+}
+
+func (x *LayoutStats) GetEnergy() float64 {
+	if x != nil {
+		return x.xxx_hidden_Energy
+	}
+	return 0
+}
+
+func (x *LayoutStats) GetConverged() bool {
+	if x != nil {
+		return x.xxx_hidden_Converged
+	}
+	return false
+}
+
+func (x *LayoutStats) SetIterations(v int64) {
+	x.xxx_hidden_Iterations = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *LayoutStats) SetEnergy(v float64) {
+	x.xxx_hidden_Energy = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *LayoutStats) SetConverged(v bool) {
+	x.xxx_hidden_Converged = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *LayoutStats) HasIterations() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *LayoutStats) HasEnergy() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *LayoutStats) HasConverged() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *LayoutStats) ClearIterations() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Iterations = 0
+}
+
+func (x *LayoutStats) ClearEnergy() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Energy = 0
+}
+
+func (x *LayoutStats) ClearConverged() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Converged = false
+}
+
+type LayoutStats_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// iterations is the number of iterations that were run.
+	Iterations *int64
+	// energy is the sum of the squared forces on the nodes in the last iteration, or the stress for the
+	// Kamada–Kawai layout.
+	Energy *float64
+	// converged is true when the layout stopped early because the nodes settled.
+	Converged *bool
+}
+
+func (b0 LayoutStats_builder) Build() *LayoutStats {
+	m0 := &LayoutStats{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Iterations != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Iterations = *b.Iterations
+	}
+	if b.Energy != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Energy = *b.Energy
+	}
+	if b.Converged != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Converged = *b.Converged
+	}
+	return m0
+}
+
+type RandomGraphResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Nodes        *[]*Node               `protobuf:"bytes,1,rep,name=nodes"`
+	xxx_hidden_Edges        *[]*Edge               `protobuf:"bytes,2,rep,name=edges"`
+	xxx_hidden_Walks        *[]*Walk               `protobuf:"bytes,3,rep,name=walks"`
+	xxx_hidden_Intersection *WalkIntersection      `protobuf:"bytes,4,opt,name=intersection"`
+	xxx_hidden_Layout       *LayoutStats           `protobuf:"bytes,5,opt,name=layout"`
+	xxx_hidden_Directed     bool                   `protobuf:"varint,6,opt,name=directed"`
+	xxx_hidden_Stats        *GraphStats            `protobuf:"bytes,7,opt,name=stats"`
+	xxx_hidden_Manifest     *GraphManifest         `protobuf:"bytes,8,opt,name=manifest"`
+	xxx_hidden_BobNodeId    *string                `protobuf:"bytes,9,opt,name=bob_node_id,json=bobNodeId"`
+	xxx_hidden_AdaNodeId    *string                `protobuf:"bytes,10,opt,name=ada_node_id,json=adaNodeId"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RandomGraphResponse) Reset() {
+	*x = RandomGraphResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomGraphResponse) ProtoMessage() {}
+
+func (x *RandomGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RandomGraphResponse) GetNodes() []*Node {
+	if x != nil {
+		if x.xxx_hidden_Nodes != nil {
+			return *x.xxx_hidden_Nodes
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphResponse) GetEdges() []*Edge {
+	if x != nil {
+		if x.xxx_hidden_Edges != nil {
+			return *x.xxx_hidden_Edges
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphResponse) GetWalks() []*Walk {
+	if x != nil {
+		if x.xxx_hidden_Walks != nil {
+			return *x.xxx_hidden_Walks
+		}
+	}
+	return nil
+}
+
+func (x *RandomGraphResponse) GetIntersection() *WalkIntersection {
+	if x != nil {
+		return x.xxx_hidden_Intersection
+	}
+	return nil
+}
+
+func (x *RandomGraphResponse) GetLayout() *LayoutStats {
+	if x != nil {
+		return x.xxx_hidden_Layout
+	}
+	return nil
+}
+
+func (x *RandomGraphResponse) GetDirected() bool {
+	if x != nil {
+		return x.xxx_hidden_Directed
+	}
+	return false
+}
+
+func (x *RandomGraphResponse) GetStats() *GraphStats {
+	if x != nil {
+		return x.xxx_hidden_Stats
+	}
+	return nil
+}
+
+func (x *RandomGraphResponse) GetManifest() *GraphManifest {
+	if x != nil {
+		return x.xxx_hidden_Manifest
+	}
+	return nil
+}
+
+func (x *RandomGraphResponse) GetBobNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_BobNodeId != nil {
+			return *x.xxx_hidden_BobNodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RandomGraphResponse) GetAdaNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_AdaNodeId != nil {
+			return *x.xxx_hidden_AdaNodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RandomGraphResponse) SetNodes(v []*Node) {
+	x.xxx_hidden_Nodes = &v
+}
+
+func (x *RandomGraphResponse) SetEdges(v []*Edge) {
+	x.xxx_hidden_Edges = &v
+}
+
+func (x *RandomGraphResponse) SetWalks(v []*Walk) {
+	x.xxx_hidden_Walks = &v
+}
+
+func (x *RandomGraphResponse) SetIntersection(v *WalkIntersection) {
+	x.xxx_hidden_Intersection = v
+}
+
+func (x *RandomGraphResponse) SetLayout(v *LayoutStats) {
+	x.xxx_hidden_Layout = v
+}
+
+func (x *RandomGraphResponse) SetDirected(v bool) {
+	x.xxx_hidden_Directed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 10)
+}
+
+func (x *RandomGraphResponse) SetStats(v *GraphStats) {
+	x.xxx_hidden_Stats = v
+}
+
+func (x *RandomGraphResponse) SetManifest(v *GraphManifest) {
+	x.xxx_hidden_Manifest = v
+}
+
+func (x *RandomGraphResponse) SetBobNodeId(v string) {
+	x.xxx_hidden_BobNodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 10)
+}
+
+func (x *RandomGraphResponse) SetAdaNodeId(v string) {
+	x.xxx_hidden_AdaNodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 10)
+}
+
+func (x *RandomGraphResponse) HasIntersection() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Intersection != nil
+}
+
+func (x *RandomGraphResponse) HasLayout() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Layout != nil
+}
+
+func (x *RandomGraphResponse) HasDirected() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *RandomGraphResponse) HasStats() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Stats != nil
+}
+
+func (x *RandomGraphResponse) HasManifest() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Manifest != nil
+}
+
+func (x *RandomGraphResponse) HasBobNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *RandomGraphResponse) HasAdaNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *RandomGraphResponse) ClearIntersection() {
+	x.xxx_hidden_Intersection = nil
+}
+
+func (x *RandomGraphResponse) ClearLayout() {
+	x.xxx_hidden_Layout = nil
+}
+
+func (x *RandomGraphResponse) ClearDirected() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Directed = false
+}
+
+func (x *RandomGraphResponse) ClearStats() {
+	x.xxx_hidden_Stats = nil
+}
+
+func (x *RandomGraphResponse) ClearManifest() {
+	x.xxx_hidden_Manifest = nil
+}
+
+func (x *RandomGraphResponse) ClearBobNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_BobNodeId = nil
+}
+
+func (x *RandomGraphResponse) ClearAdaNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_AdaNodeId = nil
+}
+
+type RandomGraphResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Nodes        []*Node
+	Edges        []*Edge
+	Walks        []*Walk
+	Intersection *WalkIntersection
+	Layout       *LayoutStats
+	// directed is set when the edges point from their source to their target.
+	Directed *bool
+	// stats describes the structure of the graph, if requested.
+	Stats *GraphStats
+	// manifest records how the graph was generated, so Replay can generate it again. Imported graphs have
+	// none.
+	Manifest *GraphManifest
+	// bob_node_id and ada_node_id identify the parties. Their nodes keep the bobNode and adaNode types,
+	// but the types are for display only.
+	BobNodeId *string
+	AdaNodeId *string
+}
+
+func (b0 RandomGraphResponse_builder) Build() *RandomGraphResponse {
+	m0 := &RandomGraphResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Nodes = &b.Nodes
+	x.xxx_hidden_Edges = &b.Edges
+	x.xxx_hidden_Walks = &b.Walks
+	x.xxx_hidden_Intersection = b.Intersection
+	x.xxx_hidden_Layout = b.Layout
+	if b.Directed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 10)
+		x.xxx_hidden_Directed = *b.Directed
+	}
+	x.xxx_hidden_Stats = b.Stats
+	x.xxx_hidden_Manifest = b.Manifest
+	if b.BobNodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 10)
+		x.xxx_hidden_BobNodeId = b.BobNodeId
+	}
+	if b.AdaNodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 10)
+		x.xxx_hidden_AdaNodeId = b.AdaNodeId
+	}
+	return m0
+}
+
+// StageSeed is the seed of the PCG source of a stage of generating a graph.
+type StageSeed struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Stage       *string                `protobuf:"bytes,1,opt,name=stage"`
+	xxx_hidden_Seed1       uint64                 `protobuf:"varint,2,opt,name=seed1"`
+	xxx_hidden_Seed2       uint64                 `protobuf:"varint,3,opt,name=seed2"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *StageSeed) Reset() {
+	*x = StageSeed{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageSeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageSeed) ProtoMessage() {}
+
+func (x *StageSeed) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StageSeed) GetStage() string {
+	if x != nil {
+		if x.xxx_hidden_Stage != nil {
+			return *x.xxx_hidden_Stage
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *StageSeed) GetSeed1() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seed1
+	}
+	return 0
+}
+
+func (x *StageSeed) GetSeed2() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seed2
+	}
+	return 0
+}
+
+func (x *StageSeed) SetStage(v string) {
+	x.xxx_hidden_Stage = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *StageSeed) SetSeed1(v uint64) {
+	x.xxx_hidden_Seed1 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *StageSeed) SetSeed2(v uint64) {
+	x.xxx_hidden_Seed2 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *StageSeed) HasStage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *StageSeed) HasSeed1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *StageSeed) HasSeed2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *StageSeed) ClearStage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Stage = nil
+}
+
+func (x *StageSeed) ClearSeed1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Seed1 = 0
+}
+
+func (x *StageSeed) ClearSeed2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Seed2 = 0
+}
+
+type StageSeed_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// stage is "graph" for generating the graph up to and including its layout, "relayout" for laying it
+	// out again and "walks" for walking it.
+	Stage *string
+	Seed1 *uint64
+	Seed2 *uint64
+}
+
+func (b0 StageSeed_builder) Build() *StageSeed {
+	m0 := &StageSeed{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Stage != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Stage = b.Stage
+	}
+	if b.Seed1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Seed1 = *b.Seed1
+	}
+	if b.Seed2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Seed2 = *b.Seed2
+	}
+	return m0
+}
+
+// AlgorithmVersion is the version of the algorithms of a stage. It changes whenever the algorithms
+// produce a different graph for the same parameters and seeds.
+type AlgorithmVersion struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,2,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AlgorithmVersion) Reset() {
+	*x = AlgorithmVersion{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgorithmVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgorithmVersion) ProtoMessage() {}
+
+func (x *AlgorithmVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AlgorithmVersion) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlgorithmVersion) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *AlgorithmVersion) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *AlgorithmVersion) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *AlgorithmVersion) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AlgorithmVersion) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AlgorithmVersion) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *AlgorithmVersion) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+type AlgorithmVersion_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name    *string
+	Version *int64
+}
+
+func (b0 AlgorithmVersion_builder) Build() *AlgorithmVersion {
+	m0 := &AlgorithmVersion{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+// GraphManifest records everything that determines a generated graph. The stages that ran are listed
+// in seeds, their seeds take precedence over the seeds of the requests.
+//
+// A request with a graph_id refers to a stored graph rather than embedding it, so the manifest of such
+// a graph can only be replayed by the server that stores that graph, and only while it does. Replays
+// fail with a not found error otherwise.
+type GraphManifest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Request       *RandomGraphRequest    `protobuf:"bytes,1,opt,name=request"`
+	xxx_hidden_Relayout      *RandomGraphRequest    `protobuf:"bytes,2,opt,name=relayout"`
+	xxx_hidden_Seeds         *[]*StageSeed          `protobuf:"bytes,3,rep,name=seeds"`
+	xxx_hidden_Algorithms    *[]*AlgorithmVersion   `protobuf:"bytes,4,rep,name=algorithms"`
+	xxx_hidden_TrustdVersion *string                `protobuf:"bytes,5,opt,name=trustd_version,json=trustdVersion"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GraphManifest) Reset() {
+	*x = GraphManifest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphManifest) ProtoMessage() {}
+
+func (x *GraphManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GraphManifest) GetRequest() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_Request
+	}
+	return nil
+}
+
+func (x *GraphManifest) GetRelayout() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_Relayout
+	}
+	return nil
+}
+
+func (x *GraphManifest) GetSeeds() []*StageSeed {
+	if x != nil {
+		if x.xxx_hidden_Seeds != nil {
+			return *x.xxx_hidden_Seeds
+		}
+	}
+	return nil
+}
+
+func (x *GraphManifest) GetAlgorithms() []*AlgorithmVersion {
+	if x != nil {
+		if x.xxx_hidden_Algorithms != nil {
+			return *x.xxx_hidden_Algorithms
+		}
+	}
+	return nil
+}
+
+func (x *GraphManifest) GetTrustdVersion() string {
+	if x != nil {
+		if x.xxx_hidden_TrustdVersion != nil {
+			return *x.xxx_hidden_TrustdVersion
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GraphManifest) SetRequest(v *RandomGraphRequest) {
+	x.xxx_hidden_Request = v
+}
+
+func (x *GraphManifest) SetRelayout(v *RandomGraphRequest) {
+	x.xxx_hidden_Relayout = v
+}
+
+func (x *GraphManifest) SetSeeds(v []*StageSeed) {
+	x.xxx_hidden_Seeds = &v
+}
+
+func (x *GraphManifest) SetAlgorithms(v []*AlgorithmVersion) {
+	x.xxx_hidden_Algorithms = &v
+}
+
+func (x *GraphManifest) SetTrustdVersion(v string) {
+	x.xxx_hidden_TrustdVersion = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *GraphManifest) HasRequest() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Request != nil
+}
+
+func (x *GraphManifest) HasRelayout() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Relayout != nil
+}
+
+func (x *GraphManifest) HasTrustdVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *GraphManifest) ClearRequest() {
+	x.xxx_hidden_Request = nil
+}
+
+func (x *GraphManifest) ClearRelayout() {
+	x.xxx_hidden_Relayout = nil
+}
+
+func (x *GraphManifest) ClearTrustdVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_TrustdVersion = nil
+}
+
+type GraphManifest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// request holds all parameters of the graph, its layout and its walks.
+	Request *RandomGraphRequest
+	// relayout holds the layout parameters of the last Relayout or StreamLayout of a stored graph.
+	Relayout   *RandomGraphRequest
+	Seeds      []*StageSeed
+	Algorithms []*AlgorithmVersion
+	// trustd_version is the build version of the server that generated the graph.
+	TrustdVersion *string
+}
+
+func (b0 GraphManifest_builder) Build() *GraphManifest {
+	m0 := &GraphManifest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Request = b.Request
+	x.xxx_hidden_Relayout = b.Relayout
+	x.xxx_hidden_Seeds = &b.Seeds
+	x.xxx_hidden_Algorithms = &b.Algorithms
+	if b.TrustdVersion != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_TrustdVersion = b.TrustdVersion
+	}
+	return m0
+}
+
+// ReplayRequest generates the graph of a manifest again. Manifests of other algorithm versions are
+// refused with a failed precondition error, as they wouldn't produce the same graph.
+type ReplayRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Manifest *GraphManifest         `protobuf:"bytes,1,opt,name=manifest"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReplayRequest) Reset() {
+	*x = ReplayRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRequest) ProtoMessage() {}
+
+func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReplayRequest) GetManifest() *GraphManifest {
+	if x != nil {
+		return x.xxx_hidden_Manifest
+	}
+	return nil
+}
+
+func (x *ReplayRequest) SetManifest(v *GraphManifest) {
+	x.xxx_hidden_Manifest = v
+}
+
+func (x *ReplayRequest) HasManifest() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Manifest != nil
+}
+
+func (x *ReplayRequest) ClearManifest() {
+	x.xxx_hidden_Manifest = nil
+}
+
+type ReplayRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Manifest *GraphManifest
+}
+
+func (b0 ReplayRequest_builder) Build() *ReplayRequest {
+	m0 := &ReplayRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Manifest = b.Manifest
+	return m0
+}
+
+// GraphStats describes the structure of a graph, measured as a simple undirected graph. Statistics that
+// are undefined for the graph are 0.
+type GraphStats struct {
+	state                           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NumNodes             int64                  `protobuf:"varint,1,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_NumEdges             int64                  `protobuf:"varint,2,opt,name=num_edges,json=numEdges"`
+	xxx_hidden_MinDegree            int64                  `protobuf:"varint,3,opt,name=min_degree,json=minDegree"`
+	xxx_hidden_MaxDegree            int64                  `protobuf:"varint,4,opt,name=max_degree,json=maxDegree"`
+	xxx_hidden_MeanDegree           float64                `protobuf:"fixed64,5,opt,name=mean_degree,json=meanDegree"`
+	xxx_hidden_DegreeHistogram      []int64                `protobuf:"varint,6,rep,packed,name=degree_histogram,json=degreeHistogram"`
+	xxx_hidden_AverageClustering    float64                `protobuf:"fixed64,7,opt,name=average_clustering,json=averageClustering"`
+	xxx_hidden_AveragePathLength    float64                `protobuf:"fixed64,8,opt,name=average_path_length,json=averagePathLength"`
+	xxx_hidden_Diameter             int64                  `protobuf:"varint,9,opt,name=diameter"`
+	xxx_hidden_NumComponents        int64                  `protobuf:"varint,10,opt,name=num_components,json=numComponents"`
+	xxx_hidden_LargestComponentSize int64                  `protobuf:"varint,11,opt,name=largest_component_size,json=largestComponentSize"`
+	xxx_hidden_Assortativity        float64                `protobuf:"fixed64,12,opt,name=assortativity"`
+	xxx_hidden_Sigma                float64                `protobuf:"fixed64,13,opt,name=sigma"`
+	xxx_hidden_Omega                float64                `protobuf:"fixed64,14,opt,name=omega"`
+	XXX_raceDetectHookData          protoimpl.RaceDetectHookData
+	XXX_presence                    [1]uint32
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *GraphStats) Reset() {
+	*x = GraphStats{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphStats) ProtoMessage() {}
+
+func (x *GraphStats) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GraphStats) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *GraphStats) GetNumEdges() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumEdges
+	}
+	return 0
+}
+
+func (x *GraphStats) GetMinDegree() int64 {
+	if x != nil {
+		return x.xxx_hidden_MinDegree
+	}
+	return 0
+}
+
+func (x *GraphStats) GetMaxDegree() int64 {
+	if x != nil {
+		return x.xxx_hidden_MaxDegree
+	}
+	return 0
+}
+
+func (x *GraphStats) GetMeanDegree() float64 {
+	if x != nil {
+		return x.xxx_hidden_MeanDegree
+	}
+	return 0
+}
+
+func (x *GraphStats) GetDegreeHistogram() []int64 {
+	if x != nil {
+		return x.xxx_hidden_DegreeHistogram
+	}
+	return nil
+}
+
+func (x *GraphStats) GetAverageClustering() float64 {
+	if x != nil {
+		return x.xxx_hidden_AverageClustering
+	}
+	return 0
+}
+
+func (x *GraphStats) GetAveragePathLength() float64 {
+	if x != nil {
+		return x.xxx_hidden_AveragePathLength
+	}
+	return 0
+}
+
+func (x *GraphStats) GetDiameter() int64 {
+	if x != nil {
+		return x.xxx_hidden_Diameter
+	}
+	return 0
+}
+
+func (x *GraphStats) GetNumComponents() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumComponents
+	}
+	return 0
+}
+
+func (x *GraphStats) GetLargestComponentSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_LargestComponentSize
+	}
+	return 0
+}
+
+func (x *GraphStats) GetAssortativity() float64 {
+	if x != nil {
+		return x.xxx_hidden_Assortativity
+	}
+	return 0
+}
+
+func (x *GraphStats) GetSigma() float64 {
+	if x != nil {
+		return x.xxx_hidden_Sigma
+	}
+	return 0
+}
+
+func (x *GraphStats) GetOmega() float64 {
+	if x != nil {
+		return x.xxx_hidden_Omega
+	}
+	return 0
+}
+
+func (x *GraphStats) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 14)
+}
+
+func (x *GraphStats) SetNumEdges(v int64) {
+	x.xxx_hidden_NumEdges = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 14)
+}
+
+func (x *GraphStats) SetMinDegree(v int64) {
+	x.xxx_hidden_MinDegree = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 14)
+}
+
+func (x *GraphStats) SetMaxDegree(v int64) {
+	x.xxx_hidden_MaxDegree = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 14)
+}
+
+func (x *GraphStats) SetMeanDegree(v float64) {
+	x.xxx_hidden_MeanDegree = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 14)
+}
+
+func (x *GraphStats) SetDegreeHistogram(v []int64) {
+	x.xxx_hidden_DegreeHistogram = v
+}
+
+func (x *GraphStats) SetAverageClustering(v float64) {
+	x.xxx_hidden_AverageClustering = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 14)
+}
+
+func (x *GraphStats) SetAveragePathLength(v float64) {
+	x.xxx_hidden_AveragePathLength = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 14)
+}
+
+func (x *GraphStats) SetDiameter(v int64) {
+	x.xxx_hidden_Diameter = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 14)
+}
+
+func (x *GraphStats) SetNumComponents(v int64) {
+	x.xxx_hidden_NumComponents = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 14)
+}
+
+func (x *GraphStats) SetLargestComponentSize(v int64) {
+	x.xxx_hidden_LargestComponentSize = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 14)
+}
+
+func (x *GraphStats) SetAssortativity(v float64) {
+	x.xxx_hidden_Assortativity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 14)
+}
+
+func (x *GraphStats) SetSigma(v float64) {
+	x.xxx_hidden_Sigma = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 14)
+}
+
+func (x *GraphStats) SetOmega(v float64) {
+	x.xxx_hidden_Omega = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 14)
+}
+
+func (x *GraphStats) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GraphStats) HasNumEdges() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GraphStats) HasMinDegree() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GraphStats) HasMaxDegree() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GraphStats) HasMeanDegree() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *GraphStats) HasAverageClustering() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *GraphStats) HasAveragePathLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *GraphStats) HasDiameter() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *GraphStats) HasNumComponents() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *GraphStats) HasLargestComponentSize() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *GraphStats) HasAssortativity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *GraphStats) HasSigma() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *GraphStats) HasOmega() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *GraphStats) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *GraphStats) ClearNumEdges() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NumEdges = 0
+}
+
+func (x *GraphStats) ClearMinDegree() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_MinDegree = 0
+}
+
+func (x *GraphStats) ClearMaxDegree() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MaxDegree = 0
+}
+
+func (x *GraphStats) ClearMeanDegree() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_MeanDegree = 0
+}
+
+func (x *GraphStats) ClearAverageClustering() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_AverageClustering = 0
+}
+
+func (x *GraphStats) ClearAveragePathLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_AveragePathLength = 0
+}
+
+func (x *GraphStats) ClearDiameter() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Diameter = 0
+}
+
+func (x *GraphStats) ClearNumComponents() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_NumComponents = 0
+}
+
+func (x *GraphStats) ClearLargestComponentSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_LargestComponentSize = 0
+}
+
+func (x *GraphStats) ClearAssortativity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_Assortativity = 0
+}
+
+func (x *GraphStats) ClearSigma() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_Sigma = 0
+}
+
+func (x *GraphStats) ClearOmega() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_Omega = 0
+}
+
+type GraphStats_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NumNodes   *int64
+	NumEdges   *int64
+	MinDegree  *int64
+	MaxDegree  *int64
+	MeanDegree *float64
+	// degree_histogram holds the number of nodes of every degree, indexed by degree.
+	DegreeHistogram []int64
+	// average_clustering is the mean local clustering coefficient, nodes with fewer than two neighbors count
+	// as 0.
+	AverageClustering *float64
+	// average_path_length and diameter are the mean and longest hop count between nodes of the largest
+	// component.
+	AveragePathLength    *float64
+	Diameter             *int64
+	NumComponents        *int64
+	LargestComponentSize *int64
+	// assortativity is the correlation between the degrees at either end of the edges.
+	Assortativity *float64
+	// sigma and omega are the small-world coefficients, relative to the analytic clustering and path length
+	// of a random graph and a ring lattice with the same mean degree. Sigma above 1 and omega near 0
+	// indicate a small world, omega near -1 a lattice and near 1 a random graph.
+	Sigma *float64
+	Omega *float64
+}
+
+func (b0 GraphStats_builder) Build() *GraphStats {
+	m0 := &GraphStats{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 14)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.NumEdges != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 14)
+		x.xxx_hidden_NumEdges = *b.NumEdges
+	}
+	if b.MinDegree != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 14)
+		x.xxx_hidden_MinDegree = *b.MinDegree
+	}
+	if b.MaxDegree != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 14)
+		x.xxx_hidden_MaxDegree = *b.MaxDegree
+	}
+	if b.MeanDegree != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 14)
+		x.xxx_hidden_MeanDegree = *b.MeanDegree
+	}
+	x.xxx_hidden_DegreeHistogram = b.DegreeHistogram
+	if b.AverageClustering != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 14)
+		x.xxx_hidden_AverageClustering = *b.AverageClustering
+	}
+	if b.AveragePathLength != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 14)
+		x.xxx_hidden_AveragePathLength = *b.AveragePathLength
+	}
+	if b.Diameter != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 14)
+		x.xxx_hidden_Diameter = *b.Diameter
+	}
+	if b.NumComponents != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 14)
+		x.xxx_hidden_NumComponents = *b.NumComponents
+	}
+	if b.LargestComponentSize != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 14)
+		x.xxx_hidden_LargestComponentSize = *b.LargestComponentSize
+	}
+	if b.Assortativity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 14)
+		x.xxx_hidden_Assortativity = *b.Assortativity
+	}
+	if b.Sigma != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 14)
+		x.xxx_hidden_Sigma = *b.Sigma
+	}
+	if b.Omega != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 14)
+		x.xxx_hidden_Omega = *b.Omega
+	}
+	return m0
+}
+
+// GraphSource refers to a graph, either one that is stored or one that is generated on the fly.
+type GraphSource struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Source isGraphSource_Source   `protobuf_oneof:"source"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GraphSource) Reset() {
+	*x = GraphSource{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphSource) ProtoMessage() {}
+
+func (x *GraphSource) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GraphSource) GetGraphId() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Source.(*graphSource_GraphId); ok {
+			return x.GraphId
+		}
+	}
+	return ""
+}
+
+func (x *GraphSource) GetGenerate() *RandomGraphRequest {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Source.(*graphSource_Generate); ok {
+			return x.Generate
+		}
+	}
+	return nil
+}
+
+func (x *GraphSource) SetGraphId(v string) {
+	x.xxx_hidden_Source = &graphSource_GraphId{v}
+}
+
+func (x *GraphSource) SetGenerate(v *RandomGraphRequest) {
+	if v == nil {
+		x.xxx_hidden_Source = nil
+		return
+	}
+	x.xxx_hidden_Source = &graphSource_Generate{v}
+}
+
+func (x *GraphSource) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Source != nil
+}
+
+func (x *GraphSource) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Source.(*graphSource_GraphId)
+	return ok
+}
+
+func (x *GraphSource) HasGenerate() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Source.(*graphSource_Generate)
+	return ok
+}
+
+func (x *GraphSource) ClearSource() {
+	x.xxx_hidden_Source = nil
+}
+
+func (x *GraphSource) ClearGraphId() {
+	if _, ok := x.xxx_hidden_Source.(*graphSource_GraphId); ok {
+		x.xxx_hidden_Source = nil
+	}
+}
+
+func (x *GraphSource) ClearGenerate() {
+	if _, ok := x.xxx_hidden_Source.(*graphSource_Generate); ok {
+		x.xxx_hidden_Source = nil
+	}
+}
+
+const GraphSource_Source_not_set_case case_GraphSource_Source = 0
+const GraphSource_GraphId_case case_GraphSource_Source = 1
+const GraphSource_Generate_case case_GraphSource_Source = 2
+
+func (x *GraphSource) WhichSource() case_GraphSource_Source {
+	if x == nil {
+		return GraphSource_Source_not_set_case
+	}
+	switch x.xxx_hidden_Source.(type) {
+	case *graphSource_GraphId:
+		return GraphSource_GraphId_case
+	case *graphSource_Generate:
+		return GraphSource_Generate_case
+	default:
+		return GraphSource_Source_not_set_case
+	}
+}
+
+type GraphSource_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Source:
+	GraphId  *string
+	Generate *RandomGraphRequest
+	// -- end of xxx_hidden_Source
+}
+
+func (b0 GraphSource_builder) Build() *GraphSource {
+	m0 := &GraphSource{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		x.xxx_hidden_Source = &graphSource_GraphId{*b.GraphId}
+	}
+	if b.Generate != nil {
+		x.xxx_hidden_Source = &graphSource_Generate{b.Generate}
+	}
+	return m0
+}
+
+type case_GraphSource_Source protoreflect.FieldNumber
+
+func (x case_GraphSource_Source) String() string {
+	md := file_internal_rpc_v1_rpc_proto_msgTypes[27].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isGraphSource_Source interface {
+	isGraphSource_Source()
+}
+
+type graphSource_GraphId struct {
+	GraphId string `protobuf:"bytes,1,opt,name=graph_id,json=graphId,oneof"`
+}
+
+type graphSource_Generate struct {
+	Generate *RandomGraphRequest `protobuf:"bytes,2,opt,name=generate,oneof"`
+}
+
+func (*graphSource_GraphId) isGraphSource_Source() {}
+
+func (*graphSource_Generate) isGraphSource_Source() {}
+
+// LoadGraphRequest imports a graph file. The server caps the size of the file and the number of nodes of
+// the graph.
+type LoadGraphRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FileName    *string                `protobuf:"bytes,1,opt,name=file_name,json=fileName"`
+	xxx_hidden_Format      GraphFormat            `protobuf:"varint,2,opt,name=format,enum=internal.rpc.v1.GraphFormat"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LoadGraphRequest) Reset() {
+	*x = LoadGraphRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadGraphRequest) ProtoMessage() {}
+
+func (x *LoadGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *LoadGraphRequest) GetFileName() string {
+	if x != nil {
+		if x.xxx_hidden_FileName != nil {
+			return *x.xxx_hidden_FileName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *LoadGraphRequest) GetFormat() GraphFormat {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Format
+		}
+	}
+	return GraphFormat_GRAPH_FORMAT_UNSPECIFIED
+}
+
+func (x *LoadGraphRequest) SetFileName(v string) {
+	x.xxx_hidden_FileName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *LoadGraphRequest) SetFormat(v GraphFormat) {
+	x.xxx_hidden_Format = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *LoadGraphRequest) HasFileName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *LoadGraphRequest) HasFormat() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *LoadGraphRequest) ClearFileName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FileName = nil
+}
+
+func (x *LoadGraphRequest) ClearFormat() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Format = GraphFormat_GRAPH_FORMAT_UNSPECIFIED
+}
+
+type LoadGraphRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// file_name is the path of the file, relative to the configured graph directory.
+	FileName *string
+	// format of the file, determined from the file extension when unspecified.
+	Format *GraphFormat
+}
+
+func (b0 LoadGraphRequest_builder) Build() *LoadGraphRequest {
+	m0 := &LoadGraphRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FileName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_FileName = b.FileName
+	}
+	if b.Format != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Format = *b.Format
+	}
+	return m0
+}
+
+type LoadGraphResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GraphId     *string                `protobuf:"bytes,1,opt,name=graph_id,json=graphId"`
+	xxx_hidden_NumNodes    int64                  `protobuf:"varint,2,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_NumEdges    int64                  `protobuf:"varint,3,opt,name=num_edges,json=numEdges"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LoadGraphResponse) Reset() {
+	*x = LoadGraphResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadGraphResponse) ProtoMessage() {}
+
+func (x *LoadGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *LoadGraphResponse) GetGraphId() string {
+	if x != nil {
+		if x.xxx_hidden_GraphId != nil {
+			return *x.xxx_hidden_GraphId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *LoadGraphResponse) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *LoadGraphResponse) GetNumEdges() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumEdges
+	}
+	return 0
+}
+
+func (x *LoadGraphResponse) SetGraphId(v string) {
+	x.xxx_hidden_GraphId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *LoadGraphResponse) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *LoadGraphResponse) SetNumEdges(v int64) {
+	x.xxx_hidden_NumEdges = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *LoadGraphResponse) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *LoadGraphResponse) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *LoadGraphResponse) HasNumEdges() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *LoadGraphResponse) ClearGraphId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GraphId = nil
+}
+
+func (x *LoadGraphResponse) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *LoadGraphResponse) ClearNumEdges() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_NumEdges = 0
+}
+
+type LoadGraphResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// graph_id refers to the loaded graph in later calls.
+	GraphId  *string
+	NumNodes *int64
+	NumEdges *int64
+}
+
+func (b0 LoadGraphResponse_builder) Build() *LoadGraphResponse {
+	m0 := &LoadGraphResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_GraphId = b.GraphId
+	}
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.NumEdges != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_NumEdges = *b.NumEdges
+	}
+	return m0
+}
+
+type ExportGraphRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Source      *GraphSource           `protobuf:"bytes,1,opt,name=source"`
+	xxx_hidden_Format      GraphFormat            `protobuf:"varint,2,opt,name=format,enum=internal.rpc.v1.GraphFormat"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ExportGraphRequest) Reset() {
+	*x = ExportGraphRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGraphRequest) ProtoMessage() {}
+
+func (x *ExportGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExportGraphRequest) GetSource() *GraphSource {
+	if x != nil {
+		return x.xxx_hidden_Source
+	}
+	return nil
+}
+
+func (x *ExportGraphRequest) GetFormat() GraphFormat {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Format
+		}
+	}
+	return GraphFormat_GRAPH_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportGraphRequest) SetSource(v *GraphSource) {
+	x.xxx_hidden_Source = v
+}
+
+func (x *ExportGraphRequest) SetFormat(v GraphFormat) {
+	x.xxx_hidden_Format = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ExportGraphRequest) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Source != nil
+}
+
+func (x *ExportGraphRequest) HasFormat() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ExportGraphRequest) ClearSource() {
+	x.xxx_hidden_Source = nil
+}
+
+func (x *ExportGraphRequest) ClearFormat() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Format = GraphFormat_GRAPH_FORMAT_UNSPECIFIED
+}
+
+type ExportGraphRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Source *GraphSource
+	Format *GraphFormat
+}
+
+func (b0 ExportGraphRequest_builder) Build() *ExportGraphRequest {
+	m0 := &ExportGraphRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Source = b.Source
+	if b.Format != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Format = *b.Format
+	}
+	return m0
+}
+
+type ExportGraphResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Content     []byte                 `protobuf:"bytes,1,opt,name=content"`
+	xxx_hidden_ContentType *string                `protobuf:"bytes,2,opt,name=content_type,json=contentType"`
+	xxx_hidden_FileName    *string                `protobuf:"bytes,3,opt,name=file_name,json=fileName"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ExportGraphResponse) Reset() {
+	*x = ExportGraphResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGraphResponse) ProtoMessage() {}
+
+func (x *ExportGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExportGraphResponse) GetContent() []byte {
+	if x != nil {
+		return x.xxx_hidden_Content
+	}
+	return nil
+}
+
+func (x *ExportGraphResponse) GetContentType() string {
+	if x != nil {
+		if x.xxx_hidden_ContentType != nil {
+			return *x.xxx_hidden_ContentType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ExportGraphResponse) GetFileName() string {
+	if x != nil {
+		if x.xxx_hidden_FileName != nil {
+			return *x.xxx_hidden_FileName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ExportGraphResponse) SetContent(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Content = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ExportGraphResponse) SetContentType(v string) {
+	x.xxx_hidden_ContentType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *ExportGraphResponse) SetFileName(v string) {
+	x.xxx_hidden_FileName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ExportGraphResponse) HasContent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ExportGraphResponse) HasContentType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ExportGraphResponse) HasFileName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ExportGraphResponse) ClearContent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Content = nil
+}
+
+func (x *ExportGraphResponse) ClearContentType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContentType = nil
+}
+
+func (x *ExportGraphResponse) ClearFileName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_FileName = nil
+}
+
+type ExportGraphResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Content     []byte
+	ContentType *string
+	// file_name is a suggested name for the file.
+	FileName *string
+}
+
+func (b0 ExportGraphResponse_builder) Build() *ExportGraphResponse {
+	m0 := &ExportGraphResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Content != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Content = b.Content
+	}
+	if b.ContentType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_ContentType = b.ContentType
+	}
+	if b.FileName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_FileName = b.FileName
+	}
+	return m0
+}
+
+// PageRankParams configures personalized PageRank, where the random surfer always teleports back to the
+// source node. Nodes without outgoing edges teleport back to the source as well.
+type PageRankParams struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Damping       float64                `protobuf:"fixed64,1,opt,name=damping"`
+	xxx_hidden_Method        PageRankMethod         `protobuf:"varint,2,opt,name=method,enum=internal.rpc.v1.PageRankMethod"`
+	xxx_hidden_MaxIterations int64                  `protobuf:"varint,3,opt,name=max_iterations,json=maxIterations"`
+	xxx_hidden_Tolerance     float64                `protobuf:"fixed64,4,opt,name=tolerance"`
+	xxx_hidden_NumWalks      int64                  `protobuf:"varint,5,opt,name=num_walks,json=numWalks"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PageRankParams) Reset() {
+	*x = PageRankParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRankParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRankParams) ProtoMessage() {}
+
+func (x *PageRankParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PageRankParams) GetDamping() float64 {
+	if x != nil {
+		return x.xxx_hidden_Damping
+	}
+	return 0
+}
+
+func (x *PageRankParams) GetMethod() PageRankMethod {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Method
+		}
+	}
+	return PageRankMethod_PAGE_RANK_METHOD_UNSPECIFIED
+}
+
+func (x *PageRankParams) GetMaxIterations() int64 {
+	if x != nil {
+		return x.xxx_hidden_MaxIterations
+	}
+	return 0
+}
+
+func (x *PageRankParams) GetTolerance() float64 {
+	if x != nil {
+		return x.xxx_hidden_Tolerance
+	}
+	return 0
+}
+
+func (x *PageRankParams) GetNumWalks() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumWalks
+	}
+	return 0
+}
+
+func (x *PageRankParams) SetDamping(v float64) {
+	x.xxx_hidden_Damping = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *PageRankParams) SetMethod(v PageRankMethod) {
+	x.xxx_hidden_Method = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *PageRankParams) SetMaxIterations(v int64) {
+	x.xxx_hidden_MaxIterations = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *PageRankParams) SetTolerance(v float64) {
+	x.xxx_hidden_Tolerance = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *PageRankParams) SetNumWalks(v int64) {
+	x.xxx_hidden_NumWalks = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *PageRankParams) HasDamping() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *PageRankParams) HasMethod() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *PageRankParams) HasMaxIterations() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *PageRankParams) HasTolerance() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *PageRankParams) HasNumWalks() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *PageRankParams) ClearDamping() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Damping = 0
+}
+
+func (x *PageRankParams) ClearMethod() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Method = PageRankMethod_PAGE_RANK_METHOD_UNSPECIFIED
+}
+
+func (x *PageRankParams) ClearMaxIterations() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_MaxIterations = 0
+}
+
+func (x *PageRankParams) ClearTolerance() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Tolerance = 0
+}
+
+func (x *PageRankParams) ClearNumWalks() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_NumWalks = 0
+}
+
+type PageRankParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// damping is the probability that the surfer follows an edge rather than teleporting, defaults to 0.85.
+	Damping *float64
+	Method  *PageRankMethod
+	// max_iterations bounds the power iteration, defaults to 100.
+	MaxIterations *int64
+	// tolerance is the L1 change in scores below which the power iteration stops, defaults to 1e-9.
+	Tolerance *float64
+	// num_walks is the number of Monte Carlo walks, defaults to 10000.
+	NumWalks *int64
+}
+
+func (b0 PageRankParams_builder) Build() *PageRankParams {
+	m0 := &PageRankParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Damping != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Damping = *b.Damping
+	}
+	if b.Method != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Method = *b.Method
+	}
+	if b.MaxIterations != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_MaxIterations = *b.MaxIterations
+	}
+	if b.Tolerance != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Tolerance = *b.Tolerance
+	}
+	if b.NumWalks != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_NumWalks = *b.NumWalks
+	}
+	return m0
+}
+
+// EigenTrustParams configure EigenTrust, a global metric that computes the principal eigenvector of the
+// normalized edge weights, mixed with the pre-trusted distribution.
+type EigenTrustParams struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PreTrustWeight  float64                `protobuf:"fixed64,1,opt,name=pre_trust_weight,json=preTrustWeight"`
+	xxx_hidden_UniformPreTrust bool                   `protobuf:"varint,2,opt,name=uniform_pre_trust,json=uniformPreTrust"`
+	xxx_hidden_MaxIterations   int64                  `protobuf:"varint,3,opt,name=max_iterations,json=maxIterations"`
+	xxx_hidden_Tolerance       float64                `protobuf:"fixed64,4,opt,name=tolerance"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *EigenTrustParams) Reset() {
+	*x = EigenTrustParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EigenTrustParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EigenTrustParams) ProtoMessage() {}
+
+func (x *EigenTrustParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EigenTrustParams) GetPreTrustWeight() float64 {
+	if x != nil {
+		return x.xxx_hidden_PreTrustWeight
+	}
+	return 0
+}
+
+func (x *EigenTrustParams) GetUniformPreTrust() bool {
+	if x != nil {
+		return x.xxx_hidden_UniformPreTrust
+	}
+	return false
+}
+
+func (x *EigenTrustParams) GetMaxIterations() int64 {
+	if x != nil {
+		return x.xxx_hidden_MaxIterations
+	}
+	return 0
+}
+
+func (x *EigenTrustParams) GetTolerance() float64 {
+	if x != nil {
+		return x.xxx_hidden_Tolerance
+	}
+	return 0
+}
+
+func (x *EigenTrustParams) SetPreTrustWeight(v float64) {
+	x.xxx_hidden_PreTrustWeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *EigenTrustParams) SetUniformPreTrust(v bool) {
+	x.xxx_hidden_UniformPreTrust = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *EigenTrustParams) SetMaxIterations(v int64) {
+	x.xxx_hidden_MaxIterations = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *EigenTrustParams) SetTolerance(v float64) {
+	x.xxx_hidden_Tolerance = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *EigenTrustParams) HasPreTrustWeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *EigenTrustParams) HasUniformPreTrust() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *EigenTrustParams) HasMaxIterations() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *EigenTrustParams) HasTolerance() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *EigenTrustParams) ClearPreTrustWeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_PreTrustWeight = 0
+}
+
+func (x *EigenTrustParams) ClearUniformPreTrust() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UniformPreTrust = false
+}
+
+func (x *EigenTrustParams) ClearMaxIterations() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_MaxIterations = 0
+}
+
+func (x *EigenTrustParams) ClearTolerance() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Tolerance = 0
+}
+
+type EigenTrustParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// pre_trust_weight is the weight of the pre-trusted distribution in every iteration, defaults to 0.15.
+	PreTrustWeight *float64
+	// uniform_pre_trust pre-trusts all nodes equally, instead of the source node alone.
+	UniformPreTrust *bool
+	// max_iterations bounds the power iteration, defaults to 100.
+	MaxIterations *int64
+	// tolerance is the L1 change in scores below which the power iteration stops, defaults to 1e-9.
+	Tolerance *float64
+}
+
+func (b0 EigenTrustParams_builder) Build() *EigenTrustParams {
+	m0 := &EigenTrustParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.PreTrustWeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_PreTrustWeight = *b.PreTrustWeight
+	}
+	if b.UniformPreTrust != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_UniformPreTrust = *b.UniformPreTrust
+	}
+	if b.MaxIterations != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_MaxIterations = *b.MaxIterations
+	}
+	if b.Tolerance != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Tolerance = *b.Tolerance
+	}
+	return m0
+}
+
+// TidalTrustParams configure TidalTrust, a local metric that infers trust over the strongest shortest
+// paths from the source, using edge weights as ratings.
+type TidalTrustParams struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TidalTrustParams) Reset() {
+	*x = TidalTrustParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TidalTrustParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TidalTrustParams) ProtoMessage() {}
+
+func (x *TidalTrustParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type TidalTrustParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 TidalTrustParams_builder) Build() *TidalTrustParams {
+	m0 := &TidalTrustParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// AdvogatoParams configure Advogato, a group metric that accepts the nodes reached by a maximum flow
+// from the source, with node capacities decreasing by distance. Accepted nodes score 1, others 0.
+type AdvogatoParams struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Capacities []int64                `protobuf:"varint,1,rep,packed,name=capacities"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AdvogatoParams) Reset() {
+	*x = AdvogatoParams{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvogatoParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvogatoParams) ProtoMessage() {}
+
+func (x *AdvogatoParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdvogatoParams) GetCapacities() []int64 {
+	if x != nil {
+		return x.xxx_hidden_Capacities
+	}
+	return nil
+}
+
+func (x *AdvogatoParams) SetCapacities(v []int64) {
+	x.xxx_hidden_Capacities = v
+}
+
+type AdvogatoParams_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// capacities holds the node capacity at every distance from the source, the last one applies to all
+	// nodes further away. Defaults to 800, 200, 50, 12, 4, 2, 1.
+	Capacities []int64
+}
+
+func (b0 AdvogatoParams_builder) Build() *AdvogatoParams {
+	m0 := &AdvogatoParams{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Capacities = b.Capacities
+	return m0
+}
+
+type ComputeTrustRequest struct {
+	state                  protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Source      *GraphSource                 `protobuf:"bytes,1,opt,name=source"`
+	xxx_hidden_Seed1       uint64                       `protobuf:"varint,2,opt,name=seed1"`
+	xxx_hidden_Seed2       uint64                       `protobuf:"varint,3,opt,name=seed2"`
+	xxx_hidden_Metric      isComputeTrustRequest_Metric `protobuf_oneof:"metric"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ComputeTrustRequest) Reset() {
+	*x = ComputeTrustRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComputeTrustRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeTrustRequest) ProtoMessage() {}
+
+func (x *ComputeTrustRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ComputeTrustRequest) GetSource() *GraphSource {
+	if x != nil {
+		return x.xxx_hidden_Source
+	}
+	return nil
+}
+
+func (x *ComputeTrustRequest) GetSeed1() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seed1
+	}
+	return 0
+}
+
+func (x *ComputeTrustRequest) GetSeed2() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seed2
+	}
+	return 0
+}
+
+func (x *ComputeTrustRequest) GetPersonalizedPageRank() *PageRankParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Metric.(*computeTrustRequest_PersonalizedPageRank); ok {
+			return x.PersonalizedPageRank
+		}
+	}
+	return nil
+}
+
+func (x *ComputeTrustRequest) GetEigenTrust() *EigenTrustParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Metric.(*computeTrustRequest_EigenTrust); ok {
+			return x.EigenTrust
+		}
+	}
+	return nil
+}
+
+func (x *ComputeTrustRequest) GetTidalTrust() *TidalTrustParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Metric.(*computeTrustRequest_TidalTrust); ok {
+			return x.TidalTrust
+		}
+	}
+	return nil
+}
+
+func (x *ComputeTrustRequest) GetAdvogato() *AdvogatoParams {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Metric.(*computeTrustRequest_Advogato); ok {
+			return x.Advogato
+		}
+	}
+	return nil
+}
+
+func (x *ComputeTrustRequest) SetSource(v *GraphSource) {
+	x.xxx_hidden_Source = v
+}
+
+func (x *ComputeTrustRequest) SetSeed1(v uint64) {
+	x.xxx_hidden_Seed1 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *ComputeTrustRequest) SetSeed2(v uint64) {
+	x.xxx_hidden_Seed2 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *ComputeTrustRequest) SetPersonalizedPageRank(v *PageRankParams) {
+	if v == nil {
+		x.xxx_hidden_Metric = nil
+		return
+	}
+	x.xxx_hidden_Metric = &computeTrustRequest_PersonalizedPageRank{v}
+}
+
+func (x *ComputeTrustRequest) SetEigenTrust(v *EigenTrustParams) {
+	if v == nil {
+		x.xxx_hidden_Metric = nil
+		return
+	}
+	x.xxx_hidden_Metric = &computeTrustRequest_EigenTrust{v}
+}
+
+func (x *ComputeTrustRequest) SetTidalTrust(v *TidalTrustParams) {
+	if v == nil {
+		x.xxx_hidden_Metric = nil
+		return
+	}
+	x.xxx_hidden_Metric = &computeTrustRequest_TidalTrust{v}
+}
+
+func (x *ComputeTrustRequest) SetAdvogato(v *AdvogatoParams) {
+	if v == nil {
+		x.xxx_hidden_Metric = nil
+		return
+	}
+	x.xxx_hidden_Metric = &computeTrustRequest_Advogato{v}
+}
+
+func (x *ComputeTrustRequest) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Source != nil
+}
+
+func (x *ComputeTrustRequest) HasSeed1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ComputeTrustRequest) HasSeed2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ComputeTrustRequest) HasMetric() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Metric != nil
+}
+
+func (x *ComputeTrustRequest) HasPersonalizedPageRank() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Metric.(*computeTrustRequest_PersonalizedPageRank)
+	return ok
+}
+
+func (x *ComputeTrustRequest) HasEigenTrust() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Metric.(*computeTrustRequest_EigenTrust)
+	return ok
+}
+
+func (x *ComputeTrustRequest) HasTidalTrust() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Metric.(*computeTrustRequest_TidalTrust)
+	return ok
+}
+
+func (x *ComputeTrustRequest) HasAdvogato() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Metric.(*computeTrustRequest_Advogato)
+	return ok
+}
+
+func (x *ComputeTrustRequest) ClearSource() {
+	x.xxx_hidden_Source = nil
+}
+
+func (x *ComputeTrustRequest) ClearSeed1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Seed1 = 0
+}
+
+func (x *ComputeTrustRequest) ClearSeed2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Seed2 = 0
+}
+
+func (x *ComputeTrustRequest) ClearMetric() {
+	x.xxx_hidden_Metric = nil
+}
+
+func (x *ComputeTrustRequest) ClearPersonalizedPageRank() {
+	if _, ok := x.xxx_hidden_Metric.(*computeTrustRequest_PersonalizedPageRank); ok {
+		x.xxx_hidden_Metric = nil
+	}
+}
+
+func (x *ComputeTrustRequest) ClearEigenTrust() {
+	if _, ok := x.xxx_hidden_Metric.(*computeTrustRequest_EigenTrust); ok {
+		x.xxx_hidden_Metric = nil
+	}
+}
+
+func (x *ComputeTrustRequest) ClearTidalTrust() {
+	if _, ok := x.xxx_hidden_Metric.(*computeTrustRequest_TidalTrust); ok {
+		x.xxx_hidden_Metric = nil
+	}
+}
+
+func (x *ComputeTrustRequest) ClearAdvogato() {
+	if _, ok := x.xxx_hidden_Metric.(*computeTrustRequest_Advogato); ok {
+		x.xxx_hidden_Metric = nil
+	}
+}
+
+const ComputeTrustRequest_Metric_not_set_case case_ComputeTrustRequest_Metric = 0
+const ComputeTrustRequest_PersonalizedPageRank_case case_ComputeTrustRequest_Metric = 4
+const ComputeTrustRequest_EigenTrust_case case_ComputeTrustRequest_Metric = 5
+const ComputeTrustRequest_TidalTrust_case case_ComputeTrustRequest_Metric = 6
+const ComputeTrustRequest_Advogato_case case_ComputeTrustRequest_Metric = 7
+
+func (x *ComputeTrustRequest) WhichMetric() case_ComputeTrustRequest_Metric {
+	if x == nil {
+		return ComputeTrustRequest_Metric_not_set_case
+	}
+	switch x.xxx_hidden_Metric.(type) {
+	case *computeTrustRequest_PersonalizedPageRank:
+		return ComputeTrustRequest_PersonalizedPageRank_case
+	case *computeTrustRequest_EigenTrust:
+		return ComputeTrustRequest_EigenTrust_case
+	case *computeTrustRequest_TidalTrust:
+		return ComputeTrustRequest_TidalTrust_case
+	case *computeTrustRequest_Advogato:
+		return ComputeTrustRequest_Advogato_case
+	default:
+		return ComputeTrustRequest_Metric_not_set_case
+	}
+}
+
+type ComputeTrustRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Source *GraphSource
+	// seed1 and seed2 seed the randomized metrics.
+	Seed1 *uint64
+	Seed2 *uint64
+	// metric selects the trust metric, when unset personalized PageRank with its defaults is used.
+
+	// Fields of oneof xxx_hidden_Metric:
+	PersonalizedPageRank *PageRankParams
+	EigenTrust           *EigenTrustParams
+	TidalTrust           *TidalTrustParams
+	Advogato             *AdvogatoParams
+	// -- end of xxx_hidden_Metric
+}
+
+func (b0 ComputeTrustRequest_builder) Build() *ComputeTrustRequest {
+	m0 := &ComputeTrustRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Source = b.Source
+	if b.Seed1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Seed1 = *b.Seed1
+	}
+	if b.Seed2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Seed2 = *b.Seed2
+	}
+	if b.PersonalizedPageRank != nil {
+		x.xxx_hidden_Metric = &computeTrustRequest_PersonalizedPageRank{b.PersonalizedPageRank}
+	}
+	if b.EigenTrust != nil {
+		x.xxx_hidden_Metric = &computeTrustRequest_EigenTrust{b.EigenTrust}
+	}
+	if b.TidalTrust != nil {
+		x.xxx_hidden_Metric = &computeTrustRequest_TidalTrust{b.TidalTrust}
+	}
+	if b.Advogato != nil {
+		x.xxx_hidden_Metric = &computeTrustRequest_Advogato{b.Advogato}
+	}
+	return m0
+}
+
+type case_ComputeTrustRequest_Metric protoreflect.FieldNumber
+
+func (x case_ComputeTrustRequest_Metric) String() string {
+	md := file_internal_rpc_v1_rpc_proto_msgTypes[36].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isComputeTrustRequest_Metric interface {
+	isComputeTrustRequest_Metric()
+}
+
+type computeTrustRequest_PersonalizedPageRank struct {
+	PersonalizedPageRank *PageRankParams `protobuf:"bytes,4,opt,name=personalized_page_rank,json=personalizedPageRank,oneof"`
+}
+
+type computeTrustRequest_EigenTrust struct {
+	EigenTrust *EigenTrustParams `protobuf:"bytes,5,opt,name=eigen_trust,json=eigenTrust,oneof"`
+}
+
+type computeTrustRequest_TidalTrust struct {
+	TidalTrust *TidalTrustParams `protobuf:"bytes,6,opt,name=tidal_trust,json=tidalTrust,oneof"`
+}
+
+type computeTrustRequest_Advogato struct {
+	Advogato *AdvogatoParams `protobuf:"bytes,7,opt,name=advogato,oneof"`
+}
+
+func (*computeTrustRequest_PersonalizedPageRank) isComputeTrustRequest_Metric() {}
+
+func (*computeTrustRequest_EigenTrust) isComputeTrustRequest_Metric() {}
+
+func (*computeTrustRequest_TidalTrust) isComputeTrustRequest_Metric() {}
+
+func (*computeTrustRequest_Advogato) isComputeTrustRequest_Metric() {}
+
+type ComputeTrustResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Scores       map[string]float64     `protobuf:"bytes,1,rep,name=scores" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	xxx_hidden_SourceNodeId *string                `protobuf:"bytes,2,opt,name=source_node_id,json=sourceNodeId"`
+	xxx_hidden_TargetNodeId *string                `protobuf:"bytes,3,opt,name=target_node_id,json=targetNodeId"`
+	xxx_hidden_TargetScore  float64                `protobuf:"fixed64,4,opt,name=target_score,json=targetScore"`
+	xxx_hidden_TargetRank   int64                  `protobuf:"varint,5,opt,name=target_rank,json=targetRank"`
+	xxx_hidden_Iterations   int64                  `protobuf:"varint,6,opt,name=iterations"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ComputeTrustResponse) Reset() {
+	*x = ComputeTrustResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComputeTrustResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeTrustResponse) ProtoMessage() {}
+
+func (x *ComputeTrustResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ComputeTrustResponse) GetScores() map[string]float64 {
+	if x != nil {
+		return x.xxx_hidden_Scores
+	}
+	return nil
+}
+
+func (x *ComputeTrustResponse) GetSourceNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_SourceNodeId != nil {
+			return *x.xxx_hidden_SourceNodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ComputeTrustResponse) GetTargetNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_TargetNodeId != nil {
+			return *x.xxx_hidden_TargetNodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ComputeTrustResponse) GetTargetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_TargetScore
+	}
+	return 0
+}
+
+func (x *ComputeTrustResponse) GetTargetRank() int64 {
+	if x != nil {
+		return x.xxx_hidden_TargetRank
+	}
+	return 0
+}
+
+func (x *ComputeTrustResponse) GetIterations() int64 {
+	if x != nil {
+		return x.xxx_hidden_Iterations
+	}
+	return 0
+}
+
+func (x *ComputeTrustResponse) SetScores(v map[string]float64) {
+	x.xxx_hidden_Scores = v
+}
+
+func (x *ComputeTrustResponse) SetSourceNodeId(v string) {
+	x.xxx_hidden_SourceNodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *ComputeTrustResponse) SetTargetNodeId(v string) {
+	x.xxx_hidden_TargetNodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *ComputeTrustResponse) SetTargetScore(v float64) {
+	x.xxx_hidden_TargetScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *ComputeTrustResponse) SetTargetRank(v int64) {
+	x.xxx_hidden_TargetRank = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *ComputeTrustResponse) SetIterations(v int64) {
+	x.xxx_hidden_Iterations = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *ComputeTrustResponse) HasSourceNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ComputeTrustResponse) HasTargetNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ComputeTrustResponse) HasTargetScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ComputeTrustResponse) HasTargetRank() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ComputeTrustResponse) HasIterations() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ComputeTrustResponse) ClearSourceNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_SourceNodeId = nil
+}
+
+func (x *ComputeTrustResponse) ClearTargetNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_TargetNodeId = nil
+}
+
+func (x *ComputeTrustResponse) ClearTargetScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_TargetScore = 0
+}
+
+func (x *ComputeTrustResponse) ClearTargetRank() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_TargetRank = 0
+}
+
+func (x *ComputeTrustResponse) ClearIterations() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Iterations = 0
+}
+
+type ComputeTrustResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// scores holds the trust of Bob in every node, by node id.
+	Scores map[string]float64
+	// source_node_id is the node that trusts, Bob.
+	SourceNodeId *string
+	// target_node_id is the node that is trusted, Ada.
+	TargetNodeId *string
+	TargetScore  *float64
+	// target_rank is the position of Ada when all nodes except Bob are ordered by decreasing score,
+	// starting at 1. Nodes with the same score share their rank.
+	TargetRank *int64
+	// iterations is the number of power iterations, or the number of walks for Monte Carlo. It is 0 for
+	// metrics that don't iterate.
+	Iterations *int64
+}
+
+func (b0 ComputeTrustResponse_builder) Build() *ComputeTrustResponse {
+	m0 := &ComputeTrustResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Scores = b.Scores
+	if b.SourceNodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_SourceNodeId = b.SourceNodeId
+	}
+	if b.TargetNodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_TargetNodeId = b.TargetNodeId
+	}
+	if b.TargetScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_TargetScore = *b.TargetScore
+	}
+	if b.TargetRank != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_TargetRank = *b.TargetRank
+	}
+	if b.Iterations != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_Iterations = *b.Iterations
+	}
+	return m0
+}
+
+type MaxFlowRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Source      *GraphSource           `protobuf:"bytes,1,opt,name=source"`
+	xxx_hidden_Capacity    FlowCapacity           `protobuf:"varint,2,opt,name=capacity,enum=internal.rpc.v1.FlowCapacity"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MaxFlowRequest) Reset() {
+	*x = MaxFlowRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaxFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxFlowRequest) ProtoMessage() {}
+
+func (x *MaxFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MaxFlowRequest) GetSource() *GraphSource {
+	if x != nil {
+		return x.xxx_hidden_Source
+	}
+	return nil
+}
+
+func (x *MaxFlowRequest) GetCapacity() FlowCapacity {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Capacity
+		}
+	}
+	return FlowCapacity_FLOW_CAPACITY_UNSPECIFIED
+}
+
+func (x *MaxFlowRequest) SetSource(v *GraphSource) {
+	x.xxx_hidden_Source = v
+}
+
+func (x *MaxFlowRequest) SetCapacity(v FlowCapacity) {
+	x.xxx_hidden_Capacity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *MaxFlowRequest) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Source != nil
+}
+
+func (x *MaxFlowRequest) HasCapacity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MaxFlowRequest) ClearSource() {
+	x.xxx_hidden_Source = nil
+}
+
+func (x *MaxFlowRequest) ClearCapacity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Capacity = FlowCapacity_FLOW_CAPACITY_UNSPECIFIED
+}
+
+type MaxFlowRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Source   *GraphSource
+	Capacity *FlowCapacity
+}
+
+func (b0 MaxFlowRequest_builder) Build() *MaxFlowRequest {
+	m0 := &MaxFlowRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Source = b.Source
+	if b.Capacity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Capacity = *b.Capacity
+	}
+	return m0
+}
+
+type MaxFlowResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_SourceNodeId *string                `protobuf:"bytes,1,opt,name=source_node_id,json=sourceNodeId"`
+	xxx_hidden_TargetNodeId *string                `protobuf:"bytes,2,opt,name=target_node_id,json=targetNodeId"`
+	xxx_hidden_Value        float64                `protobuf:"fixed64,3,opt,name=value"`
+	xxx_hidden_CutEdgeIds   []string               `protobuf:"bytes,4,rep,name=cut_edge_ids,json=cutEdgeIds"`
+	xxx_hidden_Graph        *RandomGraphResponse   `protobuf:"bytes,5,opt,name=graph"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *MaxFlowResponse) Reset() {
+	*x = MaxFlowResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaxFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxFlowResponse) ProtoMessage() {}
+
+func (x *MaxFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MaxFlowResponse) GetSourceNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_SourceNodeId != nil {
+			return *x.xxx_hidden_SourceNodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MaxFlowResponse) GetTargetNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_TargetNodeId != nil {
+			return *x.xxx_hidden_TargetNodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MaxFlowResponse) GetValue() float64 {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return 0
+}
+
+func (x *MaxFlowResponse) GetCutEdgeIds() []string {
+	if x != nil {
+		return x.xxx_hidden_CutEdgeIds
+	}
+	return nil
+}
+
+func (x *MaxFlowResponse) GetGraph() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Graph
+	}
+	return nil
+}
+
+func (x *MaxFlowResponse) SetSourceNodeId(v string) {
+	x.xxx_hidden_SourceNodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *MaxFlowResponse) SetTargetNodeId(v string) {
+	x.xxx_hidden_TargetNodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *MaxFlowResponse) SetValue(v float64) {
+	x.xxx_hidden_Value = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *MaxFlowResponse) SetCutEdgeIds(v []string) {
+	x.xxx_hidden_CutEdgeIds = v
+}
+
+func (x *MaxFlowResponse) SetGraph(v *RandomGraphResponse) {
+	x.xxx_hidden_Graph = v
+}
+
+func (x *MaxFlowResponse) HasSourceNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MaxFlowResponse) HasTargetNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MaxFlowResponse) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *MaxFlowResponse) HasGraph() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Graph != nil
+}
+
+func (x *MaxFlowResponse) ClearSourceNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_SourceNodeId = nil
+}
+
+func (x *MaxFlowResponse) ClearTargetNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TargetNodeId = nil
+}
+
+func (x *MaxFlowResponse) ClearValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Value = 0
+}
+
+func (x *MaxFlowResponse) ClearGraph() {
+	x.xxx_hidden_Graph = nil
+}
+
+type MaxFlowResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// source_node_id is where the flow starts, Bob.
+	SourceNodeId *string
+	// target_node_id is where the flow ends, Ada.
+	TargetNodeId *string
+	// value is the maximum flow, which equals the capacity of the minimum cut.
+	Value *float64
+	// cut_edge_ids holds the edges of a minimum cut, the ones on Bob's side of it that lead to Ada's side.
+	CutEdgeIds []string
+	// graph is the graph with the cut edges typed "cutEdge".
+	Graph *RandomGraphResponse
+}
+
+func (b0 MaxFlowResponse_builder) Build() *MaxFlowResponse {
+	m0 := &MaxFlowResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.SourceNodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_SourceNodeId = b.SourceNodeId
+	}
+	if b.TargetNodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_TargetNodeId = b.TargetNodeId
+	}
+	if b.Value != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Value = *b.Value
+	}
+	x.xxx_hidden_CutEdgeIds = b.CutEdgeIds
+	x.xxx_hidden_Graph = b.Graph
+	return m0
+}
+
+type ShortestPathsRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Source      *GraphSource           `protobuf:"bytes,1,opt,name=source"`
+	xxx_hidden_Cost        PathCost               `protobuf:"varint,2,opt,name=cost,enum=internal.rpc.v1.PathCost"`
+	xxx_hidden_K           int64                  `protobuf:"varint,3,opt,name=k"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ShortestPathsRequest) Reset() {
+	*x = ShortestPathsRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortestPathsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortestPathsRequest) ProtoMessage() {}
+
+func (x *ShortestPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ShortestPathsRequest) GetSource() *GraphSource {
+	if x != nil {
+		return x.xxx_hidden_Source
+	}
+	return nil
+}
+
+func (x *ShortestPathsRequest) GetCost() PathCost {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Cost
+		}
+	}
+	return PathCost_PATH_COST_UNSPECIFIED
+}
+
+func (x *ShortestPathsRequest) GetK() int64 {
+	if x != nil {
+		return x.xxx_hidden_K
+	}
+	return 0
+}
+
+func (x *ShortestPathsRequest) SetSource(v *GraphSource) {
+	x.xxx_hidden_Source = v
+}
+
+func (x *ShortestPathsRequest) SetCost(v PathCost) {
+	x.xxx_hidden_Cost = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *ShortestPathsRequest) SetK(v int64) {
+	x.xxx_hidden_K = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ShortestPathsRequest) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Source != nil
+}
+
+func (x *ShortestPathsRequest) HasCost() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ShortestPathsRequest) HasK() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ShortestPathsRequest) ClearSource() {
+	x.xxx_hidden_Source = nil
+}
+
+func (x *ShortestPathsRequest) ClearCost() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Cost = PathCost_PATH_COST_UNSPECIFIED
+}
+
+func (x *ShortestPathsRequest) ClearK() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_K = 0
+}
+
+type ShortestPathsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Source *GraphSource
+	Cost   *PathCost
+	// k is the number of loopless paths to find with Yen's algorithm, defaults to 1.
+	K *int64
+}
+
+func (b0 ShortestPathsRequest_builder) Build() *ShortestPathsRequest {
+	m0 := &ShortestPathsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Source = b.Source
+	if b.Cost != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Cost = *b.Cost
+	}
+	if b.K != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_K = *b.K
+	}
+	return m0
+}
+
+// TrustPath is a path from Bob to Ada.
+type TrustPath struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NodeIds     []string               `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds"`
+	xxx_hidden_EdgeIds     []string               `protobuf:"bytes,2,rep,name=edge_ids,json=edgeIds"`
+	xxx_hidden_Cost        float64                `protobuf:"fixed64,3,opt,name=cost"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TrustPath) Reset() {
+	*x = TrustPath{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrustPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustPath) ProtoMessage() {}
+
+func (x *TrustPath) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrustPath) GetNodeIds() []string {
+	if x != nil {
+		return x.xxx_hidden_NodeIds
+	}
+	return nil
+}
+
+func (x *TrustPath) GetEdgeIds() []string {
+	if x != nil {
+		return x.xxx_hidden_EdgeIds
+	}
+	return nil
+}
+
+func (x *TrustPath) GetCost() float64 {
+	if x != nil {
+		return x.xxx_hidden_Cost
+	}
+	return 0
+}
+
+func (x *TrustPath) SetNodeIds(v []string) {
+	x.xxx_hidden_NodeIds = v
+}
+
+func (x *TrustPath) SetEdgeIds(v []string) {
+	x.xxx_hidden_EdgeIds = v
+}
+
+func (x *TrustPath) SetCost(v float64) {
+	x.xxx_hidden_Cost = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *TrustPath) HasCost() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TrustPath) ClearCost() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Cost = 0
+}
+
+type TrustPath_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NodeIds []string
+	EdgeIds []string
+	Cost    *float64
+}
+
+func (b0 TrustPath_builder) Build() *TrustPath {
+	m0 := &TrustPath{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_NodeIds = b.NodeIds
+	x.xxx_hidden_EdgeIds = b.EdgeIds
+	if b.Cost != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Cost = *b.Cost
+	}
+	return m0
+}
+
+type ShortestPathsResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_SourceNodeId *string                `protobuf:"bytes,1,opt,name=source_node_id,json=sourceNodeId"`
+	xxx_hidden_TargetNodeId *string                `protobuf:"bytes,2,opt,name=target_node_id,json=targetNodeId"`
+	xxx_hidden_Paths        *[]*TrustPath          `protobuf:"bytes,3,rep,name=paths"`
+	xxx_hidden_Graph        *RandomGraphResponse   `protobuf:"bytes,4,opt,name=graph"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ShortestPathsResponse) Reset() {
+	*x = ShortestPathsResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortestPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortestPathsResponse) ProtoMessage() {}
+
+func (x *ShortestPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ShortestPathsResponse) GetSourceNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_SourceNodeId != nil {
+			return *x.xxx_hidden_SourceNodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ShortestPathsResponse) GetTargetNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_TargetNodeId != nil {
+			return *x.xxx_hidden_TargetNodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ShortestPathsResponse) GetPaths() []*TrustPath {
+	if x != nil {
+		if x.xxx_hidden_Paths != nil {
+			return *x.xxx_hidden_Paths
+		}
+	}
+	return nil
+}
+
+func (x *ShortestPathsResponse) GetGraph() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Graph
+	}
+	return nil
+}
+
+func (x *ShortestPathsResponse) SetSourceNodeId(v string) {
+	x.xxx_hidden_SourceNodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *ShortestPathsResponse) SetTargetNodeId(v string) {
+	x.xxx_hidden_TargetNodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *ShortestPathsResponse) SetPaths(v []*TrustPath) {
+	x.xxx_hidden_Paths = &v
+}
+
+func (x *ShortestPathsResponse) SetGraph(v *RandomGraphResponse) {
+	x.xxx_hidden_Graph = v
+}
+
+func (x *ShortestPathsResponse) HasSourceNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ShortestPathsResponse) HasTargetNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ShortestPathsResponse) HasGraph() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Graph != nil
+}
+
+func (x *ShortestPathsResponse) ClearSourceNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_SourceNodeId = nil
+}
+
+func (x *ShortestPathsResponse) ClearTargetNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TargetNodeId = nil
+}
+
+func (x *ShortestPathsResponse) ClearGraph() {
+	x.xxx_hidden_Graph = nil
+}
+
+type ShortestPathsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// source_node_id is where the paths start, Bob.
+	SourceNodeId *string
+	// target_node_id is where the paths end, Ada.
+	TargetNodeId *string
+	// paths holds the paths in order of increasing cost, there are fewer than k if there aren't as many.
+	Paths []*TrustPath
+	// graph is the graph with the edges of the paths typed "pathEdge" and the nodes between Bob and Ada
+	// typed "pathNode".
+	Graph *RandomGraphResponse
+}
+
+func (b0 ShortestPathsResponse_builder) Build() *ShortestPathsResponse {
+	m0 := &ShortestPathsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.SourceNodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_SourceNodeId = b.SourceNodeId
+	}
+	if b.TargetNodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_TargetNodeId = b.TargetNodeId
+	}
+	x.xxx_hidden_Paths = &b.Paths
+	x.xxx_hidden_Graph = b.Graph
+	return m0
+}
+
+type GraphStatsRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Source *GraphSource           `protobuf:"bytes,1,opt,name=source"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GraphStatsRequest) Reset() {
+	*x = GraphStatsRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphStatsRequest) ProtoMessage() {}
+
+func (x *GraphStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GraphStatsRequest) GetSource() *GraphSource {
+	if x != nil {
+		return x.xxx_hidden_Source
+	}
+	return nil
+}
+
+func (x *GraphStatsRequest) SetSource(v *GraphSource) {
+	x.xxx_hidden_Source = v
+}
+
+func (x *GraphStatsRequest) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Source != nil
+}
+
+func (x *GraphStatsRequest) ClearSource() {
+	x.xxx_hidden_Source = nil
+}
+
+type GraphStatsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Source *GraphSource
+}
+
+func (b0 GraphStatsRequest_builder) Build() *GraphStatsRequest {
+	m0 := &GraphStatsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Source = b.Source
+	return m0
+}
+
+type GraphStatsResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Stats *GraphStats            `protobuf:"bytes,1,opt,name=stats"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GraphStatsResponse) Reset() {
+	*x = GraphStatsResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphStatsResponse) ProtoMessage() {}
+
+func (x *GraphStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GraphStatsResponse) GetStats() *GraphStats {
+	if x != nil {
+		return x.xxx_hidden_Stats
+	}
+	return nil
+}
+
+func (x *GraphStatsResponse) SetStats(v *GraphStats) {
+	x.xxx_hidden_Stats = v
+}
+
+func (x *GraphStatsResponse) HasStats() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Stats != nil
+}
+
+func (x *GraphStatsResponse) ClearStats() {
+	x.xxx_hidden_Stats = nil
+}
+
+type GraphStatsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Stats *GraphStats
+}
+
+func (b0 GraphStatsResponse_builder) Build() *GraphStatsResponse {
+	m0 := &GraphStatsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Stats = b.Stats
+	return m0
+}
+
+// Int64Sweep sweeps an integer parameter from start up to and including stop, in increments of step.
+// Without a step the sweep only takes the start value.
+type Int64Sweep struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Start       int64                  `protobuf:"varint,1,opt,name=start"`
+	xxx_hidden_Stop        int64                  `protobuf:"varint,2,opt,name=stop"`
+	xxx_hidden_Step        int64                  `protobuf:"varint,3,opt,name=step"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Int64Sweep) Reset() {
+	*x = Int64Sweep{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Sweep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Sweep) ProtoMessage() {}
+
+func (x *Int64Sweep) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Int64Sweep) GetStart() int64 {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return 0
+}
+
+func (x *Int64Sweep) GetStop() int64 {
+	if x != nil {
+		return x.xxx_hidden_Stop
+	}
+	return 0
+}
+
+func (x *Int64Sweep) GetStep() int64 {
+	if x != nil {
+		return x.xxx_hidden_Step
+	}
+	return 0
+}
+
+func (x *Int64Sweep) SetStart(v int64) {
+	x.xxx_hidden_Start = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *Int64Sweep) SetStop(v int64) {
+	x.xxx_hidden_Stop = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *Int64Sweep) SetStep(v int64) {
+	x.xxx_hidden_Step = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *Int64Sweep) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Int64Sweep) HasStop() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Int64Sweep) HasStep() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Int64Sweep) ClearStart() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Start = 0
+}
+
+func (x *Int64Sweep) ClearStop() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Stop = 0
+}
+
+func (x *Int64Sweep) ClearStep() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Step = 0
+}
+
+type Int64Sweep_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Start *int64
+	Stop  *int64
+	Step  *int64
+}
+
+func (b0 Int64Sweep_builder) Build() *Int64Sweep {
+	m0 := &Int64Sweep{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Start != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Start = *b.Start
+	}
+	if b.Stop != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Stop = *b.Stop
+	}
+	if b.Step != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Step = *b.Step
+	}
+	return m0
+}
+
+// DoubleSweep sweeps a real parameter from start up to and including stop, in increments of step.
+// Without a step the sweep only takes the start value.
+type DoubleSweep struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Start       float64                `protobuf:"fixed64,1,opt,name=start"`
+	xxx_hidden_Stop        float64                `protobuf:"fixed64,2,opt,name=stop"`
+	xxx_hidden_Step        float64                `protobuf:"fixed64,3,opt,name=step"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DoubleSweep) Reset() {
+	*x = DoubleSweep{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleSweep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleSweep) ProtoMessage() {}
+
+func (x *DoubleSweep) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DoubleSweep) GetStart() float64 {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return 0
+}
+
+func (x *DoubleSweep) GetStop() float64 {
+	if x != nil {
+		return x.xxx_hidden_Stop
+	}
+	return 0
+}
+
+func (x *DoubleSweep) GetStep() float64 {
+	if x != nil {
+		return x.xxx_hidden_Step
+	}
+	return 0
+}
+
+func (x *DoubleSweep) SetStart(v float64) {
+	x.xxx_hidden_Start = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *DoubleSweep) SetStop(v float64) {
+	x.xxx_hidden_Stop = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *DoubleSweep) SetStep(v float64) {
+	x.xxx_hidden_Step = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *DoubleSweep) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DoubleSweep) HasStop() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DoubleSweep) HasStep() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DoubleSweep) ClearStart() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Start = 0
+}
+
+func (x *DoubleSweep) ClearStop() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Stop = 0
+}
+
+func (x *DoubleSweep) ClearStep() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Step = 0
+}
+
+type DoubleSweep_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Start *float64
+	Stop  *float64
+	Step  *float64
+}
+
+func (b0 DoubleSweep_builder) Build() *DoubleSweep {
+	m0 := &DoubleSweep{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Start != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Start = *b.Start
+	}
+	if b.Stop != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Stop = *b.Stop
+	}
+	if b.Step != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Step = *b.Step
+	}
+	return m0
+}
+
+// RunExperimentRequest runs the walks of Bob and Ada for every combination of the swept parameters, and
+// for every seed. The graphs are Watts–Strogatz graphs, the generator and layout of the base are ignored.
+type RunExperimentRequest struct {
+	state                          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Base                *RandomGraphRequest    `protobuf:"bytes,1,opt,name=base"`
+	xxx_hidden_NumNodes            *Int64Sweep            `protobuf:"bytes,2,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_InitialConnected    *Int64Sweep            `protobuf:"bytes,3,opt,name=initial_connected,json=initialConnected"`
+	xxx_hidden_RewiringProbability *DoubleSweep           `protobuf:"bytes,4,opt,name=rewiring_probability,json=rewiringProbability"`
+	xxx_hidden_WalkLength          *Int64Sweep            `protobuf:"bytes,5,opt,name=walk_length,json=walkLength"`
+	xxx_hidden_NumWalks            *Int64Sweep            `protobuf:"bytes,6,opt,name=num_walks,json=numWalks"`
+	xxx_hidden_Seeds               *Int64Sweep            `protobuf:"bytes,7,opt,name=seeds"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *RunExperimentRequest) Reset() {
+	*x = RunExperimentRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunExperimentRequest) ProtoMessage() {}
+
+func (x *RunExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RunExperimentRequest) GetBase() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_Base
+	}
+	return nil
+}
+
+func (x *RunExperimentRequest) GetNumNodes() *Int64Sweep {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return nil
+}
+
+func (x *RunExperimentRequest) GetInitialConnected() *Int64Sweep {
+	if x != nil {
+		return x.xxx_hidden_InitialConnected
+	}
+	return nil
+}
+
+func (x *RunExperimentRequest) GetRewiringProbability() *DoubleSweep {
+	if x != nil {
+		return x.xxx_hidden_RewiringProbability
+	}
+	return nil
+}
+
+func (x *RunExperimentRequest) GetWalkLength() *Int64Sweep {
+	if x != nil {
+		return x.xxx_hidden_WalkLength
+	}
+	return nil
+}
+
+func (x *RunExperimentRequest) GetNumWalks() *Int64Sweep {
+	if x != nil {
+		return x.xxx_hidden_NumWalks
+	}
+	return nil
+}
+
+func (x *RunExperimentRequest) GetSeeds() *Int64Sweep {
+	if x != nil {
+		return x.xxx_hidden_Seeds
+	}
+	return nil
+}
+
+func (x *RunExperimentRequest) SetBase(v *RandomGraphRequest) {
+	x.xxx_hidden_Base = v
+}
+
+func (x *RunExperimentRequest) SetNumNodes(v *Int64Sweep) {
+	x.xxx_hidden_NumNodes = v
+}
+
+func (x *RunExperimentRequest) SetInitialConnected(v *Int64Sweep) {
+	x.xxx_hidden_InitialConnected = v
+}
+
+func (x *RunExperimentRequest) SetRewiringProbability(v *DoubleSweep) {
+	x.xxx_hidden_RewiringProbability = v
+}
+
+func (x *RunExperimentRequest) SetWalkLength(v *Int64Sweep) {
+	x.xxx_hidden_WalkLength = v
+}
+
+func (x *RunExperimentRequest) SetNumWalks(v *Int64Sweep) {
+	x.xxx_hidden_NumWalks = v
+}
+
+func (x *RunExperimentRequest) SetSeeds(v *Int64Sweep) {
+	x.xxx_hidden_Seeds = v
+}
+
+func (x *RunExperimentRequest) HasBase() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Base != nil
+}
+
+func (x *RunExperimentRequest) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NumNodes != nil
+}
+
+func (x *RunExperimentRequest) HasInitialConnected() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_InitialConnected != nil
+}
+
+func (x *RunExperimentRequest) HasRewiringProbability() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RewiringProbability != nil
+}
+
+func (x *RunExperimentRequest) HasWalkLength() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_WalkLength != nil
+}
+
+func (x *RunExperimentRequest) HasNumWalks() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NumWalks != nil
+}
+
+func (x *RunExperimentRequest) HasSeeds() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Seeds != nil
+}
+
+func (x *RunExperimentRequest) ClearBase() {
+	x.xxx_hidden_Base = nil
+}
+
+func (x *RunExperimentRequest) ClearNumNodes() {
+	x.xxx_hidden_NumNodes = nil
+}
+
+func (x *RunExperimentRequest) ClearInitialConnected() {
+	x.xxx_hidden_InitialConnected = nil
+}
+
+func (x *RunExperimentRequest) ClearRewiringProbability() {
+	x.xxx_hidden_RewiringProbability = nil
+}
+
+func (x *RunExperimentRequest) ClearWalkLength() {
+	x.xxx_hidden_WalkLength = nil
+}
+
+func (x *RunExperimentRequest) ClearNumWalks() {
+	x.xxx_hidden_NumWalks = nil
+}
+
+func (x *RunExperimentRequest) ClearSeeds() {
+	x.xxx_hidden_Seeds = nil
+}
+
+type RunExperimentRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// base configures everything that isn't swept, such as the walk mode and the Sybil region. Parameters
+	// that aren't swept take their value from the base.
+	Base                *RandomGraphRequest
+	NumNodes            *Int64Sweep
+	InitialConnected    *Int64Sweep
+	RewiringProbability *DoubleSweep
+	WalkLength          *Int64Sweep
+	NumWalks            *Int64Sweep
+	// seeds sweeps the seeds of the runs of every configuration: the run with seed s uses it as seed1 and
+	// seed3 of the base, and keeps its seed2 and seed4.
+	Seeds *Int64Sweep
+}
+
+func (b0 RunExperimentRequest_builder) Build() *RunExperimentRequest {
+	m0 := &RunExperimentRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Base = b.Base
+	x.xxx_hidden_NumNodes = b.NumNodes
+	x.xxx_hidden_InitialConnected = b.InitialConnected
+	x.xxx_hidden_RewiringProbability = b.RewiringProbability
+	x.xxx_hidden_WalkLength = b.WalkLength
+	x.xxx_hidden_NumWalks = b.NumWalks
+	x.xxx_hidden_Seeds = b.Seeds
+	return m0
+}
+
+// ExperimentResult aggregates the runs of a single configuration of an experiment.
+type ExperimentResult struct {
+	state                          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NumNodes            int64                  `protobuf:"varint,1,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_InitialConnected    int64                  `protobuf:"varint,2,opt,name=initial_connected,json=initialConnected"`
+	xxx_hidden_RewiringProbability float64                `protobuf:"fixed64,3,opt,name=rewiring_probability,json=rewiringProbability"`
+	xxx_hidden_WalkLength          int64                  `protobuf:"varint,4,opt,name=walk_length,json=walkLength"`
+	xxx_hidden_NumWalks            int64                  `protobuf:"varint,5,opt,name=num_walks,json=numWalks"`
+	xxx_hidden_Runs                int64                  `protobuf:"varint,6,opt,name=runs"`
+	xxx_hidden_IntersectionRate    float64                `protobuf:"fixed64,7,opt,name=intersection_rate,json=intersectionRate"`
+	xxx_hidden_MeanMeetingStep     float64                `protobuf:"fixed64,8,opt,name=mean_meeting_step,json=meanMeetingStep"`
+	xxx_hidden_EscapeProbability   float64                `protobuf:"fixed64,9,opt,name=escape_probability,json=escapeProbability"`
+	XXX_raceDetectHookData         protoimpl.RaceDetectHookData
+	XXX_presence                   [1]uint32
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ExperimentResult) Reset() {
+	*x = ExperimentResult{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentResult) ProtoMessage() {}
+
+func (x *ExperimentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExperimentResult) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *ExperimentResult) GetInitialConnected() int64 {
+	if x != nil {
+		return x.xxx_hidden_InitialConnected
+	}
+	return 0
+}
+
+func (x *ExperimentResult) GetRewiringProbability() float64 {
+	if x != nil {
+		return x.xxx_hidden_RewiringProbability
+	}
+	return 0
+}
+
+func (x *ExperimentResult) GetWalkLength() int64 {
+	if x != nil {
+		return x.xxx_hidden_WalkLength
+	}
+	return 0
+}
+
+func (x *ExperimentResult) GetNumWalks() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumWalks
+	}
+	return 0
+}
+
+func (x *ExperimentResult) GetRuns() int64 {
+	if x != nil {
+		return x.xxx_hidden_Runs
+	}
+	return 0
+}
+
+func (x *ExperimentResult) GetIntersectionRate() float64 {
+	if x != nil {
+		return x.xxx_hidden_IntersectionRate
+	}
+	return 0
+}
+
+func (x *ExperimentResult) GetMeanMeetingStep() float64 {
+	if x != nil {
+		return x.xxx_hidden_MeanMeetingStep
+	}
+	return 0
+}
+
+func (x *ExperimentResult) GetEscapeProbability() float64 {
+	if x != nil {
+		return x.xxx_hidden_EscapeProbability
+	}
+	return 0
+}
+
+func (x *ExperimentResult) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *ExperimentResult) SetInitialConnected(v int64) {
+	x.xxx_hidden_InitialConnected = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
+
+func (x *ExperimentResult) SetRewiringProbability(v float64) {
+	x.xxx_hidden_RewiringProbability = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *ExperimentResult) SetWalkLength(v int64) {
+	x.xxx_hidden_WalkLength = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *ExperimentResult) SetNumWalks(v int64) {
+	x.xxx_hidden_NumWalks = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *ExperimentResult) SetRuns(v int64) {
+	x.xxx_hidden_Runs = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *ExperimentResult) SetIntersectionRate(v float64) {
+	x.xxx_hidden_IntersectionRate = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *ExperimentResult) SetMeanMeetingStep(v float64) {
+	x.xxx_hidden_MeanMeetingStep = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *ExperimentResult) SetEscapeProbability(v float64) {
+	x.xxx_hidden_EscapeProbability = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *ExperimentResult) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ExperimentResult) HasInitialConnected() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ExperimentResult) HasRewiringProbability() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ExperimentResult) HasWalkLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ExperimentResult) HasNumWalks() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ExperimentResult) HasRuns() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ExperimentResult) HasIntersectionRate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *ExperimentResult) HasMeanMeetingStep() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *ExperimentResult) HasEscapeProbability() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *ExperimentResult) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *ExperimentResult) ClearInitialConnected() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_InitialConnected = 0
+}
+
+func (x *ExperimentResult) ClearRewiringProbability() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_RewiringProbability = 0
+}
+
+func (x *ExperimentResult) ClearWalkLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_WalkLength = 0
+}
+
+func (x *ExperimentResult) ClearNumWalks() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_NumWalks = 0
+}
+
+func (x *ExperimentResult) ClearRuns() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Runs = 0
+}
+
+func (x *ExperimentResult) ClearIntersectionRate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_IntersectionRate = 0
+}
+
+func (x *ExperimentResult) ClearMeanMeetingStep() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_MeanMeetingStep = 0
+}
+
+func (x *ExperimentResult) ClearEscapeProbability() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_EscapeProbability = 0
+}
+
+type ExperimentResult_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NumNodes            *int64
+	InitialConnected    *int64
+	RewiringProbability *float64
+	WalkLength          *int64
+	NumWalks            *int64
+	// runs is the number of seeds the configuration ran with.
+	Runs *int64
+	// intersection_rate is the fraction of the runs in which a walk of Bob met a walk of Ada.
+	IntersectionRate *float64
+	// mean_meeting_step is the mean of the combined steps of Bob and Ada to their meeting node, over the
+	// runs in which they met.
+	MeanMeetingStep *float64
+	// escape_probability is the fraction of all walks that entered the Sybil region.
+	EscapeProbability *float64
+}
+
+func (b0 ExperimentResult_builder) Build() *ExperimentResult {
+	m0 := &ExperimentResult{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.InitialConnected != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_InitialConnected = *b.InitialConnected
+	}
+	if b.RewiringProbability != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_RewiringProbability = *b.RewiringProbability
+	}
+	if b.WalkLength != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_WalkLength = *b.WalkLength
+	}
+	if b.NumWalks != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_NumWalks = *b.NumWalks
+	}
+	if b.Runs != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_Runs = *b.Runs
+	}
+	if b.IntersectionRate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_IntersectionRate = *b.IntersectionRate
+	}
+	if b.MeanMeetingStep != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_MeanMeetingStep = *b.MeanMeetingStep
+	}
+	if b.EscapeProbability != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_EscapeProbability = *b.EscapeProbability
+	}
+	return m0
+}
+
+type RunExperimentResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Results      *[]*ExperimentResult   `protobuf:"bytes,1,rep,name=results"`
+	xxx_hidden_ExperimentId *string                `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RunExperimentResponse) Reset() {
+	*x = RunExperimentResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunExperimentResponse) ProtoMessage() {}
+
+func (x *RunExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RunExperimentResponse) GetResults() []*ExperimentResult {
+	if x != nil {
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
+		}
+	}
+	return nil
+}
+
+func (x *RunExperimentResponse) GetExperimentId() string {
+	if x != nil {
+		if x.xxx_hidden_ExperimentId != nil {
+			return *x.xxx_hidden_ExperimentId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RunExperimentResponse) SetResults(v []*ExperimentResult) {
+	x.xxx_hidden_Results = &v
+}
+
+func (x *RunExperimentResponse) SetExperimentId(v string) {
+	x.xxx_hidden_ExperimentId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *RunExperimentResponse) HasExperimentId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RunExperimentResponse) ClearExperimentId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ExperimentId = nil
+}
+
+type RunExperimentResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// results holds a result per configuration, the last swept parameter varies fastest.
+	Results []*ExperimentResult
+	// experiment_id refers to the stored experiment in later calls.
+	ExperimentId *string
+}
+
+func (b0 RunExperimentResponse_builder) Build() *RunExperimentResponse {
+	m0 := &RunExperimentResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Results = &b.Results
+	if b.ExperimentId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ExperimentId = b.ExperimentId
+	}
+	return m0
+}
+
+// CreateGraphRequest creates a graph that is kept on the server, so it can be walked and laid out again
+// without being regenerated.
+type CreateGraphRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Params *RandomGraphRequest    `protobuf:"bytes,1,opt,name=params"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateGraphRequest) Reset() {
+	*x = CreateGraphRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGraphRequest) ProtoMessage() {}
+
+func (x *CreateGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateGraphRequest) GetParams() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_Params
+	}
+	return nil
+}
+
+func (x *CreateGraphRequest) SetParams(v *RandomGraphRequest) {
+	x.xxx_hidden_Params = v
+}
+
+func (x *CreateGraphRequest) HasParams() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Params != nil
+}
+
+func (x *CreateGraphRequest) ClearParams() {
+	x.xxx_hidden_Params = nil
+}
+
+type CreateGraphRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// params configures the graph, its walk related fields are ignored.
+	Params *RandomGraphRequest
+}
+
+func (b0 CreateGraphRequest_builder) Build() *CreateGraphRequest {
+	m0 := &CreateGraphRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Params = b.Params
+	return m0
+}
+
+type CreateGraphResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GraphId     *string                `protobuf:"bytes,1,opt,name=graph_id,json=graphId"`
+	xxx_hidden_Graph       *RandomGraphResponse   `protobuf:"bytes,2,opt,name=graph"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateGraphResponse) Reset() {
+	*x = CreateGraphResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGraphResponse) ProtoMessage() {}
+
+func (x *CreateGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateGraphResponse) GetGraphId() string {
+	if x != nil {
+		if x.xxx_hidden_GraphId != nil {
+			return *x.xxx_hidden_GraphId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateGraphResponse) GetGraph() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Graph
+	}
+	return nil
+}
+
+func (x *CreateGraphResponse) SetGraphId(v string) {
+	x.xxx_hidden_GraphId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *CreateGraphResponse) SetGraph(v *RandomGraphResponse) {
+	x.xxx_hidden_Graph = v
+}
+
+func (x *CreateGraphResponse) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateGraphResponse) HasGraph() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Graph != nil
+}
+
+func (x *CreateGraphResponse) ClearGraphId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GraphId = nil
+}
+
+func (x *CreateGraphResponse) ClearGraph() {
+	x.xxx_hidden_Graph = nil
+}
+
+type CreateGraphResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GraphId *string
+	Graph   *RandomGraphResponse
+}
+
+func (b0 CreateGraphResponse_builder) Build() *CreateGraphResponse {
+	m0 := &CreateGraphResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_GraphId = b.GraphId
+	}
+	x.xxx_hidden_Graph = b.Graph
+	return m0
+}
+
+type RunWalksRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GraphId     *string                `protobuf:"bytes,1,opt,name=graph_id,json=graphId"`
+	xxx_hidden_Params      *RandomGraphRequest    `protobuf:"bytes,2,opt,name=params"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RunWalksRequest) Reset() {
+	*x = RunWalksRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunWalksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunWalksRequest) ProtoMessage() {}
+
+func (x *RunWalksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RunWalksRequest) GetGraphId() string {
+	if x != nil {
+		if x.xxx_hidden_GraphId != nil {
+			return *x.xxx_hidden_GraphId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RunWalksRequest) GetParams() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_Params
+	}
+	return nil
+}
+
+func (x *RunWalksRequest) SetGraphId(v string) {
+	x.xxx_hidden_GraphId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *RunWalksRequest) SetParams(v *RandomGraphRequest) {
+	x.xxx_hidden_Params = v
+}
+
+func (x *RunWalksRequest) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RunWalksRequest) HasParams() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Params != nil
+}
+
+func (x *RunWalksRequest) ClearGraphId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GraphId = nil
+}
+
+func (x *RunWalksRequest) ClearParams() {
+	x.xxx_hidden_Params = nil
+}
+
+type RunWalksRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GraphId *string
+	// params configures the walks, only its walk related fields are used.
+	Params *RandomGraphRequest
+}
+
+func (b0 RunWalksRequest_builder) Build() *RunWalksRequest {
+	m0 := &RunWalksRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_GraphId = b.GraphId
+	}
+	x.xxx_hidden_Params = b.Params
+	return m0
+}
+
+type RunWalksResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Graph *RandomGraphResponse   `protobuf:"bytes,1,opt,name=graph"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RunWalksResponse) Reset() {
+	*x = RunWalksResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunWalksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunWalksResponse) ProtoMessage() {}
+
+func (x *RunWalksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RunWalksResponse) GetGraph() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Graph
+	}
+	return nil
+}
+
+func (x *RunWalksResponse) SetGraph(v *RandomGraphResponse) {
+	x.xxx_hidden_Graph = v
+}
+
+func (x *RunWalksResponse) HasGraph() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Graph != nil
+}
+
+func (x *RunWalksResponse) ClearGraph() {
+	x.xxx_hidden_Graph = nil
+}
+
+type RunWalksResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Graph *RandomGraphResponse
+}
+
+func (b0 RunWalksResponse_builder) Build() *RunWalksResponse {
+	m0 := &RunWalksResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Graph = b.Graph
+	return m0
+}
+
+type RelayoutRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GraphId     *string                `protobuf:"bytes,1,opt,name=graph_id,json=graphId"`
+	xxx_hidden_Params      *RandomGraphRequest    `protobuf:"bytes,2,opt,name=params"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RelayoutRequest) Reset() {
+	*x = RelayoutRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayoutRequest) ProtoMessage() {}
+
+func (x *RelayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RelayoutRequest) GetGraphId() string {
+	if x != nil {
+		if x.xxx_hidden_GraphId != nil {
+			return *x.xxx_hidden_GraphId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RelayoutRequest) GetParams() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_Params
+	}
+	return nil
+}
+
+func (x *RelayoutRequest) SetGraphId(v string) {
+	x.xxx_hidden_GraphId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *RelayoutRequest) SetParams(v *RandomGraphRequest) {
+	x.xxx_hidden_Params = v
+}
+
+func (x *RelayoutRequest) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RelayoutRequest) HasParams() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Params != nil
+}
+
+func (x *RelayoutRequest) ClearGraphId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GraphId = nil
+}
+
+func (x *RelayoutRequest) ClearParams() {
+	x.xxx_hidden_Params = nil
+}
+
+type RelayoutRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GraphId *string
+	// params configures the layout, only its layout related fields and seed1 and seed2 are used.
+	Params *RandomGraphRequest
+}
+
+func (b0 RelayoutRequest_builder) Build() *RelayoutRequest {
+	m0 := &RelayoutRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_GraphId = b.GraphId
+	}
+	x.xxx_hidden_Params = b.Params
+	return m0
+}
+
+type RelayoutResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Graph *RandomGraphResponse   `protobuf:"bytes,1,opt,name=graph"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RelayoutResponse) Reset() {
+	*x = RelayoutResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayoutResponse) ProtoMessage() {}
+
+func (x *RelayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RelayoutResponse) GetGraph() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Graph
+	}
+	return nil
+}
+
+func (x *RelayoutResponse) SetGraph(v *RandomGraphResponse) {
+	x.xxx_hidden_Graph = v
+}
+
+func (x *RelayoutResponse) HasGraph() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Graph != nil
+}
+
+func (x *RelayoutResponse) ClearGraph() {
+	x.xxx_hidden_Graph = nil
+}
+
+type RelayoutResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Graph *RandomGraphResponse
+}
+
+func (b0 RelayoutResponse_builder) Build() *RelayoutResponse {
+	m0 := &RelayoutResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Graph = b.Graph
+	return m0
+}
+
+type StreamLayoutRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GraphId          *string                `protobuf:"bytes,1,opt,name=graph_id,json=graphId"`
+	xxx_hidden_Params           *RandomGraphRequest    `protobuf:"bytes,2,opt,name=params"`
+	xxx_hidden_SnapshotInterval int64                  `protobuf:"varint,3,opt,name=snapshot_interval,json=snapshotInterval"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *StreamLayoutRequest) Reset() {
+	*x = StreamLayoutRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLayoutRequest) ProtoMessage() {}
+
+func (x *StreamLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StreamLayoutRequest) GetGraphId() string {
+	if x != nil {
+		if x.xxx_hidden_GraphId != nil {
+			return *x.xxx_hidden_GraphId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *StreamLayoutRequest) GetParams() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_Params
+	}
+	return nil
+}
+
+func (x *StreamLayoutRequest) GetSnapshotInterval() int64 {
+	if x != nil {
+		return x.xxx_hidden_SnapshotInterval
+	}
+	return 0
+}
+
+func (x *StreamLayoutRequest) SetGraphId(v string) {
+	x.xxx_hidden_GraphId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *StreamLayoutRequest) SetParams(v *RandomGraphRequest) {
+	x.xxx_hidden_Params = v
+}
+
+func (x *StreamLayoutRequest) SetSnapshotInterval(v int64) {
+	x.xxx_hidden_SnapshotInterval = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *StreamLayoutRequest) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *StreamLayoutRequest) HasParams() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Params != nil
+}
+
+func (x *StreamLayoutRequest) HasSnapshotInterval() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *StreamLayoutRequest) ClearGraphId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GraphId = nil
+}
+
+func (x *StreamLayoutRequest) ClearParams() {
+	x.xxx_hidden_Params = nil
+}
+
+func (x *StreamLayoutRequest) ClearSnapshotInterval() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_SnapshotInterval = 0
+}
+
+type StreamLayoutRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GraphId *string
+	// params configures the layout, only its force-directed layout fields and seed1 and seed2 are used.
+	Params *RandomGraphRequest
+	// snapshot_interval is the number of iterations between snapshots, defaults to 10.
+	SnapshotInterval *int64
+}
+
+func (b0 StreamLayoutRequest_builder) Build() *StreamLayoutRequest {
+	m0 := &StreamLayoutRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_GraphId = b.GraphId
+	}
+	x.xxx_hidden_Params = b.Params
+	if b.SnapshotInterval != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_SnapshotInterval = *b.SnapshotInterval
+	}
+	return m0
+}
+
+// NodePosition is the position of a single node.
+type NodePosition struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NodeId      *string                `protobuf:"bytes,1,opt,name=node_id,json=nodeId"`
+	xxx_hidden_Position    *Position              `protobuf:"bytes,2,opt,name=position"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NodePosition) Reset() {
+	*x = NodePosition{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePosition) ProtoMessage() {}
+
+func (x *NodePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *NodePosition) GetNodeId() string {
+	if x != nil {
+		if x.xxx_hidden_NodeId != nil {
+			return *x.xxx_hidden_NodeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *NodePosition) GetPosition() *Position {
+	if x != nil {
+		return x.xxx_hidden_Position
+	}
+	return nil
+}
+
+func (x *NodePosition) SetNodeId(v string) {
+	x.xxx_hidden_NodeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *NodePosition) SetPosition(v *Position) {
+	x.xxx_hidden_Position = v
+}
+
+func (x *NodePosition) HasNodeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *NodePosition) HasPosition() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Position != nil
+}
+
+func (x *NodePosition) ClearNodeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NodeId = nil
+}
+
+func (x *NodePosition) ClearPosition() {
+	x.xxx_hidden_Position = nil
+}
+
+type NodePosition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NodeId   *string
+	Position *Position
+}
+
+func (b0 NodePosition_builder) Build() *NodePosition {
+	m0 := &NodePosition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NodeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_NodeId = b.NodeId
+	}
+	x.xxx_hidden_Position = b.Position
+	return m0
+}
+
+type StreamLayoutResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Stats     *LayoutStats           `protobuf:"bytes,1,opt,name=stats"`
+	xxx_hidden_Positions *[]*NodePosition       `protobuf:"bytes,2,rep,name=positions"`
+	xxx_hidden_Graph     *RandomGraphResponse   `protobuf:"bytes,3,opt,name=graph"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StreamLayoutResponse) Reset() {
+	*x = StreamLayoutResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLayoutResponse) ProtoMessage() {}
+
+func (x *StreamLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StreamLayoutResponse) GetStats() *LayoutStats {
+	if x != nil {
+		return x.xxx_hidden_Stats
+	}
+	return nil
+}
+
+func (x *StreamLayoutResponse) GetPositions() []*NodePosition {
+	if x != nil {
+		if x.xxx_hidden_Positions != nil {
+			return *x.xxx_hidden_Positions
+		}
+	}
+	return nil
+}
+
+func (x *StreamLayoutResponse) GetGraph() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Graph
+	}
+	return nil
+}
+
+func (x *StreamLayoutResponse) SetStats(v *LayoutStats) {
+	x.xxx_hidden_Stats = v
+}
+
+func (x *StreamLayoutResponse) SetPositions(v []*NodePosition) {
+	x.xxx_hidden_Positions = &v
+}
+
+func (x *StreamLayoutResponse) SetGraph(v *RandomGraphResponse) {
+	x.xxx_hidden_Graph = v
+}
+
+func (x *StreamLayoutResponse) HasStats() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Stats != nil
+}
+
+func (x *StreamLayoutResponse) HasGraph() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Graph != nil
+}
+
+func (x *StreamLayoutResponse) ClearStats() {
+	x.xxx_hidden_Stats = nil
+}
+
+func (x *StreamLayoutResponse) ClearGraph() {
+	x.xxx_hidden_Graph = nil
+}
+
+type StreamLayoutResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// stats of the layout so far.
+	Stats *LayoutStats
+	// positions of all nodes, not set on the last message.
+	Positions []*NodePosition
+	// graph with the final layout, only set on the last message.
+	Graph *RandomGraphResponse
+}
+
+func (b0 StreamLayoutResponse_builder) Build() *StreamLayoutResponse {
+	m0 := &StreamLayoutResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Stats = b.Stats
+	x.xxx_hidden_Positions = &b.Positions
+	x.xxx_hidden_Graph = b.Graph
+	return m0
+}
+
+// GraphMetadata describes a record of the graph store, with what is needed to reproduce it.
+type GraphMetadata struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GraphId      *string                `protobuf:"bytes,1,opt,name=graph_id,json=graphId"`
+	xxx_hidden_Kind         RecordKind             `protobuf:"varint,2,opt,name=kind,enum=internal.rpc.v1.RecordKind"`
+	xxx_hidden_CreatedAt    int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt    int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_NumNodes     int64                  `protobuf:"varint,5,opt,name=num_nodes,json=numNodes"`
+	xxx_hidden_NumEdges     int64                  `protobuf:"varint,6,opt,name=num_edges,json=numEdges"`
+	xxx_hidden_Params       *RandomGraphRequest    `protobuf:"bytes,7,opt,name=params"`
+	xxx_hidden_FileName     *string                `protobuf:"bytes,8,opt,name=file_name,json=fileName"`
+	xxx_hidden_WalkParams   *RandomGraphRequest    `protobuf:"bytes,9,opt,name=walk_params,json=walkParams"`
+	xxx_hidden_LayoutParams *RandomGraphRequest    `protobuf:"bytes,10,opt,name=layout_params,json=layoutParams"`
+	xxx_hidden_Experiment   *RunExperimentRequest  `protobuf:"bytes,11,opt,name=experiment"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GraphMetadata) Reset() {
+	*x = GraphMetadata{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphMetadata) ProtoMessage() {}
+
+func (x *GraphMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GraphMetadata) GetGraphId() string {
+	if x != nil {
+		if x.xxx_hidden_GraphId != nil {
+			return *x.xxx_hidden_GraphId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GraphMetadata) GetKind() RecordKind {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Kind
+		}
+	}
+	return RecordKind_RECORD_KIND_UNSPECIFIED
+}
+
+func (x *GraphMetadata) GetCreatedAt() int64 {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return 0
+}
+
+func (x *GraphMetadata) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return 0
+}
+
+func (x *GraphMetadata) GetNumNodes() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumNodes
+	}
+	return 0
+}
+
+func (x *GraphMetadata) GetNumEdges() int64 {
+	if x != nil {
+		return x.xxx_hidden_NumEdges
+	}
+	return 0
+}
+
+func (x *GraphMetadata) GetParams() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_Params
+	}
+	return nil
+}
+
+func (x *GraphMetadata) GetFileName() string {
+	if x != nil {
+		if x.xxx_hidden_FileName != nil {
+			return *x.xxx_hidden_FileName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GraphMetadata) GetWalkParams() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_WalkParams
+	}
+	return nil
+}
+
+func (x *GraphMetadata) GetLayoutParams() *RandomGraphRequest {
+	if x != nil {
+		return x.xxx_hidden_LayoutParams
+	}
+	return nil
+}
+
+func (x *GraphMetadata) GetExperiment() *RunExperimentRequest {
+	if x != nil {
+		return x.xxx_hidden_Experiment
+	}
+	return nil
+}
+
+func (x *GraphMetadata) SetGraphId(v string) {
+	x.xxx_hidden_GraphId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
+}
+
+func (x *GraphMetadata) SetKind(v RecordKind) {
+	x.xxx_hidden_Kind = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 11)
+}
+
+func (x *GraphMetadata) SetCreatedAt(v int64) {
+	x.xxx_hidden_CreatedAt = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
+}
+
+func (x *GraphMetadata) SetUpdatedAt(v int64) {
+	x.xxx_hidden_UpdatedAt = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
+}
+
+func (x *GraphMetadata) SetNumNodes(v int64) {
+	x.xxx_hidden_NumNodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 11)
+}
+
+func (x *GraphMetadata) SetNumEdges(v int64) {
+	x.xxx_hidden_NumEdges = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 11)
+}
+
+func (x *GraphMetadata) SetParams(v *RandomGraphRequest) {
+	x.xxx_hidden_Params = v
+}
+
+func (x *GraphMetadata) SetFileName(v string) {
+	x.xxx_hidden_FileName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 11)
+}
+
+func (x *GraphMetadata) SetWalkParams(v *RandomGraphRequest) {
+	x.xxx_hidden_WalkParams = v
+}
+
+func (x *GraphMetadata) SetLayoutParams(v *RandomGraphRequest) {
+	x.xxx_hidden_LayoutParams = v
+}
+
+func (x *GraphMetadata) SetExperiment(v *RunExperimentRequest) {
+	x.xxx_hidden_Experiment = v
+}
+
+func (x *GraphMetadata) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GraphMetadata) HasKind() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GraphMetadata) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GraphMetadata) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GraphMetadata) HasNumNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *GraphMetadata) HasNumEdges() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *GraphMetadata) HasParams() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Params != nil
+}
+
+func (x *GraphMetadata) HasFileName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *GraphMetadata) HasWalkParams() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_WalkParams != nil
+}
+
+func (x *GraphMetadata) HasLayoutParams() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LayoutParams != nil
+}
+
+func (x *GraphMetadata) HasExperiment() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Experiment != nil
+}
+
+func (x *GraphMetadata) ClearGraphId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GraphId = nil
+}
+
+func (x *GraphMetadata) ClearKind() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Kind = RecordKind_RECORD_KIND_UNSPECIFIED
+}
+
+func (x *GraphMetadata) ClearCreatedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CreatedAt = 0
+}
+
+func (x *GraphMetadata) ClearUpdatedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_UpdatedAt = 0
+}
+
+func (x *GraphMetadata) ClearNumNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_NumNodes = 0
+}
+
+func (x *GraphMetadata) ClearNumEdges() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_NumEdges = 0
+}
+
+func (x *GraphMetadata) ClearParams() {
+	x.xxx_hidden_Params = nil
+}
+
+func (x *GraphMetadata) ClearFileName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_FileName = nil
+}
+
+func (x *GraphMetadata) ClearWalkParams() {
+	x.xxx_hidden_WalkParams = nil
+}
+
+func (x *GraphMetadata) ClearLayoutParams() {
+	x.xxx_hidden_LayoutParams = nil
+}
+
+func (x *GraphMetadata) ClearExperiment() {
+	x.xxx_hidden_Experiment = nil
+}
+
+type GraphMetadata_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GraphId *string
+	Kind    *RecordKind
+	// created_at is the unix time in milliseconds at which the record was created.
+	CreatedAt *int64
+	// updated_at is the unix time in milliseconds at which the graph was last walked or laid out.
+	UpdatedAt *int64
+	NumNodes  *int64
+	NumEdges  *int64
+	// params is the request that generated the graph, including its seeds.
+	Params *RandomGraphRequest
+	// file_name is the file that an imported graph was loaded from.
+	FileName *string
+	// walk_params configured the latest walks over the graph.
+	WalkParams *RandomGraphRequest
+	// layout_params configured the latest layout of the graph.
+	LayoutParams *RandomGraphRequest
+	// experiment is the request of an experiment.
+	Experiment *RunExperimentRequest
+}
+
+func (b0 GraphMetadata_builder) Build() *GraphMetadata {
+	m0 := &GraphMetadata{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
+		x.xxx_hidden_GraphId = b.GraphId
+	}
+	if b.Kind != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 11)
+		x.xxx_hidden_Kind = *b.Kind
+	}
+	if b.CreatedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
+		x.xxx_hidden_CreatedAt = *b.CreatedAt
+	}
+	if b.UpdatedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
+		x.xxx_hidden_UpdatedAt = *b.UpdatedAt
+	}
+	if b.NumNodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 11)
+		x.xxx_hidden_NumNodes = *b.NumNodes
+	}
+	if b.NumEdges != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 11)
+		x.xxx_hidden_NumEdges = *b.NumEdges
+	}
+	x.xxx_hidden_Params = b.Params
+	if b.FileName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 11)
+		x.xxx_hidden_FileName = b.FileName
+	}
+	x.xxx_hidden_WalkParams = b.WalkParams
+	x.xxx_hidden_LayoutParams = b.LayoutParams
+	x.xxx_hidden_Experiment = b.Experiment
+	return m0
+}
+
+// GraphRecord is a record of the graph store.
+type GraphRecord struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Metadata *GraphMetadata         `protobuf:"bytes,1,opt,name=metadata"`
+	xxx_hidden_Base     *RandomGraphResponse   `protobuf:"bytes,2,opt,name=base"`
+	xxx_hidden_Graph    *RandomGraphResponse   `protobuf:"bytes,3,opt,name=graph"`
+	xxx_hidden_Results  *RunExperimentResponse `protobuf:"bytes,4,opt,name=results"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GraphRecord) Reset() {
+	*x = GraphRecord{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphRecord) ProtoMessage() {}
+
+func (x *GraphRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GraphRecord) GetMetadata() *GraphMetadata {
+	if x != nil {
+		return x.xxx_hidden_Metadata
+	}
+	return nil
+}
+
+func (x *GraphRecord) GetBase() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Base
+	}
+	return nil
+}
+
+func (x *GraphRecord) GetGraph() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Graph
+	}
+	return nil
+}
+
+func (x *GraphRecord) GetResults() *RunExperimentResponse {
+	if x != nil {
+		return x.xxx_hidden_Results
+	}
+	return nil
+}
+
+func (x *GraphRecord) SetMetadata(v *GraphMetadata) {
+	x.xxx_hidden_Metadata = v
+}
+
+func (x *GraphRecord) SetBase(v *RandomGraphResponse) {
+	x.xxx_hidden_Base = v
+}
+
+func (x *GraphRecord) SetGraph(v *RandomGraphResponse) {
+	x.xxx_hidden_Graph = v
+}
+
+func (x *GraphRecord) SetResults(v *RunExperimentResponse) {
+	x.xxx_hidden_Results = v
+}
+
+func (x *GraphRecord) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Metadata != nil
+}
+
+func (x *GraphRecord) HasBase() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Base != nil
+}
+
+func (x *GraphRecord) HasGraph() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Graph != nil
+}
+
+func (x *GraphRecord) HasResults() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Results != nil
+}
+
+func (x *GraphRecord) ClearMetadata() {
+	x.xxx_hidden_Metadata = nil
+}
+
+func (x *GraphRecord) ClearBase() {
+	x.xxx_hidden_Base = nil
+}
+
+func (x *GraphRecord) ClearGraph() {
+	x.xxx_hidden_Graph = nil
+}
+
+func (x *GraphRecord) ClearResults() {
+	x.xxx_hidden_Results = nil
+}
+
+type GraphRecord_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Metadata *GraphMetadata
+	// base is the graph before any walks.
+	Base *RandomGraphResponse
+	// graph is the graph as of the latest walks.
+	Graph *RandomGraphResponse
+	// results holds the results of an experiment.
+	Results *RunExperimentResponse
+}
+
+func (b0 GraphRecord_builder) Build() *GraphRecord {
+	m0 := &GraphRecord{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Metadata = b.Metadata
+	x.xxx_hidden_Base = b.Base
+	x.xxx_hidden_Graph = b.Graph
+	x.xxx_hidden_Results = b.Results
+	return m0
+}
+
+type ListGraphsRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Kind        RecordKind             `protobuf:"varint,1,opt,name=kind,enum=internal.rpc.v1.RecordKind"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListGraphsRequest) Reset() {
+	*x = ListGraphsRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGraphsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGraphsRequest) ProtoMessage() {}
+
+func (x *ListGraphsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListGraphsRequest) GetKind() RecordKind {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Kind
+		}
+	}
+	return RecordKind_RECORD_KIND_UNSPECIFIED
+}
+
+func (x *ListGraphsRequest) SetKind(v RecordKind) {
+	x.xxx_hidden_Kind = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *ListGraphsRequest) HasKind() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListGraphsRequest) ClearKind() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Kind = RecordKind_RECORD_KIND_UNSPECIFIED
+}
+
+type ListGraphsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// kind only lists the records of the kind, if set.
+	Kind *RecordKind
+}
+
+func (b0 ListGraphsRequest_builder) Build() *ListGraphsRequest {
+	m0 := &ListGraphsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Kind != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Kind = *b.Kind
+	}
+	return m0
+}
+
+type ListGraphsResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Graphs *[]*GraphMetadata      `protobuf:"bytes,1,rep,name=graphs"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListGraphsResponse) Reset() {
+	*x = ListGraphsResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGraphsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGraphsResponse) ProtoMessage() {}
+
+func (x *ListGraphsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListGraphsResponse) GetGraphs() []*GraphMetadata {
+	if x != nil {
+		if x.xxx_hidden_Graphs != nil {
+			return *x.xxx_hidden_Graphs
+		}
+	}
+	return nil
+}
+
+func (x *ListGraphsResponse) SetGraphs(v []*GraphMetadata) {
+	x.xxx_hidden_Graphs = &v
+}
+
+type ListGraphsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// graphs holds the metadata of the records, most recently created first.
+	Graphs []*GraphMetadata
+}
+
+func (b0 ListGraphsResponse_builder) Build() *ListGraphsResponse {
+	m0 := &ListGraphsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Graphs = &b.Graphs
+	return m0
+}
+
+type GetGraphRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GraphId     *string                `protobuf:"bytes,1,opt,name=graph_id,json=graphId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetGraphRequest) Reset() {
+	*x = GetGraphRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGraphRequest) ProtoMessage() {}
+
+func (x *GetGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetGraphRequest) GetGraphId() string {
+	if x != nil {
+		if x.xxx_hidden_GraphId != nil {
+			return *x.xxx_hidden_GraphId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetGraphRequest) SetGraphId(v string) {
+	x.xxx_hidden_GraphId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetGraphRequest) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetGraphRequest) ClearGraphId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GraphId = nil
+}
+
+type GetGraphRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GraphId *string
+}
+
+func (b0 GetGraphRequest_builder) Build() *GetGraphRequest {
+	m0 := &GetGraphRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_GraphId = b.GraphId
+	}
+	return m0
+}
+
+type GetGraphResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Graph    *RandomGraphResponse   `protobuf:"bytes,1,opt,name=graph"`
+	xxx_hidden_Metadata *GraphMetadata         `protobuf:"bytes,2,opt,name=metadata"`
+	xxx_hidden_Results  *RunExperimentResponse `protobuf:"bytes,3,opt,name=results"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetGraphResponse) Reset() {
+	*x = GetGraphResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGraphResponse) ProtoMessage() {}
+
+func (x *GetGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetGraphResponse) GetGraph() *RandomGraphResponse {
+	if x != nil {
+		return x.xxx_hidden_Graph
+	}
+	return nil
+}
+
+func (x *GetGraphResponse) GetMetadata() *GraphMetadata {
+	if x != nil {
+		return x.xxx_hidden_Metadata
+	}
+	return nil
+}
+
+func (x *GetGraphResponse) GetResults() *RunExperimentResponse {
+	if x != nil {
+		return x.xxx_hidden_Results
+	}
+	return nil
+}
+
+func (x *GetGraphResponse) SetGraph(v *RandomGraphResponse) {
+	x.xxx_hidden_Graph = v
+}
+
+func (x *GetGraphResponse) SetMetadata(v *GraphMetadata) {
+	x.xxx_hidden_Metadata = v
+}
+
+func (x *GetGraphResponse) SetResults(v *RunExperimentResponse) {
+	x.xxx_hidden_Results = v
+}
+
+func (x *GetGraphResponse) HasGraph() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Graph != nil
+}
+
+func (x *GetGraphResponse) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Metadata != nil
+}
+
+func (x *GetGraphResponse) HasResults() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Results != nil
+}
+
+func (x *GetGraphResponse) ClearGraph() {
+	x.xxx_hidden_Graph = nil
+}
+
+func (x *GetGraphResponse) ClearMetadata() {
+	x.xxx_hidden_Metadata = nil
+}
+
+func (x *GetGraphResponse) ClearResults() {
+	x.xxx_hidden_Results = nil
+}
+
+type GetGraphResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// graph as of the latest walks, experiments have no graph.
+	Graph    *RandomGraphResponse
+	Metadata *GraphMetadata
+	// results holds the results of an experiment.
+	Results *RunExperimentResponse
+}
+
+func (b0 GetGraphResponse_builder) Build() *GetGraphResponse {
+	m0 := &GetGraphResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Graph = b.Graph
+	x.xxx_hidden_Metadata = b.Metadata
+	x.xxx_hidden_Results = b.Results
+	return m0
+}
+
+type DeleteGraphRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GraphId     *string                `protobuf:"bytes,1,opt,name=graph_id,json=graphId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteGraphRequest) Reset() {
+	*x = DeleteGraphRequest{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGraphRequest) ProtoMessage() {}
+
+func (x *DeleteGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteGraphRequest) GetGraphId() string {
+	if x != nil {
+		if x.xxx_hidden_GraphId != nil {
+			return *x.xxx_hidden_GraphId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteGraphRequest) SetGraphId(v string) {
+	x.xxx_hidden_GraphId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteGraphRequest) HasGraphId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteGraphRequest) ClearGraphId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GraphId = nil
+}
+
+type DeleteGraphRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GraphId *string
+}
+
+func (b0 DeleteGraphRequest_builder) Build() *DeleteGraphRequest {
+	m0 := &DeleteGraphRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GraphId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_GraphId = b.GraphId
 	}
-	return 0
+	return m0
 }
 
-func (x *RandomGraphRequest) SetSeed1(v uint64) {
-	x.xxx_hidden_Seed1 = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
+type DeleteGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RandomGraphRequest) SetSeed2(v uint64) {
-	x.xxx_hidden_Seed2 = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 11)
+func (x *DeleteGraphResponse) Reset() {
+	*x = DeleteGraphResponse{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RandomGraphRequest) SetNumNodes(v int64) {
-	x.xxx_hidden_NumNodes = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
+func (x *DeleteGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RandomGraphRequest) SetInitialConnected(v int64) {
-	x.xxx_hidden_InitialConnected = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
-}
+func (*DeleteGraphResponse) ProtoMessage() {}
 
-func (x *RandomGraphRequest) SetRewiringProbability(v float64) {
-	x.xxx_hidden_RewiringProbability = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 11)
+func (x *DeleteGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *RandomGraphRequest) SetLayoutIterations(v int64) {
-	x.xxx_hidden_LayoutIterations = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 11)
-}
+type DeleteGraphResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-func (x *RandomGraphRequest) SetLayoutArea(v float64) {
-	x.xxx_hidden_LayoutArea = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 11)
 }
 
-func (x *RandomGraphRequest) SetWalkLength(v int64) {
-	x.xxx_hidden_WalkLength = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 11)
+func (b0 DeleteGraphResponse_builder) Build() *DeleteGraphResponse {
+	m0 := &DeleteGraphResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
 }
 
-func (x *RandomGraphRequest) SetNumWalks(v int64) {
-	x.xxx_hidden_NumWalks = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 11)
+// FieldViolation describes a field of a request that violates its constraints, like the violations
+// reported by protovalidate.
+type FieldViolation struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FieldPath   *string                `protobuf:"bytes,1,opt,name=field_path,json=fieldPath"`
+	xxx_hidden_RuleId      *string                `protobuf:"bytes,2,opt,name=rule_id,json=ruleId"`
+	xxx_hidden_Message     *string                `protobuf:"bytes,3,opt,name=message"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *RandomGraphRequest) SetSeed3(v uint64) {
-	x.xxx_hidden_Seed3 = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 11)
+func (x *FieldViolation) Reset() {
+	*x = FieldViolation{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RandomGraphRequest) SetSeed4(v uint64) {
-	x.xxx_hidden_Seed4 = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 11)
+func (x *FieldViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RandomGraphRequest) HasSeed1() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
-}
+func (*FieldViolation) ProtoMessage() {}
 
-func (x *RandomGraphRequest) HasSeed2() bool {
-	if x == nil {
-		return false
+func (x *FieldViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return mi.MessageOf(x)
 }
 
-func (x *RandomGraphRequest) HasNumNodes() bool {
-	if x == nil {
-		return false
+func (x *FieldViolation) GetFieldPath() string {
+	if x != nil {
+		if x.xxx_hidden_FieldPath != nil {
+			return *x.xxx_hidden_FieldPath
+		}
+		return ""
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+	return ""
 }
 
-func (x *RandomGraphRequest) HasInitialConnected() bool {
-	if x == nil {
-		return false
+func (x *FieldViolation) GetRuleId() string {
+	if x != nil {
+		if x.xxx_hidden_RuleId != nil {
+			return *x.xxx_hidden_RuleId
+		}
+		return ""
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+	return ""
 }
 
-func (x *RandomGraphRequest) HasRewiringProbability() bool {
-	if x == nil {
-		return false
+func (x *FieldViolation) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+	return ""
 }
 
-func (x *RandomGraphRequest) HasLayoutIterations() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+func (x *FieldViolation) SetFieldPath(v string) {
+	x.xxx_hidden_FieldPath = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-func (x *RandomGraphRequest) HasLayoutArea() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+func (x *FieldViolation) SetRuleId(v string) {
+	x.xxx_hidden_RuleId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *RandomGraphRequest) HasWalkLength() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+func (x *FieldViolation) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (x *RandomGraphRequest) HasNumWalks() bool {
+func (x *FieldViolation) HasFieldPath() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *RandomGraphRequest) HasSeed3() bool {
+func (x *FieldViolation) HasRuleId() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *RandomGraphRequest) HasSeed4() bool {
+func (x *FieldViolation) HasMessage() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *RandomGraphRequest) ClearSeed1() {
+func (x *FieldViolation) ClearFieldPath() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Seed1 = 0
+	x.xxx_hidden_FieldPath = nil
 }
 
-func (x *RandomGraphRequest) ClearSeed2() {
+func (x *FieldViolation) ClearRuleId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Seed2 = 0
+	x.xxx_hidden_RuleId = nil
 }
 
-func (x *RandomGraphRequest) ClearNumNodes() {
+func (x *FieldViolation) ClearMessage() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_NumNodes = 0
+	x.xxx_hidden_Message = nil
 }
 
-func (x *RandomGraphRequest) ClearInitialConnected() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_InitialConnected = 0
-}
-
-func (x *RandomGraphRequest) ClearRewiringProbability() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_RewiringProbability = 0
-}
-
-func (x *RandomGraphRequest) ClearLayoutIterations() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_LayoutIterations = 0
-}
-
-func (x *RandomGraphRequest) ClearLayoutArea() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
-	x.xxx_hidden_LayoutArea = 0
-}
-
-func (x *RandomGraphRequest) ClearWalkLength() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
-	x.xxx_hidden_WalkLength = 0
-}
-
-func (x *RandomGraphRequest) ClearNumWalks() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
-	x.xxx_hidden_NumWalks = 0
-}
-
-func (x *RandomGraphRequest) ClearSeed3() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
-	x.xxx_hidden_Seed3 = 0
-}
-
-func (x *RandomGraphRequest) ClearSeed4() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
-	x.xxx_hidden_Seed4 = 0
-}
-
-type RandomGraphRequest_builder struct {
+type FieldViolation_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Seed1               *uint64
-	Seed2               *uint64
-	NumNodes            *int64
-	InitialConnected    *int64
-	RewiringProbability *float64
-	LayoutIterations    *int64
-	LayoutArea          *float64
-	WalkLength          *int64
-	NumWalks            *int64
-	Seed3               *uint64
-	Seed4               *uint64
+	// field_path is the path of the field within the request, such as "sybil_region.num_nodes".
+	FieldPath *string
+	// rule_id identifies the constraint, such as "int64.gte" for the buf.validate rules of the fields or
+	// "trustd.max_nodes" for the caps of the server and the constraints between fields.
+	RuleId  *string
+	Message *string
 }
 
-func (b0 RandomGraphRequest_builder) Build() *RandomGraphRequest {
-	m0 := &RandomGraphRequest{}
+func (b0 FieldViolation_builder) Build() *FieldViolation {
+	m0 := &FieldViolation{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Seed1 != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
-		x.xxx_hidden_Seed1 = *b.Seed1
-	}
-	if b.Seed2 != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 11)
-		x.xxx_hidden_Seed2 = *b.Seed2
-	}
-	if b.NumNodes != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
-		x.xxx_hidden_NumNodes = *b.NumNodes
-	}
-	if b.InitialConnected != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
-		x.xxx_hidden_InitialConnected = *b.InitialConnected
-	}
-	if b.RewiringProbability != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 11)
-		x.xxx_hidden_RewiringProbability = *b.RewiringProbability
-	}
-	if b.LayoutIterations != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 11)
-		x.xxx_hidden_LayoutIterations = *b.LayoutIterations
-	}
-	if b.LayoutArea != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 11)
-		x.xxx_hidden_LayoutArea = *b.LayoutArea
+	if b.FieldPath != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_FieldPath = b.FieldPath
 	}
-	if b.WalkLength != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 11)
-		x.xxx_hidden_WalkLength = *b.WalkLength
-	}
-	if b.NumWalks != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 11)
-		x.xxx_hidden_NumWalks = *b.NumWalks
-	}
-	if b.Seed3 != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 11)
-		x.xxx_hidden_Seed3 = *b.Seed3
+	if b.RuleId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_RuleId = b.RuleId
 	}
-	if b.Seed4 != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 11)
-		x.xxx_hidden_Seed4 = *b.Seed4
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Message = b.Message
 	}
 	return m0
 }
 
-type RandomGraphResponse struct {
-	state            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Nodes *[]*Node               `protobuf:"bytes,1,rep,name=nodes"`
-	xxx_hidden_Edges *[]*Edge               `protobuf:"bytes,2,rep,name=edges"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Violations is attached as a detail to the invalid argument errors of requests that fail validation.
+type Violations struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Violations *[]*FieldViolation     `protobuf:"bytes,1,rep,name=violations"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *RandomGraphResponse) Reset() {
-	*x = RandomGraphResponse{}
-	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[5]
+func (x *Violations) Reset() {
+	*x = Violations{}
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RandomGraphResponse) String() string {
+func (x *Violations) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandomGraphResponse) ProtoMessage() {}
+func (*Violations) ProtoMessage() {}
 
-func (x *RandomGraphResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[5]
+func (x *Violations) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_rpc_v1_rpc_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,146 +11563,698 @@ func (x *RandomGraphResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *RandomGraphResponse) GetNodes() []*Node {
-	if x != nil {
-		if x.xxx_hidden_Nodes != nil {
-			return *x.xxx_hidden_Nodes
-		}
-	}
-	return nil
-}
-
-func (x *RandomGraphResponse) GetEdges() []*Edge {
+func (x *Violations) GetViolations() []*FieldViolation {
 	if x != nil {
-		if x.xxx_hidden_Edges != nil {
-			return *x.xxx_hidden_Edges
+		if x.xxx_hidden_Violations != nil {
+			return *x.xxx_hidden_Violations
 		}
 	}
 	return nil
 }
 
-func (x *RandomGraphResponse) SetNodes(v []*Node) {
-	x.xxx_hidden_Nodes = &v
-}
-
-func (x *RandomGraphResponse) SetEdges(v []*Edge) {
-	x.xxx_hidden_Edges = &v
+func (x *Violations) SetViolations(v []*FieldViolation) {
+	x.xxx_hidden_Violations = &v
 }
 
-type RandomGraphResponse_builder struct {
+type Violations_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Nodes []*Node
-	Edges []*Edge
+	Violations []*FieldViolation
 }
 
-func (b0 RandomGraphResponse_builder) Build() *RandomGraphResponse {
-	m0 := &RandomGraphResponse{}
+func (b0 Violations_builder) Build() *Violations {
+	m0 := &Violations{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Nodes = &b.Nodes
-	x.xxx_hidden_Edges = &b.Edges
+	x.xxx_hidden_Violations = &b.Violations
 	return m0
 }
 
 var File_internal_rpc_v1_rpc_proto protoreflect.FileDescriptor
 
-var file_internal_rpc_v1_rpc_proto_rawDesc = string([]byte{
-	0x0a, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x76,
-	0x31, 0x2f, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x22, 0x26, 0x0a, 0x08,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x01, 0x79, 0x22, 0x20, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x90, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x35, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5a, 0x0a, 0x04, 0x45, 0x64, 0x67,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xf5, 0x02, 0x0a, 0x12, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x65, 0x65, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x65, 0x65,
-	0x64, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x65, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x73, 0x65, 0x65, 0x64, 0x32, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f,
-	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x75, 0x6d,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x77, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70,
-	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x13, 0x72, 0x65, 0x77, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f,
-	0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x10, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x72, 0x65,
-	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41,
-	0x72, 0x65, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6b, 0x4c, 0x65,
-	0x6e, 0x67, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6b,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x61, 0x6c, 0x6b,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x65, 0x64, 0x33, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x73, 0x65, 0x65, 0x64, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x65, 0x64, 0x34,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x65, 0x65, 0x64, 0x34, 0x22, 0x6f, 0x0a,
-	0x13, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x32, 0x68,
-	0x0a, 0x0c, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58,
-	0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x23, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xac, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x42, 0x08, 0x52, 0x70, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x64, 0x76, 0x64, 0x76, 0x2f, 0x74,
-	0x72, 0x75, 0x73, 0x74, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x72,
-	0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x70, 0x63, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x52,
-	0x58, 0xaa, 0x02, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x52, 0x70, 0x63,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5c, 0x52,
-	0x70, 0x63, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5c, 0x52, 0x70, 0x63, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x3a, 0x3a,
-	0x52, 0x70, 0x63, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x70, 0xe8, 0x07,
-})
-
-var file_internal_rpc_v1_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+const file_internal_rpc_v1_rpc_proto_rawDesc = "" +
+	"\n" +
+	"\x19internal/rpc/v1/rpc.proto\x12\x0finternal.rpc.v1\x1a\x1bbuf/validate/validate.proto\"&\n" +
+	"\bPosition\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x03R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x03R\x01y\" \n" +
+	"\bNodeData\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\"\x90\x01\n" +
+	"\x04Node\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\bposition\x18\x02 \x01(\v2\x19.internal.rpc.v1.PositionR\bposition\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.internal.rpc.v1.NodeDataR\x04data\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\"r\n" +
+	"\x04Edge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\"\xe0\x01\n" +
+	"\x04Walk\x12,\n" +
+	"\x05owner\x18\x01 \x01(\x0e2\x16.internal.rpc.v1.PartyR\x05owner\x12\x1d\n" +
+	"\n" +
+	"start_node\x18\x02 \x01(\tR\tstartNode\x12\x19\n" +
+	"\bnode_ids\x18\x03 \x03(\tR\anodeIds\x12\x19\n" +
+	"\bedge_ids\x18\x04 \x03(\tR\aedgeIds\x12\x1a\n" +
+	"\binstance\x18\x05 \x01(\x03R\binstance\x12\x18\n" +
+	"\aescaped\x18\x06 \x01(\bR\aescaped\x12\x1f\n" +
+	"\vescape_step\x18\a \x01(\x03R\n" +
+	"escapeStep\"\xf8\x01\n" +
+	"\x10WalkIntersection\x12\x19\n" +
+	"\bbob_walk\x18\x01 \x01(\x03R\abobWalk\x12\x19\n" +
+	"\bada_walk\x18\x02 \x01(\x03R\aadaWalk\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x17\n" +
+	"\aedge_id\x18\x04 \x01(\tR\x06edgeId\x12\x19\n" +
+	"\bbob_step\x18\x05 \x01(\x03R\abobStep\x12\x19\n" +
+	"\bada_step\x18\x06 \x01(\x03R\aadaStep\x12\"\n" +
+	"\rpath_node_ids\x18\a \x03(\tR\vpathNodeIds\x12\"\n" +
+	"\rpath_edge_ids\x18\b \x03(\tR\vpathEdgeIds\"\xbd\x01\n" +
+	"\x13WattsStrogatzParams\x12$\n" +
+	"\tnum_nodes\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bnumNodes\x124\n" +
+	"\x11initial_connected\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x10initialConnected\x12J\n" +
+	"\x14rewiring_probability\x18\x03 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x13rewiringProbability\"|\n" +
+	"\x10ErdosRenyiParams\x12$\n" +
+	"\tnum_nodes\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bnumNodes\x12B\n" +
+	"\x10edge_probability\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x0fedgeProbability\"q\n" +
+	"\x14BarabasiAlbertParams\x12'\n" +
+	"\tnum_nodes\x18\x01 \x01(\x03B\n" +
+	"\xbaH\a\xc8\x01\x01\"\x02(\x02R\bnumNodes\x120\n" +
+	"\x0eedges_per_node\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\xc8\x01\x01\"\x02(\x01R\fedgesPerNode\"\xa0\x01\n" +
+	"\x15StochasticBlockParams\x12-\n" +
+	"\vblock_sizes\x18\x01 \x03(\x03B\f\xbaH\t\x92\x01\x06\"\x04\"\x02(\x00R\n" +
+	"blockSizes\x12*\n" +
+	"\x04p_in\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x03pIn\x12,\n" +
+	"\x05p_out\x18\x03 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x04pOut\"\\\n" +
+	"\x13RandomRegularParams\x12$\n" +
+	"\tnum_nodes\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bnumNodes\x12\x1f\n" +
+	"\x06degree\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x06degree\"k\n" +
+	"\rLatticeParams\x12\x1b\n" +
+	"\x04rows\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x04rows\x12!\n" +
+	"\acolumns\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\acolumns\x12\x1a\n" +
+	"\bperiodic\x18\x03 \x01(\bR\bperiodic\"\xc1\x02\n" +
+	"\vEdgeWeights\x12G\n" +
+	"\fdistribution\x18\x01 \x01(\x0e2#.internal.rpc.v1.WeightDistributionR\fdistribution\x12\x19\n" +
+	"\x03min\x18\x02 \x01(\x01B\a\xbaH\x04\x12\x02@\x01R\x03min\x12\x19\n" +
+	"\x03max\x18\x03 \x01(\x01B\a\xbaH\x04\x12\x02@\x01R\x03max\x12$\n" +
+	"\x04mean\x18\x04 \x01(\x01B\x10\xbaH\r\x12\v@\x01!\x00\x00\x00\x00\x00\x00\x00\x00R\x04mean\x12\x17\n" +
+	"\x02mu\x18\x05 \x01(\x01B\a\xbaH\x04\x12\x02@\x01R\x02mu\x12&\n" +
+	"\x05sigma\x18\x06 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\x05sigma\x12&\n" +
+	"\x05alpha\x18\a \x01(\x01B\x10\xbaH\r\x12\v@\x01!\x00\x00\x00\x00\x00\x00\x00\x00R\x05alpha\x12$\n" +
+	"\x04beta\x18\b \x01(\x01B\x10\xbaH\r\x12\v@\x01!\x00\x00\x00\x00\x00\x00\x00\x00R\x04beta\"\xe1\x01\n" +
+	"\vSybilRegion\x12$\n" +
+	"\tnum_nodes\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bnumNodes\x124\n" +
+	"\x11initial_connected\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x10initialConnected\x12J\n" +
+	"\x14rewiring_probability\x18\x03 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x13rewiringProbability\x12*\n" +
+	"\fattack_edges\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vattackEdges\"\xbb\x02\n" +
+	"\x13ForceDirectedParams\x12'\n" +
+	"\n" +
+	"iterations\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\n" +
+	"iterations\x12$\n" +
+	"\x04area\x18\x02 \x01(\x01B\x10\xbaH\r\x12\v@\x01!\x00\x00\x00\x00\x00\x00\x00\x00R\x04area\x12A\n" +
+	"\trepulsion\x18\x03 \x01(\x0e2#.internal.rpc.v1.RepulsionAlgorithmR\trepulsion\x12&\n" +
+	"\x05theta\x18\x04 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\x05theta\x12:\n" +
+	"\acooling\x18\x05 \x01(\x0e2 .internal.rpc.v1.CoolingScheduleR\acooling\x12.\n" +
+	"\ttolerance\x18\x06 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\ttolerance\":\n" +
+	"\x0eCircularParams\x12(\n" +
+	"\x06radius\x18\x01 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\x06radius\"8\n" +
+	"\x0eSpectralParams\x12&\n" +
+	"\x05scale\x18\x01 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\x05scale\"o\n" +
+	"\x11KamadaKawaiParams\x12'\n" +
+	"\n" +
+	"iterations\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\n" +
+	"iterations\x121\n" +
+	"\vedge_length\x18\x02 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\n" +
+	"edgeLength\"\x80\x01\n" +
+	"\x12HierarchicalParams\x125\n" +
+	"\rlayer_spacing\x18\x01 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\flayerSpacing\x123\n" +
+	"\fnode_spacing\x18\x02 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\vnodeSpacing\"\x9f\x0f\n" +
+	"\x12RandomGraphRequest\x12\x14\n" +
+	"\x05seed1\x18\x01 \x01(\x04R\x05seed1\x12\x14\n" +
+	"\x05seed2\x18\x02 \x01(\x04R\x05seed2\x12$\n" +
+	"\tnum_nodes\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bnumNodes\x124\n" +
+	"\x11initial_connected\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x10initialConnected\x12J\n" +
+	"\x14rewiring_probability\x18\x05 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x13rewiringProbability\x124\n" +
+	"\x11layout_iterations\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x10layoutIterations\x121\n" +
+	"\vlayout_area\x18\a \x01(\x01B\x10\xbaH\r\x12\v@\x01!\x00\x00\x00\x00\x00\x00\x00\x00R\n" +
+	"layoutArea\x12(\n" +
+	"\vwalk_length\x18\b \x01(\x03B\a\xbaH\x04\"\x02(\x00R\n" +
+	"walkLength\x12$\n" +
+	"\tnum_walks\x18\t \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bnumWalks\x12\x14\n" +
+	"\x05seed3\x18\n" +
+	" \x01(\x04R\x05seed3\x12\x14\n" +
+	"\x05seed4\x18\v \x01(\x04R\x05seed4\x126\n" +
+	"\twalk_mode\x18\f \x01(\x0e2\x19.internal.rpc.v1.WalkModeR\bwalkMode\x12?\n" +
+	"\fsybil_region\x18\r \x01(\v2\x1c.internal.rpc.v1.SybilRegionR\vsybilRegion\x12M\n" +
+	"\x0ewatts_strogatz\x18\x0e \x01(\v2$.internal.rpc.v1.WattsStrogatzParamsH\x00R\rwattsStrogatz\x12D\n" +
+	"\verdos_renyi\x18\x0f \x01(\v2!.internal.rpc.v1.ErdosRenyiParamsH\x00R\n" +
+	"erdosRenyi\x12P\n" +
+	"\x0fbarabasi_albert\x18\x10 \x01(\v2%.internal.rpc.v1.BarabasiAlbertParamsH\x00R\x0ebarabasiAlbert\x12S\n" +
+	"\x10stochastic_block\x18\x11 \x01(\v2&.internal.rpc.v1.StochasticBlockParamsH\x00R\x0fstochasticBlock\x12M\n" +
+	"\x0erandom_regular\x18\x12 \x01(\v2$.internal.rpc.v1.RandomRegularParamsH\x00R\rrandomRegular\x12:\n" +
+	"\alattice\x18\x13 \x01(\v2\x1e.internal.rpc.v1.LatticeParamsH\x00R\alattice\x12\x1b\n" +
+	"\bgraph_id\x18\x14 \x01(\tH\x00R\agraphId\x12N\n" +
+	"\x10layout_repulsion\x18\x15 \x01(\x0e2#.internal.rpc.v1.RepulsionAlgorithmR\x0flayoutRepulsion\x123\n" +
+	"\flayout_theta\x18\x16 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\vlayoutTheta\x12G\n" +
+	"\x0elayout_cooling\x18\x17 \x01(\x0e2 .internal.rpc.v1.CoolingScheduleR\rlayoutCooling\x12;\n" +
+	"\x10layout_tolerance\x18\x18 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\x0flayoutTolerance\x12M\n" +
+	"\x0eforce_directed\x18\x19 \x01(\v2$.internal.rpc.v1.ForceDirectedParamsH\x01R\rforceDirected\x12=\n" +
+	"\bcircular\x18\x1a \x01(\v2\x1f.internal.rpc.v1.CircularParamsH\x01R\bcircular\x12=\n" +
+	"\bspectral\x18\x1b \x01(\v2\x1f.internal.rpc.v1.SpectralParamsH\x01R\bspectral\x12G\n" +
+	"\fkamada_kawai\x18\x1c \x01(\v2\".internal.rpc.v1.KamadaKawaiParamsH\x01R\vkamadaKawai\x12I\n" +
+	"\fhierarchical\x18\x1d \x01(\v2#.internal.rpc.v1.HierarchicalParamsH\x01R\fhierarchical\x12?\n" +
+	"\fedge_weights\x18\x1e \x01(\v2\x1c.internal.rpc.v1.EdgeWeightsR\vedgeWeights\x123\n" +
+	"\blaziness\x18\x1f \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\blaziness\x12\x1a\n" +
+	"\bdirected\x18  \x01(\bR\bdirected\x129\n" +
+	"\vreciprocity\x18! \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\vreciprocity\x12#\n" +
+	"\rinclude_stats\x18\" \x01(\bR\fincludeStatsB\v\n" +
+	"\tgeneratorB\b\n" +
+	"\x06layout\"c\n" +
+	"\vLayoutStats\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x01 \x01(\x03R\n" +
+	"iterations\x12\x16\n" +
+	"\x06energy\x18\x02 \x01(\x01R\x06energy\x12\x1c\n" +
+	"\tconverged\x18\x03 \x01(\bR\tconverged\"\xe4\x03\n" +
+	"\x13RandomGraphResponse\x12+\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x15.internal.rpc.v1.NodeR\x05nodes\x12+\n" +
+	"\x05edges\x18\x02 \x03(\v2\x15.internal.rpc.v1.EdgeR\x05edges\x12+\n" +
+	"\x05walks\x18\x03 \x03(\v2\x15.internal.rpc.v1.WalkR\x05walks\x12E\n" +
+	"\fintersection\x18\x04 \x01(\v2!.internal.rpc.v1.WalkIntersectionR\fintersection\x124\n" +
+	"\x06layout\x18\x05 \x01(\v2\x1c.internal.rpc.v1.LayoutStatsR\x06layout\x12\x1a\n" +
+	"\bdirected\x18\x06 \x01(\bR\bdirected\x121\n" +
+	"\x05stats\x18\a \x01(\v2\x1b.internal.rpc.v1.GraphStatsR\x05stats\x12:\n" +
+	"\bmanifest\x18\b \x01(\v2\x1e.internal.rpc.v1.GraphManifestR\bmanifest\x12\x1e\n" +
+	"\vbob_node_id\x18\t \x01(\tR\tbobNodeId\x12\x1e\n" +
+	"\vada_node_id\x18\n" +
+	" \x01(\tR\tadaNodeId\"l\n" +
+	"\tStageSeed\x123\n" +
+	"\x05stage\x18\x01 \x01(\tB\x1d\xbaH\x1ar\x18R\x05graphR\brelayoutR\x05walksR\x05stage\x12\x14\n" +
+	"\x05seed1\x18\x02 \x01(\x04R\x05seed1\x12\x14\n" +
+	"\x05seed2\x18\x03 \x01(\x04R\x05seed2\"@\n" +
+	"\x10AlgorithmVersion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\xab\x02\n" +
+	"\rGraphManifest\x12=\n" +
+	"\arequest\x18\x01 \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\arequest\x12?\n" +
+	"\brelayout\x18\x02 \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\brelayout\x120\n" +
+	"\x05seeds\x18\x03 \x03(\v2\x1a.internal.rpc.v1.StageSeedR\x05seeds\x12A\n" +
+	"\n" +
+	"algorithms\x18\x04 \x03(\v2!.internal.rpc.v1.AlgorithmVersionR\n" +
+	"algorithms\x12%\n" +
+	"\x0etrustd_version\x18\x05 \x01(\tR\rtrustdVersion\"K\n" +
+	"\rReplayRequest\x12:\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x1e.internal.rpc.v1.GraphManifestR\bmanifest\"\xfa\x03\n" +
+	"\n" +
+	"GraphStats\x12\x1b\n" +
+	"\tnum_nodes\x18\x01 \x01(\x03R\bnumNodes\x12\x1b\n" +
+	"\tnum_edges\x18\x02 \x01(\x03R\bnumEdges\x12\x1d\n" +
+	"\n" +
+	"min_degree\x18\x03 \x01(\x03R\tminDegree\x12\x1d\n" +
+	"\n" +
+	"max_degree\x18\x04 \x01(\x03R\tmaxDegree\x12\x1f\n" +
+	"\vmean_degree\x18\x05 \x01(\x01R\n" +
+	"meanDegree\x12)\n" +
+	"\x10degree_histogram\x18\x06 \x03(\x03R\x0fdegreeHistogram\x12-\n" +
+	"\x12average_clustering\x18\a \x01(\x01R\x11averageClustering\x12.\n" +
+	"\x13average_path_length\x18\b \x01(\x01R\x11averagePathLength\x12\x1a\n" +
+	"\bdiameter\x18\t \x01(\x03R\bdiameter\x12%\n" +
+	"\x0enum_components\x18\n" +
+	" \x01(\x03R\rnumComponents\x124\n" +
+	"\x16largest_component_size\x18\v \x01(\x03R\x14largestComponentSize\x12$\n" +
+	"\rassortativity\x18\f \x01(\x01R\rassortativity\x12\x14\n" +
+	"\x05sigma\x18\r \x01(\x01R\x05sigma\x12\x14\n" +
+	"\x05omega\x18\x0e \x01(\x01R\x05omega\"w\n" +
+	"\vGraphSource\x12\x1b\n" +
+	"\bgraph_id\x18\x01 \x01(\tH\x00R\agraphId\x12A\n" +
+	"\bgenerate\x18\x02 \x01(\v2#.internal.rpc.v1.RandomGraphRequestH\x00R\bgenerateB\b\n" +
+	"\x06source\"e\n" +
+	"\x10LoadGraphRequest\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x124\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1c.internal.rpc.v1.GraphFormatR\x06format\"h\n" +
+	"\x11LoadGraphResponse\x12\x19\n" +
+	"\bgraph_id\x18\x01 \x01(\tR\agraphId\x12\x1b\n" +
+	"\tnum_nodes\x18\x02 \x01(\x03R\bnumNodes\x12\x1b\n" +
+	"\tnum_edges\x18\x03 \x01(\x03R\bnumEdges\"\x80\x01\n" +
+	"\x12ExportGraphRequest\x124\n" +
+	"\x06source\x18\x01 \x01(\v2\x1c.internal.rpc.v1.GraphSourceR\x06source\x124\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1c.internal.rpc.v1.GraphFormatR\x06format\"o\n" +
+	"\x13ExportGraphResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\"\x82\x02\n" +
+	"\x0ePageRankParams\x121\n" +
+	"\adamping\x18\x01 \x01(\x01B\x17\xbaH\x14\x12\x12\x11\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\adamping\x127\n" +
+	"\x06method\x18\x02 \x01(\x0e2\x1f.internal.rpc.v1.PageRankMethodR\x06method\x12.\n" +
+	"\x0emax_iterations\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\rmaxIterations\x12.\n" +
+	"\ttolerance\x18\x04 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\ttolerance\x12$\n" +
+	"\tnum_walks\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bnumWalks\"\xe1\x01\n" +
+	"\x10EigenTrustParams\x12A\n" +
+	"\x10pre_trust_weight\x18\x01 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?!\x00\x00\x00\x00\x00\x00\x00\x00R\x0epreTrustWeight\x12*\n" +
+	"\x11uniform_pre_trust\x18\x02 \x01(\bR\x0funiformPreTrust\x12.\n" +
+	"\x0emax_iterations\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\rmaxIterations\x12.\n" +
+	"\ttolerance\x18\x04 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\ttolerance\"\x12\n" +
+	"\x10TidalTrustParams\">\n" +
+	"\x0eAdvogatoParams\x12,\n" +
+	"\n" +
+	"capacities\x18\x01 \x03(\x03B\f\xbaH\t\x92\x01\x06\"\x04\"\x02(\x00R\n" +
+	"capacities\"\xa5\x03\n" +
+	"\x13ComputeTrustRequest\x124\n" +
+	"\x06source\x18\x01 \x01(\v2\x1c.internal.rpc.v1.GraphSourceR\x06source\x12\x14\n" +
+	"\x05seed1\x18\x02 \x01(\x04R\x05seed1\x12\x14\n" +
+	"\x05seed2\x18\x03 \x01(\x04R\x05seed2\x12W\n" +
+	"\x16personalized_page_rank\x18\x04 \x01(\v2\x1f.internal.rpc.v1.PageRankParamsH\x00R\x14personalizedPageRank\x12D\n" +
+	"\veigen_trust\x18\x05 \x01(\v2!.internal.rpc.v1.EigenTrustParamsH\x00R\n" +
+	"eigenTrust\x12D\n" +
+	"\vtidal_trust\x18\x06 \x01(\v2!.internal.rpc.v1.TidalTrustParamsH\x00R\n" +
+	"tidalTrust\x12=\n" +
+	"\badvogato\x18\a \x01(\v2\x1f.internal.rpc.v1.AdvogatoParamsH\x00R\badvogatoB\b\n" +
+	"\x06metric\"\xcc\x02\n" +
+	"\x14ComputeTrustResponse\x12I\n" +
+	"\x06scores\x18\x01 \x03(\v21.internal.rpc.v1.ComputeTrustResponse.ScoresEntryR\x06scores\x12$\n" +
+	"\x0esource_node_id\x18\x02 \x01(\tR\fsourceNodeId\x12$\n" +
+	"\x0etarget_node_id\x18\x03 \x01(\tR\ftargetNodeId\x12!\n" +
+	"\ftarget_score\x18\x04 \x01(\x01R\vtargetScore\x12\x1f\n" +
+	"\vtarget_rank\x18\x05 \x01(\x03R\n" +
+	"targetRank\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x06 \x01(\x03R\n" +
+	"iterations\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x81\x01\n" +
+	"\x0eMaxFlowRequest\x124\n" +
+	"\x06source\x18\x01 \x01(\v2\x1c.internal.rpc.v1.GraphSourceR\x06source\x129\n" +
+	"\bcapacity\x18\x02 \x01(\x0e2\x1d.internal.rpc.v1.FlowCapacityR\bcapacity\"\xd1\x01\n" +
+	"\x0fMaxFlowResponse\x12$\n" +
+	"\x0esource_node_id\x18\x01 \x01(\tR\fsourceNodeId\x12$\n" +
+	"\x0etarget_node_id\x18\x02 \x01(\tR\ftargetNodeId\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12 \n" +
+	"\fcut_edge_ids\x18\x04 \x03(\tR\n" +
+	"cutEdgeIds\x12:\n" +
+	"\x05graph\x18\x05 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x05graph\"\x92\x01\n" +
+	"\x14ShortestPathsRequest\x124\n" +
+	"\x06source\x18\x01 \x01(\v2\x1c.internal.rpc.v1.GraphSourceR\x06source\x12-\n" +
+	"\x04cost\x18\x02 \x01(\x0e2\x19.internal.rpc.v1.PathCostR\x04cost\x12\x15\n" +
+	"\x01k\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x01k\"U\n" +
+	"\tTrustPath\x12\x19\n" +
+	"\bnode_ids\x18\x01 \x03(\tR\anodeIds\x12\x19\n" +
+	"\bedge_ids\x18\x02 \x03(\tR\aedgeIds\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\"\xd1\x01\n" +
+	"\x15ShortestPathsResponse\x12$\n" +
+	"\x0esource_node_id\x18\x01 \x01(\tR\fsourceNodeId\x12$\n" +
+	"\x0etarget_node_id\x18\x02 \x01(\tR\ftargetNodeId\x120\n" +
+	"\x05paths\x18\x03 \x03(\v2\x1a.internal.rpc.v1.TrustPathR\x05paths\x12:\n" +
+	"\x05graph\x18\x04 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x05graph\"I\n" +
+	"\x11GraphStatsRequest\x124\n" +
+	"\x06source\x18\x01 \x01(\v2\x1c.internal.rpc.v1.GraphSourceR\x06source\"G\n" +
+	"\x12GraphStatsResponse\x121\n" +
+	"\x05stats\x18\x01 \x01(\v2\x1b.internal.rpc.v1.GraphStatsR\x05stats\"e\n" +
+	"\n" +
+	"Int64Sweep\x12\x1d\n" +
+	"\x05start\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x05start\x12\x1b\n" +
+	"\x04stop\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x04stop\x12\x1b\n" +
+	"\x04step\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x04step\"\x81\x01\n" +
+	"\vDoubleSweep\x12&\n" +
+	"\x05start\x18\x01 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\x05start\x12$\n" +
+	"\x04stop\x18\x02 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\x04stop\x12$\n" +
+	"\x04step\x18\x03 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00R\x04step\"\xcf\x03\n" +
+	"\x14RunExperimentRequest\x127\n" +
+	"\x04base\x18\x01 \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\x04base\x128\n" +
+	"\tnum_nodes\x18\x02 \x01(\v2\x1b.internal.rpc.v1.Int64SweepR\bnumNodes\x12H\n" +
+	"\x11initial_connected\x18\x03 \x01(\v2\x1b.internal.rpc.v1.Int64SweepR\x10initialConnected\x12O\n" +
+	"\x14rewiring_probability\x18\x04 \x01(\v2\x1c.internal.rpc.v1.DoubleSweepR\x13rewiringProbability\x12<\n" +
+	"\vwalk_length\x18\x05 \x01(\v2\x1b.internal.rpc.v1.Int64SweepR\n" +
+	"walkLength\x128\n" +
+	"\tnum_walks\x18\x06 \x01(\v2\x1b.internal.rpc.v1.Int64SweepR\bnumWalks\x121\n" +
+	"\x05seeds\x18\a \x01(\v2\x1b.internal.rpc.v1.Int64SweepR\x05seeds\"\xe9\x02\n" +
+	"\x10ExperimentResult\x12\x1b\n" +
+	"\tnum_nodes\x18\x01 \x01(\x03R\bnumNodes\x12+\n" +
+	"\x11initial_connected\x18\x02 \x01(\x03R\x10initialConnected\x121\n" +
+	"\x14rewiring_probability\x18\x03 \x01(\x01R\x13rewiringProbability\x12\x1f\n" +
+	"\vwalk_length\x18\x04 \x01(\x03R\n" +
+	"walkLength\x12\x1b\n" +
+	"\tnum_walks\x18\x05 \x01(\x03R\bnumWalks\x12\x12\n" +
+	"\x04runs\x18\x06 \x01(\x03R\x04runs\x12+\n" +
+	"\x11intersection_rate\x18\a \x01(\x01R\x10intersectionRate\x12*\n" +
+	"\x11mean_meeting_step\x18\b \x01(\x01R\x0fmeanMeetingStep\x12-\n" +
+	"\x12escape_probability\x18\t \x01(\x01R\x11escapeProbability\"y\n" +
+	"\x15RunExperimentResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.internal.rpc.v1.ExperimentResultR\aresults\x12#\n" +
+	"\rexperiment_id\x18\x02 \x01(\tR\fexperimentId\"Q\n" +
+	"\x12CreateGraphRequest\x12;\n" +
+	"\x06params\x18\x01 \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\x06params\"l\n" +
+	"\x13CreateGraphResponse\x12\x19\n" +
+	"\bgraph_id\x18\x01 \x01(\tR\agraphId\x12:\n" +
+	"\x05graph\x18\x02 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x05graph\"i\n" +
+	"\x0fRunWalksRequest\x12\x19\n" +
+	"\bgraph_id\x18\x01 \x01(\tR\agraphId\x12;\n" +
+	"\x06params\x18\x02 \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\x06params\"N\n" +
+	"\x10RunWalksResponse\x12:\n" +
+	"\x05graph\x18\x01 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x05graph\"i\n" +
+	"\x0fRelayoutRequest\x12\x19\n" +
+	"\bgraph_id\x18\x01 \x01(\tR\agraphId\x12;\n" +
+	"\x06params\x18\x02 \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\x06params\"N\n" +
+	"\x10RelayoutResponse\x12:\n" +
+	"\x05graph\x18\x01 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x05graph\"\x9a\x01\n" +
+	"\x13StreamLayoutRequest\x12\x19\n" +
+	"\bgraph_id\x18\x01 \x01(\tR\agraphId\x12;\n" +
+	"\x06params\x18\x02 \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\x06params\x12+\n" +
+	"\x11snapshot_interval\x18\x03 \x01(\x03R\x10snapshotInterval\"^\n" +
+	"\fNodePosition\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x125\n" +
+	"\bposition\x18\x02 \x01(\v2\x19.internal.rpc.v1.PositionR\bposition\"\xc3\x01\n" +
+	"\x14StreamLayoutResponse\x122\n" +
+	"\x05stats\x18\x01 \x01(\v2\x1c.internal.rpc.v1.LayoutStatsR\x05stats\x12;\n" +
+	"\tpositions\x18\x02 \x03(\v2\x1d.internal.rpc.v1.NodePositionR\tpositions\x12:\n" +
+	"\x05graph\x18\x03 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x05graph\"\x84\x04\n" +
+	"\rGraphMetadata\x12\x19\n" +
+	"\bgraph_id\x18\x01 \x01(\tR\agraphId\x12/\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1b.internal.rpc.v1.RecordKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\x12\x1b\n" +
+	"\tnum_nodes\x18\x05 \x01(\x03R\bnumNodes\x12\x1b\n" +
+	"\tnum_edges\x18\x06 \x01(\x03R\bnumEdges\x12;\n" +
+	"\x06params\x18\a \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\x06params\x12\x1b\n" +
+	"\tfile_name\x18\b \x01(\tR\bfileName\x12D\n" +
+	"\vwalk_params\x18\t \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\n" +
+	"walkParams\x12H\n" +
+	"\rlayout_params\x18\n" +
+	" \x01(\v2#.internal.rpc.v1.RandomGraphRequestR\flayoutParams\x12E\n" +
+	"\n" +
+	"experiment\x18\v \x01(\v2%.internal.rpc.v1.RunExperimentRequestR\n" +
+	"experiment\"\x81\x02\n" +
+	"\vGraphRecord\x12:\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1e.internal.rpc.v1.GraphMetadataR\bmetadata\x128\n" +
+	"\x04base\x18\x02 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x04base\x12:\n" +
+	"\x05graph\x18\x03 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x05graph\x12@\n" +
+	"\aresults\x18\x04 \x01(\v2&.internal.rpc.v1.RunExperimentResponseR\aresults\"D\n" +
+	"\x11ListGraphsRequest\x12/\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1b.internal.rpc.v1.RecordKindR\x04kind\"L\n" +
+	"\x12ListGraphsResponse\x126\n" +
+	"\x06graphs\x18\x01 \x03(\v2\x1e.internal.rpc.v1.GraphMetadataR\x06graphs\",\n" +
+	"\x0fGetGraphRequest\x12\x19\n" +
+	"\bgraph_id\x18\x01 \x01(\tR\agraphId\"\xcc\x01\n" +
+	"\x10GetGraphResponse\x12:\n" +
+	"\x05graph\x18\x01 \x01(\v2$.internal.rpc.v1.RandomGraphResponseR\x05graph\x12:\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x1e.internal.rpc.v1.GraphMetadataR\bmetadata\x12@\n" +
+	"\aresults\x18\x03 \x01(\v2&.internal.rpc.v1.RunExperimentResponseR\aresults\"/\n" +
+	"\x12DeleteGraphRequest\x12\x19\n" +
+	"\bgraph_id\x18\x01 \x01(\tR\agraphId\"\x15\n" +
+	"\x13DeleteGraphResponse\"b\n" +
+	"\x0eFieldViolation\x12\x1d\n" +
+	"\n" +
+	"field_path\x18\x01 \x01(\tR\tfieldPath\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"M\n" +
+	"\n" +
+	"Violations\x12?\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2\x1f.internal.rpc.v1.FieldViolationR\n" +
+	"violations*<\n" +
+	"\x05Party\x12\x15\n" +
+	"\x11PARTY_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tPARTY_BOB\x10\x01\x12\r\n" +
+	"\tPARTY_ADA\x10\x02*t\n" +
+	"\bWalkMode\x12\x19\n" +
+	"\x15WALK_MODE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15WALK_MODE_RANDOM_WALK\x10\x01\x12\x1a\n" +
+	"\x16WALK_MODE_RANDOM_ROUTE\x10\x02\x12\x16\n" +
+	"\x12WALK_MODE_WEIGHTED\x10\x03*\xc1\x01\n" +
+	"\x12WeightDistribution\x12#\n" +
+	"\x1fWEIGHT_DISTRIBUTION_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bWEIGHT_DISTRIBUTION_UNIFORM\x10\x01\x12#\n" +
+	"\x1fWEIGHT_DISTRIBUTION_EXPONENTIAL\x10\x02\x12\"\n" +
+	"\x1eWEIGHT_DISTRIBUTION_LOG_NORMAL\x10\x03\x12\x1c\n" +
+	"\x18WEIGHT_DISTRIBUTION_BETA\x10\x04*|\n" +
+	"\x12RepulsionAlgorithm\x12#\n" +
+	"\x1fREPULSION_ALGORITHM_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19REPULSION_ALGORITHM_EXACT\x10\x01\x12\"\n" +
+	"\x1eREPULSION_ALGORITHM_BARNES_HUT\x10\x02*\x91\x01\n" +
+	"\x0fCoolingSchedule\x12 \n" +
+	"\x1cCOOLING_SCHEDULE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17COOLING_SCHEDULE_LINEAR\x10\x01\x12 \n" +
+	"\x1cCOOLING_SCHEDULE_EXPONENTIAL\x10\x02\x12\x1d\n" +
+	"\x19COOLING_SCHEDULE_ADAPTIVE\x10\x03*\xa5\x01\n" +
+	"\vGraphFormat\x12\x1c\n" +
+	"\x18GRAPH_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16GRAPH_FORMAT_EDGE_LIST\x10\x01\x12\x18\n" +
+	"\x14GRAPH_FORMAT_GRAPHML\x10\x02\x12\x15\n" +
+	"\x11GRAPH_FORMAT_GEXF\x10\x03\x12\x14\n" +
+	"\x10GRAPH_FORMAT_DOT\x10\x04\x12\x15\n" +
+	"\x11GRAPH_FORMAT_JSON\x10\x05*z\n" +
+	"\x0ePageRankMethod\x12 \n" +
+	"\x1cPAGE_RANK_METHOD_UNSPECIFIED\x10\x00\x12$\n" +
+	" PAGE_RANK_METHOD_POWER_ITERATION\x10\x01\x12 \n" +
+	"\x1cPAGE_RANK_METHOD_MONTE_CARLO\x10\x02*^\n" +
+	"\fFlowCapacity\x12\x1d\n" +
+	"\x19FLOW_CAPACITY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12FLOW_CAPACITY_UNIT\x10\x01\x12\x17\n" +
+	"\x13FLOW_CAPACITY_TRUST\x10\x02*N\n" +
+	"\bPathCost\x12\x19\n" +
+	"\x15PATH_COST_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0ePATH_COST_HOPS\x10\x01\x12\x13\n" +
+	"\x0fPATH_COST_TRUST\x10\x02*z\n" +
+	"\n" +
+	"RecordKind\x12\x1b\n" +
+	"\x17RECORD_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15RECORD_KIND_GENERATED\x10\x01\x12\x18\n" +
+	"\x14RECORD_KIND_IMPORTED\x10\x02\x12\x1a\n" +
+	"\x16RECORD_KIND_EXPERIMENT\x10\x032\x85\v\n" +
+	"\fGraphService\x12X\n" +
+	"\vRandomGraph\x12#.internal.rpc.v1.RandomGraphRequest\x1a$.internal.rpc.v1.RandomGraphResponse\x12R\n" +
+	"\tLoadGraph\x12!.internal.rpc.v1.LoadGraphRequest\x1a\".internal.rpc.v1.LoadGraphResponse\x12X\n" +
+	"\vExportGraph\x12#.internal.rpc.v1.ExportGraphRequest\x1a$.internal.rpc.v1.ExportGraphResponse\x12X\n" +
+	"\vCreateGraph\x12#.internal.rpc.v1.CreateGraphRequest\x1a$.internal.rpc.v1.CreateGraphResponse\x12O\n" +
+	"\bRunWalks\x12 .internal.rpc.v1.RunWalksRequest\x1a!.internal.rpc.v1.RunWalksResponse\x12O\n" +
+	"\bRelayout\x12 .internal.rpc.v1.RelayoutRequest\x1a!.internal.rpc.v1.RelayoutResponse\x12]\n" +
+	"\fStreamLayout\x12$.internal.rpc.v1.StreamLayoutRequest\x1a%.internal.rpc.v1.StreamLayoutResponse0\x01\x12U\n" +
+	"\n" +
+	"ListGraphs\x12\".internal.rpc.v1.ListGraphsRequest\x1a#.internal.rpc.v1.ListGraphsResponse\x12O\n" +
+	"\bGetGraph\x12 .internal.rpc.v1.GetGraphRequest\x1a!.internal.rpc.v1.GetGraphResponse\x12X\n" +
+	"\vDeleteGraph\x12#.internal.rpc.v1.DeleteGraphRequest\x1a$.internal.rpc.v1.DeleteGraphResponse\x12[\n" +
+	"\fComputeTrust\x12$.internal.rpc.v1.ComputeTrustRequest\x1a%.internal.rpc.v1.ComputeTrustResponse\x12L\n" +
+	"\aMaxFlow\x12\x1f.internal.rpc.v1.MaxFlowRequest\x1a .internal.rpc.v1.MaxFlowResponse\x12^\n" +
+	"\rShortestPaths\x12%.internal.rpc.v1.ShortestPathsRequest\x1a&.internal.rpc.v1.ShortestPathsResponse\x12U\n" +
+	"\n" +
+	"GraphStats\x12\".internal.rpc.v1.GraphStatsRequest\x1a#.internal.rpc.v1.GraphStatsResponse\x12^\n" +
+	"\rRunExperiment\x12%.internal.rpc.v1.RunExperimentRequest\x1a&.internal.rpc.v1.RunExperimentResponse\x12N\n" +
+	"\x06Replay\x12\x1e.internal.rpc.v1.ReplayRequest\x1a$.internal.rpc.v1.RandomGraphResponseB\xac\x01\n" +
+	"\x13com.internal.rpc.v1B\bRpcProtoP\x01Z-github.com/advdv/trustd/internal/rpc/v1;rpcv1\xa2\x02\x03IRX\xaa\x02\x0fInternal.Rpc.V1\xca\x02\x0fInternal\\Rpc\\V1\xe2\x02\x1bInternal\\Rpc\\V1\\GPBMetadata\xea\x02\x11Internal::Rpc::V1b\beditionsp\xe8\a"
+
+var file_internal_rpc_v1_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_internal_rpc_v1_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_internal_rpc_v1_rpc_proto_goTypes = []any{
-	(*Position)(nil),            // 0: internal.rpc.v1.Position
-	(*NodeData)(nil),            // 1: internal.rpc.v1.NodeData
-	(*Node)(nil),                // 2: internal.rpc.v1.Node
-	(*Edge)(nil),                // 3: internal.rpc.v1.Edge
-	(*RandomGraphRequest)(nil),  // 4: internal.rpc.v1.RandomGraphRequest
-	(*RandomGraphResponse)(nil), // 5: internal.rpc.v1.RandomGraphResponse
+	(Party)(0),                    // 0: internal.rpc.v1.Party
+	(WalkMode)(0),                 // 1: internal.rpc.v1.WalkMode
+	(WeightDistribution)(0),       // 2: internal.rpc.v1.WeightDistribution
+	(RepulsionAlgorithm)(0),       // 3: internal.rpc.v1.RepulsionAlgorithm
+	(CoolingSchedule)(0),          // 4: internal.rpc.v1.CoolingSchedule
+	(GraphFormat)(0),              // 5: internal.rpc.v1.GraphFormat
+	(PageRankMethod)(0),           // 6: internal.rpc.v1.PageRankMethod
+	(FlowCapacity)(0),             // 7: internal.rpc.v1.FlowCapacity
+	(PathCost)(0),                 // 8: internal.rpc.v1.PathCost
+	(RecordKind)(0),               // 9: internal.rpc.v1.RecordKind
+	(*Position)(nil),              // 10: internal.rpc.v1.Position
+	(*NodeData)(nil),              // 11: internal.rpc.v1.NodeData
+	(*Node)(nil),                  // 12: internal.rpc.v1.Node
+	(*Edge)(nil),                  // 13: internal.rpc.v1.Edge
+	(*Walk)(nil),                  // 14: internal.rpc.v1.Walk
+	(*WalkIntersection)(nil),      // 15: internal.rpc.v1.WalkIntersection
+	(*WattsStrogatzParams)(nil),   // 16: internal.rpc.v1.WattsStrogatzParams
+	(*ErdosRenyiParams)(nil),      // 17: internal.rpc.v1.ErdosRenyiParams
+	(*BarabasiAlbertParams)(nil),  // 18: internal.rpc.v1.BarabasiAlbertParams
+	(*StochasticBlockParams)(nil), // 19: internal.rpc.v1.StochasticBlockParams
+	(*RandomRegularParams)(nil),   // 20: internal.rpc.v1.RandomRegularParams
+	(*LatticeParams)(nil),         // 21: internal.rpc.v1.LatticeParams
+	(*EdgeWeights)(nil),           // 22: internal.rpc.v1.EdgeWeights
+	(*SybilRegion)(nil),           // 23: internal.rpc.v1.SybilRegion
+	(*ForceDirectedParams)(nil),   // 24: internal.rpc.v1.ForceDirectedParams
+	(*CircularParams)(nil),        // 25: internal.rpc.v1.CircularParams
+	(*SpectralParams)(nil),        // 26: internal.rpc.v1.SpectralParams
+	(*KamadaKawaiParams)(nil),     // 27: internal.rpc.v1.KamadaKawaiParams
+	(*HierarchicalParams)(nil),    // 28: internal.rpc.v1.HierarchicalParams
+	(*RandomGraphRequest)(nil),    // 29: internal.rpc.v1.RandomGraphRequest
+	(*LayoutStats)(nil),           // 30: internal.rpc.v1.LayoutStats
+	(*RandomGraphResponse)(nil),   // 31: internal.rpc.v1.RandomGraphResponse
+	(*StageSeed)(nil),             // 32: internal.rpc.v1.StageSeed
+	(*AlgorithmVersion)(nil),      // 33: internal.rpc.v1.AlgorithmVersion
+	(*GraphManifest)(nil),         // 34: internal.rpc.v1.GraphManifest
+	(*ReplayRequest)(nil),         // 35: internal.rpc.v1.ReplayRequest
+	(*GraphStats)(nil),            // 36: internal.rpc.v1.GraphStats
+	(*GraphSource)(nil),           // 37: internal.rpc.v1.GraphSource
+	(*LoadGraphRequest)(nil),      // 38: internal.rpc.v1.LoadGraphRequest
+	(*LoadGraphResponse)(nil),     // 39: internal.rpc.v1.LoadGraphResponse
+	(*ExportGraphRequest)(nil),    // 40: internal.rpc.v1.ExportGraphRequest
+	(*ExportGraphResponse)(nil),   // 41: internal.rpc.v1.ExportGraphResponse
+	(*PageRankParams)(nil),        // 42: internal.rpc.v1.PageRankParams
+	(*EigenTrustParams)(nil),      // 43: internal.rpc.v1.EigenTrustParams
+	(*TidalTrustParams)(nil),      // 44: internal.rpc.v1.TidalTrustParams
+	(*AdvogatoParams)(nil),        // 45: internal.rpc.v1.AdvogatoParams
+	(*ComputeTrustRequest)(nil),   // 46: internal.rpc.v1.ComputeTrustRequest
+	(*ComputeTrustResponse)(nil),  // 47: internal.rpc.v1.ComputeTrustResponse
+	(*MaxFlowRequest)(nil),        // 48: internal.rpc.v1.MaxFlowRequest
+	(*MaxFlowResponse)(nil),       // 49: internal.rpc.v1.MaxFlowResponse
+	(*ShortestPathsRequest)(nil),  // 50: internal.rpc.v1.ShortestPathsRequest
+	(*TrustPath)(nil),             // 51: internal.rpc.v1.TrustPath
+	(*ShortestPathsResponse)(nil), // 52: internal.rpc.v1.ShortestPathsResponse
+	(*GraphStatsRequest)(nil),     // 53: internal.rpc.v1.GraphStatsRequest
+	(*GraphStatsResponse)(nil),    // 54: internal.rpc.v1.GraphStatsResponse
+	(*Int64Sweep)(nil),            // 55: internal.rpc.v1.Int64Sweep
+	(*DoubleSweep)(nil),           // 56: internal.rpc.v1.DoubleSweep
+	(*RunExperimentRequest)(nil),  // 57: internal.rpc.v1.RunExperimentRequest
+	(*ExperimentResult)(nil),      // 58: internal.rpc.v1.ExperimentResult
+	(*RunExperimentResponse)(nil), // 59: internal.rpc.v1.RunExperimentResponse
+	(*CreateGraphRequest)(nil),    // 60: internal.rpc.v1.CreateGraphRequest
+	(*CreateGraphResponse)(nil),   // 61: internal.rpc.v1.CreateGraphResponse
+	(*RunWalksRequest)(nil),       // 62: internal.rpc.v1.RunWalksRequest
+	(*RunWalksResponse)(nil),      // 63: internal.rpc.v1.RunWalksResponse
+	(*RelayoutRequest)(nil),       // 64: internal.rpc.v1.RelayoutRequest
+	(*RelayoutResponse)(nil),      // 65: internal.rpc.v1.RelayoutResponse
+	(*StreamLayoutRequest)(nil),   // 66: internal.rpc.v1.StreamLayoutRequest
+	(*NodePosition)(nil),          // 67: internal.rpc.v1.NodePosition
+	(*StreamLayoutResponse)(nil),  // 68: internal.rpc.v1.StreamLayoutResponse
+	(*GraphMetadata)(nil),         // 69: internal.rpc.v1.GraphMetadata
+	(*GraphRecord)(nil),           // 70: internal.rpc.v1.GraphRecord
+	(*ListGraphsRequest)(nil),     // 71: internal.rpc.v1.ListGraphsRequest
+	(*ListGraphsResponse)(nil),    // 72: internal.rpc.v1.ListGraphsResponse
+	(*GetGraphRequest)(nil),       // 73: internal.rpc.v1.GetGraphRequest
+	(*GetGraphResponse)(nil),      // 74: internal.rpc.v1.GetGraphResponse
+	(*DeleteGraphRequest)(nil),    // 75: internal.rpc.v1.DeleteGraphRequest
+	(*DeleteGraphResponse)(nil),   // 76: internal.rpc.v1.DeleteGraphResponse
+	(*FieldViolation)(nil),        // 77: internal.rpc.v1.FieldViolation
+	(*Violations)(nil),            // 78: internal.rpc.v1.Violations
+	nil,                           // 79: internal.rpc.v1.ComputeTrustResponse.ScoresEntry
 }
 var file_internal_rpc_v1_rpc_proto_depIdxs = []int32{
-	0, // 0: internal.rpc.v1.Node.position:type_name -> internal.rpc.v1.Position
-	1, // 1: internal.rpc.v1.Node.data:type_name -> internal.rpc.v1.NodeData
-	2, // 2: internal.rpc.v1.RandomGraphResponse.nodes:type_name -> internal.rpc.v1.Node
-	3, // 3: internal.rpc.v1.RandomGraphResponse.edges:type_name -> internal.rpc.v1.Edge
-	4, // 4: internal.rpc.v1.GraphService.RandomGraph:input_type -> internal.rpc.v1.RandomGraphRequest
-	5, // 5: internal.rpc.v1.GraphService.RandomGraph:output_type -> internal.rpc.v1.RandomGraphResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	10,  // 0: internal.rpc.v1.Node.position:type_name -> internal.rpc.v1.Position
+	11,  // 1: internal.rpc.v1.Node.data:type_name -> internal.rpc.v1.NodeData
+	0,   // 2: internal.rpc.v1.Walk.owner:type_name -> internal.rpc.v1.Party
+	2,   // 3: internal.rpc.v1.EdgeWeights.distribution:type_name -> internal.rpc.v1.WeightDistribution
+	3,   // 4: internal.rpc.v1.ForceDirectedParams.repulsion:type_name -> internal.rpc.v1.RepulsionAlgorithm
+	4,   // 5: internal.rpc.v1.ForceDirectedParams.cooling:type_name -> internal.rpc.v1.CoolingSchedule
+	1,   // 6: internal.rpc.v1.RandomGraphRequest.walk_mode:type_name -> internal.rpc.v1.WalkMode
+	23,  // 7: internal.rpc.v1.RandomGraphRequest.sybil_region:type_name -> internal.rpc.v1.SybilRegion
+	16,  // 8: internal.rpc.v1.RandomGraphRequest.watts_strogatz:type_name -> internal.rpc.v1.WattsStrogatzParams
+	17,  // 9: internal.rpc.v1.RandomGraphRequest.erdos_renyi:type_name -> internal.rpc.v1.ErdosRenyiParams
+	18,  // 10: internal.rpc.v1.RandomGraphRequest.barabasi_albert:type_name -> internal.rpc.v1.BarabasiAlbertParams
+	19,  // 11: internal.rpc.v1.RandomGraphRequest.stochastic_block:type_name -> internal.rpc.v1.StochasticBlockParams
+	20,  // 12: internal.rpc.v1.RandomGraphRequest.random_regular:type_name -> internal.rpc.v1.RandomRegularParams
+	21,  // 13: internal.rpc.v1.RandomGraphRequest.lattice:type_name -> internal.rpc.v1.LatticeParams
+	3,   // 14: internal.rpc.v1.RandomGraphRequest.layout_repulsion:type_name -> internal.rpc.v1.RepulsionAlgorithm
+	4,   // 15: internal.rpc.v1.RandomGraphRequest.layout_cooling:type_name -> internal.rpc.v1.CoolingSchedule
+	24,  // 16: internal.rpc.v1.RandomGraphRequest.force_directed:type_name -> internal.rpc.v1.ForceDirectedParams
+	25,  // 17: internal.rpc.v1.RandomGraphRequest.circular:type_name -> internal.rpc.v1.CircularParams
+	26,  // 18: internal.rpc.v1.RandomGraphRequest.spectral:type_name -> internal.rpc.v1.SpectralParams
+	27,  // 19: internal.rpc.v1.RandomGraphRequest.kamada_kawai:type_name -> internal.rpc.v1.KamadaKawaiParams
+	28,  // 20: internal.rpc.v1.RandomGraphRequest.hierarchical:type_name -> internal.rpc.v1.HierarchicalParams
+	22,  // 21: internal.rpc.v1.RandomGraphRequest.edge_weights:type_name -> internal.rpc.v1.EdgeWeights
+	12,  // 22: internal.rpc.v1.RandomGraphResponse.nodes:type_name -> internal.rpc.v1.Node
+	13,  // 23: internal.rpc.v1.RandomGraphResponse.edges:type_name -> internal.rpc.v1.Edge
+	14,  // 24: internal.rpc.v1.RandomGraphResponse.walks:type_name -> internal.rpc.v1.Walk
+	15,  // 25: internal.rpc.v1.RandomGraphResponse.intersection:type_name -> internal.rpc.v1.WalkIntersection
+	30,  // 26: internal.rpc.v1.RandomGraphResponse.layout:type_name -> internal.rpc.v1.LayoutStats
+	36,  // 27: internal.rpc.v1.RandomGraphResponse.stats:type_name -> internal.rpc.v1.GraphStats
+	34,  // 28: internal.rpc.v1.RandomGraphResponse.manifest:type_name -> internal.rpc.v1.GraphManifest
+	29,  // 29: internal.rpc.v1.GraphManifest.request:type_name -> internal.rpc.v1.RandomGraphRequest
+	29,  // 30: internal.rpc.v1.GraphManifest.relayout:type_name -> internal.rpc.v1.RandomGraphRequest
+	32,  // 31: internal.rpc.v1.GraphManifest.seeds:type_name -> internal.rpc.v1.StageSeed
+	33,  // 32: internal.rpc.v1.GraphManifest.algorithms:type_name -> internal.rpc.v1.AlgorithmVersion
+	34,  // 33: internal.rpc.v1.ReplayRequest.manifest:type_name -> internal.rpc.v1.GraphManifest
+	29,  // 34: internal.rpc.v1.GraphSource.generate:type_name -> internal.rpc.v1.RandomGraphRequest
+	5,   // 35: internal.rpc.v1.LoadGraphRequest.format:type_name -> internal.rpc.v1.GraphFormat
+	37,  // 36: internal.rpc.v1.ExportGraphRequest.source:type_name -> internal.rpc.v1.GraphSource
+	5,   // 37: internal.rpc.v1.ExportGraphRequest.format:type_name -> internal.rpc.v1.GraphFormat
+	6,   // 38: internal.rpc.v1.PageRankParams.method:type_name -> internal.rpc.v1.PageRankMethod
+	37,  // 39: internal.rpc.v1.ComputeTrustRequest.source:type_name -> internal.rpc.v1.GraphSource
+	42,  // 40: internal.rpc.v1.ComputeTrustRequest.personalized_page_rank:type_name -> internal.rpc.v1.PageRankParams
+	43,  // 41: internal.rpc.v1.ComputeTrustRequest.eigen_trust:type_name -> internal.rpc.v1.EigenTrustParams
+	44,  // 42: internal.rpc.v1.ComputeTrustRequest.tidal_trust:type_name -> internal.rpc.v1.TidalTrustParams
+	45,  // 43: internal.rpc.v1.ComputeTrustRequest.advogato:type_name -> internal.rpc.v1.AdvogatoParams
+	79,  // 44: internal.rpc.v1.ComputeTrustResponse.scores:type_name -> internal.rpc.v1.ComputeTrustResponse.ScoresEntry
+	37,  // 45: internal.rpc.v1.MaxFlowRequest.source:type_name -> internal.rpc.v1.GraphSource
+	7,   // 46: internal.rpc.v1.MaxFlowRequest.capacity:type_name -> internal.rpc.v1.FlowCapacity
+	31,  // 47: internal.rpc.v1.MaxFlowResponse.graph:type_name -> internal.rpc.v1.RandomGraphResponse
+	37,  // 48: internal.rpc.v1.ShortestPathsRequest.source:type_name -> internal.rpc.v1.GraphSource
+	8,   // 49: internal.rpc.v1.ShortestPathsRequest.cost:type_name -> internal.rpc.v1.PathCost
+	51,  // 50: internal.rpc.v1.ShortestPathsResponse.paths:type_name -> internal.rpc.v1.TrustPath
+	31,  // 51: internal.rpc.v1.ShortestPathsResponse.graph:type_name -> internal.rpc.v1.RandomGraphResponse
+	37,  // 52: internal.rpc.v1.GraphStatsRequest.source:type_name -> internal.rpc.v1.GraphSource
+	36,  // 53: internal.rpc.v1.GraphStatsResponse.stats:type_name -> internal.rpc.v1.GraphStats
+	29,  // 54: internal.rpc.v1.RunExperimentRequest.base:type_name -> internal.rpc.v1.RandomGraphRequest
+	55,  // 55: internal.rpc.v1.RunExperimentRequest.num_nodes:type_name -> internal.rpc.v1.Int64Sweep
+	55,  // 56: internal.rpc.v1.RunExperimentRequest.initial_connected:type_name -> internal.rpc.v1.Int64Sweep
+	56,  // 57: internal.rpc.v1.RunExperimentRequest.rewiring_probability:type_name -> internal.rpc.v1.DoubleSweep
+	55,  // 58: internal.rpc.v1.RunExperimentRequest.walk_length:type_name -> internal.rpc.v1.Int64Sweep
+	55,  // 59: internal.rpc.v1.RunExperimentRequest.num_walks:type_name -> internal.rpc.v1.Int64Sweep
+	55,  // 60: internal.rpc.v1.RunExperimentRequest.seeds:type_name -> internal.rpc.v1.Int64Sweep
+	58,  // 61: internal.rpc.v1.RunExperimentResponse.results:type_name -> internal.rpc.v1.ExperimentResult
+	29,  // 62: internal.rpc.v1.CreateGraphRequest.params:type_name -> internal.rpc.v1.RandomGraphRequest
+	31,  // 63: internal.rpc.v1.CreateGraphResponse.graph:type_name -> internal.rpc.v1.RandomGraphResponse
+	29,  // 64: internal.rpc.v1.RunWalksRequest.params:type_name -> internal.rpc.v1.RandomGraphRequest
+	31,  // 65: internal.rpc.v1.RunWalksResponse.graph:type_name -> internal.rpc.v1.RandomGraphResponse
+	29,  // 66: internal.rpc.v1.RelayoutRequest.params:type_name -> internal.rpc.v1.RandomGraphRequest
+	31,  // 67: internal.rpc.v1.RelayoutResponse.graph:type_name -> internal.rpc.v1.RandomGraphResponse
+	29,  // 68: internal.rpc.v1.StreamLayoutRequest.params:type_name -> internal.rpc.v1.RandomGraphRequest
+	10,  // 69: internal.rpc.v1.NodePosition.position:type_name -> internal.rpc.v1.Position
+	30,  // 70: internal.rpc.v1.StreamLayoutResponse.stats:type_name -> internal.rpc.v1.LayoutStats
+	67,  // 71: internal.rpc.v1.StreamLayoutResponse.positions:type_name -> internal.rpc.v1.NodePosition
+	31,  // 72: internal.rpc.v1.StreamLayoutResponse.graph:type_name -> internal.rpc.v1.RandomGraphResponse
+	9,   // 73: internal.rpc.v1.GraphMetadata.kind:type_name -> internal.rpc.v1.RecordKind
+	29,  // 74: internal.rpc.v1.GraphMetadata.params:type_name -> internal.rpc.v1.RandomGraphRequest
+	29,  // 75: internal.rpc.v1.GraphMetadata.walk_params:type_name -> internal.rpc.v1.RandomGraphRequest
+	29,  // 76: internal.rpc.v1.GraphMetadata.layout_params:type_name -> internal.rpc.v1.RandomGraphRequest
+	57,  // 77: internal.rpc.v1.GraphMetadata.experiment:type_name -> internal.rpc.v1.RunExperimentRequest
+	69,  // 78: internal.rpc.v1.GraphRecord.metadata:type_name -> internal.rpc.v1.GraphMetadata
+	31,  // 79: internal.rpc.v1.GraphRecord.base:type_name -> internal.rpc.v1.RandomGraphResponse
+	31,  // 80: internal.rpc.v1.GraphRecord.graph:type_name -> internal.rpc.v1.RandomGraphResponse
+	59,  // 81: internal.rpc.v1.GraphRecord.results:type_name -> internal.rpc.v1.RunExperimentResponse
+	9,   // 82: internal.rpc.v1.ListGraphsRequest.kind:type_name -> internal.rpc.v1.RecordKind
+	69,  // 83: internal.rpc.v1.ListGraphsResponse.graphs:type_name -> internal.rpc.v1.GraphMetadata
+	31,  // 84: internal.rpc.v1.GetGraphResponse.graph:type_name -> internal.rpc.v1.RandomGraphResponse
+	69,  // 85: internal.rpc.v1.GetGraphResponse.metadata:type_name -> internal.rpc.v1.GraphMetadata
+	59,  // 86: internal.rpc.v1.GetGraphResponse.results:type_name -> internal.rpc.v1.RunExperimentResponse
+	77,  // 87: internal.rpc.v1.Violations.violations:type_name -> internal.rpc.v1.FieldViolation
+	29,  // 88: internal.rpc.v1.GraphService.RandomGraph:input_type -> internal.rpc.v1.RandomGraphRequest
+	38,  // 89: internal.rpc.v1.GraphService.LoadGraph:input_type -> internal.rpc.v1.LoadGraphRequest
+	40,  // 90: internal.rpc.v1.GraphService.ExportGraph:input_type -> internal.rpc.v1.ExportGraphRequest
+	60,  // 91: internal.rpc.v1.GraphService.CreateGraph:input_type -> internal.rpc.v1.CreateGraphRequest
+	62,  // 92: internal.rpc.v1.GraphService.RunWalks:input_type -> internal.rpc.v1.RunWalksRequest
+	64,  // 93: internal.rpc.v1.GraphService.Relayout:input_type -> internal.rpc.v1.RelayoutRequest
+	66,  // 94: internal.rpc.v1.GraphService.StreamLayout:input_type -> internal.rpc.v1.StreamLayoutRequest
+	71,  // 95: internal.rpc.v1.GraphService.ListGraphs:input_type -> internal.rpc.v1.ListGraphsRequest
+	73,  // 96: internal.rpc.v1.GraphService.GetGraph:input_type -> internal.rpc.v1.GetGraphRequest
+	75,  // 97: internal.rpc.v1.GraphService.DeleteGraph:input_type -> internal.rpc.v1.DeleteGraphRequest
+	46,  // 98: internal.rpc.v1.GraphService.ComputeTrust:input_type -> internal.rpc.v1.ComputeTrustRequest
+	48,  // 99: internal.rpc.v1.GraphService.MaxFlow:input_type -> internal.rpc.v1.MaxFlowRequest
+	50,  // 100: internal.rpc.v1.GraphService.ShortestPaths:input_type -> internal.rpc.v1.ShortestPathsRequest
+	53,  // 101: internal.rpc.v1.GraphService.GraphStats:input_type -> internal.rpc.v1.GraphStatsRequest
+	57,  // 102: internal.rpc.v1.GraphService.RunExperiment:input_type -> internal.rpc.v1.RunExperimentRequest
+	35,  // 103: internal.rpc.v1.GraphService.Replay:input_type -> internal.rpc.v1.ReplayRequest
+	31,  // 104: internal.rpc.v1.GraphService.RandomGraph:output_type -> internal.rpc.v1.RandomGraphResponse
+	39,  // 105: internal.rpc.v1.GraphService.LoadGraph:output_type -> internal.rpc.v1.LoadGraphResponse
+	41,  // 106: internal.rpc.v1.GraphService.ExportGraph:output_type -> internal.rpc.v1.ExportGraphResponse
+	61,  // 107: internal.rpc.v1.GraphService.CreateGraph:output_type -> internal.rpc.v1.CreateGraphResponse
+	63,  // 108: internal.rpc.v1.GraphService.RunWalks:output_type -> internal.rpc.v1.RunWalksResponse
+	65,  // 109: internal.rpc.v1.GraphService.Relayout:output_type -> internal.rpc.v1.RelayoutResponse
+	68,  // 110: internal.rpc.v1.GraphService.StreamLayout:output_type -> internal.rpc.v1.StreamLayoutResponse
+	72,  // 111: internal.rpc.v1.GraphService.ListGraphs:output_type -> internal.rpc.v1.ListGraphsResponse
+	74,  // 112: internal.rpc.v1.GraphService.GetGraph:output_type -> internal.rpc.v1.GetGraphResponse
+	76,  // 113: internal.rpc.v1.GraphService.DeleteGraph:output_type -> internal.rpc.v1.DeleteGraphResponse
+	47,  // 114: internal.rpc.v1.GraphService.ComputeTrust:output_type -> internal.rpc.v1.ComputeTrustResponse
+	49,  // 115: internal.rpc.v1.GraphService.MaxFlow:output_type -> internal.rpc.v1.MaxFlowResponse
+	52,  // 116: internal.rpc.v1.GraphService.ShortestPaths:output_type -> internal.rpc.v1.ShortestPathsResponse
+	54,  // 117: internal.rpc.v1.GraphService.GraphStats:output_type -> internal.rpc.v1.GraphStatsResponse
+	59,  // 118: internal.rpc.v1.GraphService.RunExperiment:output_type -> internal.rpc.v1.RunExperimentResponse
+	31,  // 119: internal.rpc.v1.GraphService.Replay:output_type -> internal.rpc.v1.RandomGraphResponse
+	104, // [104:120] is the sub-list for method output_type
+	88,  // [88:104] is the sub-list for method input_type
+	88,  // [88:88] is the sub-list for extension type_name
+	88,  // [88:88] is the sub-list for extension extendee
+	0,   // [0:88] is the sub-list for field type_name
 }
 
 func init() { file_internal_rpc_v1_rpc_proto_init() }
@@ -1096,18 +12262,43 @@ func file_internal_rpc_v1_rpc_proto_init() {
 	if File_internal_rpc_v1_rpc_proto != nil {
 		return
 	}
+	file_internal_rpc_v1_rpc_proto_msgTypes[19].OneofWrappers = []any{
+		(*randomGraphRequest_WattsStrogatz)(nil),
+		(*randomGraphRequest_ErdosRenyi)(nil),
+		(*randomGraphRequest_BarabasiAlbert)(nil),
+		(*randomGraphRequest_StochasticBlock)(nil),
+		(*randomGraphRequest_RandomRegular)(nil),
+		(*randomGraphRequest_Lattice)(nil),
+		(*randomGraphRequest_GraphId)(nil),
+		(*randomGraphRequest_ForceDirected)(nil),
+		(*randomGraphRequest_Circular)(nil),
+		(*randomGraphRequest_Spectral)(nil),
+		(*randomGraphRequest_KamadaKawai)(nil),
+		(*randomGraphRequest_Hierarchical)(nil),
+	}
+	file_internal_rpc_v1_rpc_proto_msgTypes[27].OneofWrappers = []any{
+		(*graphSource_GraphId)(nil),
+		(*graphSource_Generate)(nil),
+	}
+	file_internal_rpc_v1_rpc_proto_msgTypes[36].OneofWrappers = []any{
+		(*computeTrustRequest_PersonalizedPageRank)(nil),
+		(*computeTrustRequest_EigenTrust)(nil),
+		(*computeTrustRequest_TidalTrust)(nil),
+		(*computeTrustRequest_Advogato)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_rpc_v1_rpc_proto_rawDesc), len(file_internal_rpc_v1_rpc_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      10,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_internal_rpc_v1_rpc_proto_goTypes,
 		DependencyIndexes: file_internal_rpc_v1_rpc_proto_depIdxs,
+		EnumInfos:         file_internal_rpc_v1_rpc_proto_enumTypes,
 		MessageInfos:      file_internal_rpc_v1_rpc_proto_msgTypes,
 	}.Build()
 	File_internal_rpc_v1_rpc_proto = out.File
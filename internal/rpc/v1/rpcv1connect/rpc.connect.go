@@ -36,11 +36,73 @@ const (
 	// GraphServiceRandomGraphProcedure is the fully-qualified name of the GraphService's RandomGraph
 	// RPC.
 	GraphServiceRandomGraphProcedure = "/internal.rpc.v1.GraphService/RandomGraph"
+	// GraphServiceLoadGraphProcedure is the fully-qualified name of the GraphService's LoadGraph RPC.
+	GraphServiceLoadGraphProcedure = "/internal.rpc.v1.GraphService/LoadGraph"
+	// GraphServiceExportGraphProcedure is the fully-qualified name of the GraphService's ExportGraph
+	// RPC.
+	GraphServiceExportGraphProcedure = "/internal.rpc.v1.GraphService/ExportGraph"
+	// GraphServiceCreateGraphProcedure is the fully-qualified name of the GraphService's CreateGraph
+	// RPC.
+	GraphServiceCreateGraphProcedure = "/internal.rpc.v1.GraphService/CreateGraph"
+	// GraphServiceRunWalksProcedure is the fully-qualified name of the GraphService's RunWalks RPC.
+	GraphServiceRunWalksProcedure = "/internal.rpc.v1.GraphService/RunWalks"
+	// GraphServiceRelayoutProcedure is the fully-qualified name of the GraphService's Relayout RPC.
+	GraphServiceRelayoutProcedure = "/internal.rpc.v1.GraphService/Relayout"
+	// GraphServiceStreamLayoutProcedure is the fully-qualified name of the GraphService's StreamLayout
+	// RPC.
+	GraphServiceStreamLayoutProcedure = "/internal.rpc.v1.GraphService/StreamLayout"
+	// GraphServiceListGraphsProcedure is the fully-qualified name of the GraphService's ListGraphs RPC.
+	GraphServiceListGraphsProcedure = "/internal.rpc.v1.GraphService/ListGraphs"
+	// GraphServiceGetGraphProcedure is the fully-qualified name of the GraphService's GetGraph RPC.
+	GraphServiceGetGraphProcedure = "/internal.rpc.v1.GraphService/GetGraph"
+	// GraphServiceDeleteGraphProcedure is the fully-qualified name of the GraphService's DeleteGraph
+	// RPC.
+	GraphServiceDeleteGraphProcedure = "/internal.rpc.v1.GraphService/DeleteGraph"
+	// GraphServiceComputeTrustProcedure is the fully-qualified name of the GraphService's ComputeTrust
+	// RPC.
+	GraphServiceComputeTrustProcedure = "/internal.rpc.v1.GraphService/ComputeTrust"
+	// GraphServiceMaxFlowProcedure is the fully-qualified name of the GraphService's MaxFlow RPC.
+	GraphServiceMaxFlowProcedure = "/internal.rpc.v1.GraphService/MaxFlow"
+	// GraphServiceShortestPathsProcedure is the fully-qualified name of the GraphService's
+	// ShortestPaths RPC.
+	GraphServiceShortestPathsProcedure = "/internal.rpc.v1.GraphService/ShortestPaths"
+	// GraphServiceGraphStatsProcedure is the fully-qualified name of the GraphService's GraphStats RPC.
+	GraphServiceGraphStatsProcedure = "/internal.rpc.v1.GraphService/GraphStats"
+	// GraphServiceRunExperimentProcedure is the fully-qualified name of the GraphService's
+	// RunExperiment RPC.
+	GraphServiceRunExperimentProcedure = "/internal.rpc.v1.GraphService/RunExperiment"
+	// GraphServiceReplayProcedure is the fully-qualified name of the GraphService's Replay RPC.
+	GraphServiceReplayProcedure = "/internal.rpc.v1.GraphService/Replay"
 )
 
 // GraphServiceClient is a client for the internal.rpc.v1.GraphService service.
 type GraphServiceClient interface {
 	RandomGraph(context.Context, *connect.Request[v1.RandomGraphRequest]) (*connect.Response[v1.RandomGraphResponse], error)
+	LoadGraph(context.Context, *connect.Request[v1.LoadGraphRequest]) (*connect.Response[v1.LoadGraphResponse], error)
+	ExportGraph(context.Context, *connect.Request[v1.ExportGraphRequest]) (*connect.Response[v1.ExportGraphResponse], error)
+	CreateGraph(context.Context, *connect.Request[v1.CreateGraphRequest]) (*connect.Response[v1.CreateGraphResponse], error)
+	RunWalks(context.Context, *connect.Request[v1.RunWalksRequest]) (*connect.Response[v1.RunWalksResponse], error)
+	Relayout(context.Context, *connect.Request[v1.RelayoutRequest]) (*connect.Response[v1.RelayoutResponse], error)
+	// StreamLayout applies a force-directed layout to a stored graph like Relayout, and streams snapshots
+	// of the node positions while it runs.
+	StreamLayout(context.Context, *connect.Request[v1.StreamLayoutRequest]) (*connect.ServerStreamForClient[v1.StreamLayoutResponse], error)
+	// ListGraphs lists the graphs and experiments in the store.
+	ListGraphs(context.Context, *connect.Request[v1.ListGraphsRequest]) (*connect.Response[v1.ListGraphsResponse], error)
+	GetGraph(context.Context, *connect.Request[v1.GetGraphRequest]) (*connect.Response[v1.GetGraphResponse], error)
+	DeleteGraph(context.Context, *connect.Request[v1.DeleteGraphRequest]) (*connect.Response[v1.DeleteGraphResponse], error)
+	// ComputeTrust computes how much Bob trusts every node in the graph.
+	ComputeTrust(context.Context, *connect.Request[v1.ComputeTrustRequest]) (*connect.Response[v1.ComputeTrustResponse], error)
+	// MaxFlow computes the maximum flow from Bob to Ada, and a minimum cut that separates them.
+	MaxFlow(context.Context, *connect.Request[v1.MaxFlowRequest]) (*connect.Response[v1.MaxFlowResponse], error)
+	// ShortestPaths finds the k shortest paths from Bob to Ada.
+	ShortestPaths(context.Context, *connect.Request[v1.ShortestPathsRequest]) (*connect.Response[v1.ShortestPathsResponse], error)
+	// GraphStats reports the structure of the graph.
+	GraphStats(context.Context, *connect.Request[v1.GraphStatsRequest]) (*connect.Response[v1.GraphStatsResponse], error)
+	// RunExperiment runs the walks over sweeps of the graph and walk parameters, and aggregates how often
+	// the walks of Bob and Ada meet.
+	RunExperiment(context.Context, *connect.Request[v1.RunExperimentRequest]) (*connect.Response[v1.RunExperimentResponse], error)
+	// Replay generates a graph again from its manifest, the graph is identical to the original.
+	Replay(context.Context, *connect.Request[v1.ReplayRequest]) (*connect.Response[v1.RandomGraphResponse], error)
 }
 
 // NewGraphServiceClient constructs a client for the internal.rpc.v1.GraphService service. By
@@ -60,12 +122,117 @@ func NewGraphServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(graphServiceMethods.ByName("RandomGraph")),
 			connect.WithClientOptions(opts...),
 		),
+		loadGraph: connect.NewClient[v1.LoadGraphRequest, v1.LoadGraphResponse](
+			httpClient,
+			baseURL+GraphServiceLoadGraphProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("LoadGraph")),
+			connect.WithClientOptions(opts...),
+		),
+		exportGraph: connect.NewClient[v1.ExportGraphRequest, v1.ExportGraphResponse](
+			httpClient,
+			baseURL+GraphServiceExportGraphProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("ExportGraph")),
+			connect.WithClientOptions(opts...),
+		),
+		createGraph: connect.NewClient[v1.CreateGraphRequest, v1.CreateGraphResponse](
+			httpClient,
+			baseURL+GraphServiceCreateGraphProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("CreateGraph")),
+			connect.WithClientOptions(opts...),
+		),
+		runWalks: connect.NewClient[v1.RunWalksRequest, v1.RunWalksResponse](
+			httpClient,
+			baseURL+GraphServiceRunWalksProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("RunWalks")),
+			connect.WithClientOptions(opts...),
+		),
+		relayout: connect.NewClient[v1.RelayoutRequest, v1.RelayoutResponse](
+			httpClient,
+			baseURL+GraphServiceRelayoutProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("Relayout")),
+			connect.WithClientOptions(opts...),
+		),
+		streamLayout: connect.NewClient[v1.StreamLayoutRequest, v1.StreamLayoutResponse](
+			httpClient,
+			baseURL+GraphServiceStreamLayoutProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("StreamLayout")),
+			connect.WithClientOptions(opts...),
+		),
+		listGraphs: connect.NewClient[v1.ListGraphsRequest, v1.ListGraphsResponse](
+			httpClient,
+			baseURL+GraphServiceListGraphsProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("ListGraphs")),
+			connect.WithClientOptions(opts...),
+		),
+		getGraph: connect.NewClient[v1.GetGraphRequest, v1.GetGraphResponse](
+			httpClient,
+			baseURL+GraphServiceGetGraphProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("GetGraph")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteGraph: connect.NewClient[v1.DeleteGraphRequest, v1.DeleteGraphResponse](
+			httpClient,
+			baseURL+GraphServiceDeleteGraphProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("DeleteGraph")),
+			connect.WithClientOptions(opts...),
+		),
+		computeTrust: connect.NewClient[v1.ComputeTrustRequest, v1.ComputeTrustResponse](
+			httpClient,
+			baseURL+GraphServiceComputeTrustProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("ComputeTrust")),
+			connect.WithClientOptions(opts...),
+		),
+		maxFlow: connect.NewClient[v1.MaxFlowRequest, v1.MaxFlowResponse](
+			httpClient,
+			baseURL+GraphServiceMaxFlowProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("MaxFlow")),
+			connect.WithClientOptions(opts...),
+		),
+		shortestPaths: connect.NewClient[v1.ShortestPathsRequest, v1.ShortestPathsResponse](
+			httpClient,
+			baseURL+GraphServiceShortestPathsProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("ShortestPaths")),
+			connect.WithClientOptions(opts...),
+		),
+		graphStats: connect.NewClient[v1.GraphStatsRequest, v1.GraphStatsResponse](
+			httpClient,
+			baseURL+GraphServiceGraphStatsProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("GraphStats")),
+			connect.WithClientOptions(opts...),
+		),
+		runExperiment: connect.NewClient[v1.RunExperimentRequest, v1.RunExperimentResponse](
+			httpClient,
+			baseURL+GraphServiceRunExperimentProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("RunExperiment")),
+			connect.WithClientOptions(opts...),
+		),
+		replay: connect.NewClient[v1.ReplayRequest, v1.RandomGraphResponse](
+			httpClient,
+			baseURL+GraphServiceReplayProcedure,
+			connect.WithSchema(graphServiceMethods.ByName("Replay")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // graphServiceClient implements GraphServiceClient.
 type graphServiceClient struct {
-	randomGraph *connect.Client[v1.RandomGraphRequest, v1.RandomGraphResponse]
+	randomGraph   *connect.Client[v1.RandomGraphRequest, v1.RandomGraphResponse]
+	loadGraph     *connect.Client[v1.LoadGraphRequest, v1.LoadGraphResponse]
+	exportGraph   *connect.Client[v1.ExportGraphRequest, v1.ExportGraphResponse]
+	createGraph   *connect.Client[v1.CreateGraphRequest, v1.CreateGraphResponse]
+	runWalks      *connect.Client[v1.RunWalksRequest, v1.RunWalksResponse]
+	relayout      *connect.Client[v1.RelayoutRequest, v1.RelayoutResponse]
+	streamLayout  *connect.Client[v1.StreamLayoutRequest, v1.StreamLayoutResponse]
+	listGraphs    *connect.Client[v1.ListGraphsRequest, v1.ListGraphsResponse]
+	getGraph      *connect.Client[v1.GetGraphRequest, v1.GetGraphResponse]
+	deleteGraph   *connect.Client[v1.DeleteGraphRequest, v1.DeleteGraphResponse]
+	computeTrust  *connect.Client[v1.ComputeTrustRequest, v1.ComputeTrustResponse]
+	maxFlow       *connect.Client[v1.MaxFlowRequest, v1.MaxFlowResponse]
+	shortestPaths *connect.Client[v1.ShortestPathsRequest, v1.ShortestPathsResponse]
+	graphStats    *connect.Client[v1.GraphStatsRequest, v1.GraphStatsResponse]
+	runExperiment *connect.Client[v1.RunExperimentRequest, v1.RunExperimentResponse]
+	replay        *connect.Client[v1.ReplayRequest, v1.RandomGraphResponse]
 }
 
 // RandomGraph calls internal.rpc.v1.GraphService.RandomGraph.
@@ -73,9 +240,109 @@ func (c *graphServiceClient) RandomGraph(ctx context.Context, req *connect.Reque
 	return c.randomGraph.CallUnary(ctx, req)
 }
 
+// LoadGraph calls internal.rpc.v1.GraphService.LoadGraph.
+func (c *graphServiceClient) LoadGraph(ctx context.Context, req *connect.Request[v1.LoadGraphRequest]) (*connect.Response[v1.LoadGraphResponse], error) {
+	return c.loadGraph.CallUnary(ctx, req)
+}
+
+// ExportGraph calls internal.rpc.v1.GraphService.ExportGraph.
+func (c *graphServiceClient) ExportGraph(ctx context.Context, req *connect.Request[v1.ExportGraphRequest]) (*connect.Response[v1.ExportGraphResponse], error) {
+	return c.exportGraph.CallUnary(ctx, req)
+}
+
+// CreateGraph calls internal.rpc.v1.GraphService.CreateGraph.
+func (c *graphServiceClient) CreateGraph(ctx context.Context, req *connect.Request[v1.CreateGraphRequest]) (*connect.Response[v1.CreateGraphResponse], error) {
+	return c.createGraph.CallUnary(ctx, req)
+}
+
+// RunWalks calls internal.rpc.v1.GraphService.RunWalks.
+func (c *graphServiceClient) RunWalks(ctx context.Context, req *connect.Request[v1.RunWalksRequest]) (*connect.Response[v1.RunWalksResponse], error) {
+	return c.runWalks.CallUnary(ctx, req)
+}
+
+// Relayout calls internal.rpc.v1.GraphService.Relayout.
+func (c *graphServiceClient) Relayout(ctx context.Context, req *connect.Request[v1.RelayoutRequest]) (*connect.Response[v1.RelayoutResponse], error) {
+	return c.relayout.CallUnary(ctx, req)
+}
+
+// StreamLayout calls internal.rpc.v1.GraphService.StreamLayout.
+func (c *graphServiceClient) StreamLayout(ctx context.Context, req *connect.Request[v1.StreamLayoutRequest]) (*connect.ServerStreamForClient[v1.StreamLayoutResponse], error) {
+	return c.streamLayout.CallServerStream(ctx, req)
+}
+
+// ListGraphs calls internal.rpc.v1.GraphService.ListGraphs.
+func (c *graphServiceClient) ListGraphs(ctx context.Context, req *connect.Request[v1.ListGraphsRequest]) (*connect.Response[v1.ListGraphsResponse], error) {
+	return c.listGraphs.CallUnary(ctx, req)
+}
+
+// GetGraph calls internal.rpc.v1.GraphService.GetGraph.
+func (c *graphServiceClient) GetGraph(ctx context.Context, req *connect.Request[v1.GetGraphRequest]) (*connect.Response[v1.GetGraphResponse], error) {
+	return c.getGraph.CallUnary(ctx, req)
+}
+
+// DeleteGraph calls internal.rpc.v1.GraphService.DeleteGraph.
+func (c *graphServiceClient) DeleteGraph(ctx context.Context, req *connect.Request[v1.DeleteGraphRequest]) (*connect.Response[v1.DeleteGraphResponse], error) {
+	return c.deleteGraph.CallUnary(ctx, req)
+}
+
+// ComputeTrust calls internal.rpc.v1.GraphService.ComputeTrust.
+func (c *graphServiceClient) ComputeTrust(ctx context.Context, req *connect.Request[v1.ComputeTrustRequest]) (*connect.Response[v1.ComputeTrustResponse], error) {
+	return c.computeTrust.CallUnary(ctx, req)
+}
+
+// MaxFlow calls internal.rpc.v1.GraphService.MaxFlow.
+func (c *graphServiceClient) MaxFlow(ctx context.Context, req *connect.Request[v1.MaxFlowRequest]) (*connect.Response[v1.MaxFlowResponse], error) {
+	return c.maxFlow.CallUnary(ctx, req)
+}
+
+// ShortestPaths calls internal.rpc.v1.GraphService.ShortestPaths.
+func (c *graphServiceClient) ShortestPaths(ctx context.Context, req *connect.Request[v1.ShortestPathsRequest]) (*connect.Response[v1.ShortestPathsResponse], error) {
+	return c.shortestPaths.CallUnary(ctx, req)
+}
+
+// GraphStats calls internal.rpc.v1.GraphService.GraphStats.
+func (c *graphServiceClient) GraphStats(ctx context.Context, req *connect.Request[v1.GraphStatsRequest]) (*connect.Response[v1.GraphStatsResponse], error) {
+	return c.graphStats.CallUnary(ctx, req)
+}
+
+// RunExperiment calls internal.rpc.v1.GraphService.RunExperiment.
+func (c *graphServiceClient) RunExperiment(ctx context.Context, req *connect.Request[v1.RunExperimentRequest]) (*connect.Response[v1.RunExperimentResponse], error) {
+	return c.runExperiment.CallUnary(ctx, req)
+}
+
+// Replay calls internal.rpc.v1.GraphService.Replay.
+func (c *graphServiceClient) Replay(ctx context.Context, req *connect.Request[v1.ReplayRequest]) (*connect.Response[v1.RandomGraphResponse], error) {
+	return c.replay.CallUnary(ctx, req)
+}
+
 // GraphServiceHandler is an implementation of the internal.rpc.v1.GraphService service.
 type GraphServiceHandler interface {
 	RandomGraph(context.Context, *connect.Request[v1.RandomGraphRequest]) (*connect.Response[v1.RandomGraphResponse], error)
+	LoadGraph(context.Context, *connect.Request[v1.LoadGraphRequest]) (*connect.Response[v1.LoadGraphResponse], error)
+	ExportGraph(context.Context, *connect.Request[v1.ExportGraphRequest]) (*connect.Response[v1.ExportGraphResponse], error)
+	CreateGraph(context.Context, *connect.Request[v1.CreateGraphRequest]) (*connect.Response[v1.CreateGraphResponse], error)
+	RunWalks(context.Context, *connect.Request[v1.RunWalksRequest]) (*connect.Response[v1.RunWalksResponse], error)
+	Relayout(context.Context, *connect.Request[v1.RelayoutRequest]) (*connect.Response[v1.RelayoutResponse], error)
+	// StreamLayout applies a force-directed layout to a stored graph like Relayout, and streams snapshots
+	// of the node positions while it runs.
+	StreamLayout(context.Context, *connect.Request[v1.StreamLayoutRequest], *connect.ServerStream[v1.StreamLayoutResponse]) error
+	// ListGraphs lists the graphs and experiments in the store.
+	ListGraphs(context.Context, *connect.Request[v1.ListGraphsRequest]) (*connect.Response[v1.ListGraphsResponse], error)
+	GetGraph(context.Context, *connect.Request[v1.GetGraphRequest]) (*connect.Response[v1.GetGraphResponse], error)
+	DeleteGraph(context.Context, *connect.Request[v1.DeleteGraphRequest]) (*connect.Response[v1.DeleteGraphResponse], error)
+	// ComputeTrust computes how much Bob trusts every node in the graph.
+	ComputeTrust(context.Context, *connect.Request[v1.ComputeTrustRequest]) (*connect.Response[v1.ComputeTrustResponse], error)
+	// MaxFlow computes the maximum flow from Bob to Ada, and a minimum cut that separates them.
+	MaxFlow(context.Context, *connect.Request[v1.MaxFlowRequest]) (*connect.Response[v1.MaxFlowResponse], error)
+	// ShortestPaths finds the k shortest paths from Bob to Ada.
+	ShortestPaths(context.Context, *connect.Request[v1.ShortestPathsRequest]) (*connect.Response[v1.ShortestPathsResponse], error)
+	// GraphStats reports the structure of the graph.
+	GraphStats(context.Context, *connect.Request[v1.GraphStatsRequest]) (*connect.Response[v1.GraphStatsResponse], error)
+	// RunExperiment runs the walks over sweeps of the graph and walk parameters, and aggregates how often
+	// the walks of Bob and Ada meet.
+	RunExperiment(context.Context, *connect.Request[v1.RunExperimentRequest]) (*connect.Response[v1.RunExperimentResponse], error)
+	// Replay generates a graph again from its manifest, the graph is identical to the original.
+	Replay(context.Context, *connect.Request[v1.ReplayRequest]) (*connect.Response[v1.RandomGraphResponse], error)
 }
 
 // NewGraphServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -91,10 +358,130 @@ func NewGraphServiceHandler(svc GraphServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(graphServiceMethods.ByName("RandomGraph")),
 		connect.WithHandlerOptions(opts...),
 	)
+	graphServiceLoadGraphHandler := connect.NewUnaryHandler(
+		GraphServiceLoadGraphProcedure,
+		svc.LoadGraph,
+		connect.WithSchema(graphServiceMethods.ByName("LoadGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceExportGraphHandler := connect.NewUnaryHandler(
+		GraphServiceExportGraphProcedure,
+		svc.ExportGraph,
+		connect.WithSchema(graphServiceMethods.ByName("ExportGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceCreateGraphHandler := connect.NewUnaryHandler(
+		GraphServiceCreateGraphProcedure,
+		svc.CreateGraph,
+		connect.WithSchema(graphServiceMethods.ByName("CreateGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceRunWalksHandler := connect.NewUnaryHandler(
+		GraphServiceRunWalksProcedure,
+		svc.RunWalks,
+		connect.WithSchema(graphServiceMethods.ByName("RunWalks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceRelayoutHandler := connect.NewUnaryHandler(
+		GraphServiceRelayoutProcedure,
+		svc.Relayout,
+		connect.WithSchema(graphServiceMethods.ByName("Relayout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceStreamLayoutHandler := connect.NewServerStreamHandler(
+		GraphServiceStreamLayoutProcedure,
+		svc.StreamLayout,
+		connect.WithSchema(graphServiceMethods.ByName("StreamLayout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceListGraphsHandler := connect.NewUnaryHandler(
+		GraphServiceListGraphsProcedure,
+		svc.ListGraphs,
+		connect.WithSchema(graphServiceMethods.ByName("ListGraphs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceGetGraphHandler := connect.NewUnaryHandler(
+		GraphServiceGetGraphProcedure,
+		svc.GetGraph,
+		connect.WithSchema(graphServiceMethods.ByName("GetGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceDeleteGraphHandler := connect.NewUnaryHandler(
+		GraphServiceDeleteGraphProcedure,
+		svc.DeleteGraph,
+		connect.WithSchema(graphServiceMethods.ByName("DeleteGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceComputeTrustHandler := connect.NewUnaryHandler(
+		GraphServiceComputeTrustProcedure,
+		svc.ComputeTrust,
+		connect.WithSchema(graphServiceMethods.ByName("ComputeTrust")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceMaxFlowHandler := connect.NewUnaryHandler(
+		GraphServiceMaxFlowProcedure,
+		svc.MaxFlow,
+		connect.WithSchema(graphServiceMethods.ByName("MaxFlow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceShortestPathsHandler := connect.NewUnaryHandler(
+		GraphServiceShortestPathsProcedure,
+		svc.ShortestPaths,
+		connect.WithSchema(graphServiceMethods.ByName("ShortestPaths")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceGraphStatsHandler := connect.NewUnaryHandler(
+		GraphServiceGraphStatsProcedure,
+		svc.GraphStats,
+		connect.WithSchema(graphServiceMethods.ByName("GraphStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceRunExperimentHandler := connect.NewUnaryHandler(
+		GraphServiceRunExperimentProcedure,
+		svc.RunExperiment,
+		connect.WithSchema(graphServiceMethods.ByName("RunExperiment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	graphServiceReplayHandler := connect.NewUnaryHandler(
+		GraphServiceReplayProcedure,
+		svc.Replay,
+		connect.WithSchema(graphServiceMethods.ByName("Replay")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/internal.rpc.v1.GraphService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GraphServiceRandomGraphProcedure:
 			graphServiceRandomGraphHandler.ServeHTTP(w, r)
+		case GraphServiceLoadGraphProcedure:
+			graphServiceLoadGraphHandler.ServeHTTP(w, r)
+		case GraphServiceExportGraphProcedure:
+			graphServiceExportGraphHandler.ServeHTTP(w, r)
+		case GraphServiceCreateGraphProcedure:
+			graphServiceCreateGraphHandler.ServeHTTP(w, r)
+		case GraphServiceRunWalksProcedure:
+			graphServiceRunWalksHandler.ServeHTTP(w, r)
+		case GraphServiceRelayoutProcedure:
+			graphServiceRelayoutHandler.ServeHTTP(w, r)
+		case GraphServiceStreamLayoutProcedure:
+			graphServiceStreamLayoutHandler.ServeHTTP(w, r)
+		case GraphServiceListGraphsProcedure:
+			graphServiceListGraphsHandler.ServeHTTP(w, r)
+		case GraphServiceGetGraphProcedure:
+			graphServiceGetGraphHandler.ServeHTTP(w, r)
+		case GraphServiceDeleteGraphProcedure:
+			graphServiceDeleteGraphHandler.ServeHTTP(w, r)
+		case GraphServiceComputeTrustProcedure:
+			graphServiceComputeTrustHandler.ServeHTTP(w, r)
+		case GraphServiceMaxFlowProcedure:
+			graphServiceMaxFlowHandler.ServeHTTP(w, r)
+		case GraphServiceShortestPathsProcedure:
+			graphServiceShortestPathsHandler.ServeHTTP(w, r)
+		case GraphServiceGraphStatsProcedure:
+			graphServiceGraphStatsHandler.ServeHTTP(w, r)
+		case GraphServiceRunExperimentProcedure:
+			graphServiceRunExperimentHandler.ServeHTTP(w, r)
+		case GraphServiceReplayProcedure:
+			graphServiceReplayHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -107,3 +494,63 @@ type UnimplementedGraphServiceHandler struct{}
 func (UnimplementedGraphServiceHandler) RandomGraph(context.Context, *connect.Request[v1.RandomGraphRequest]) (*connect.Response[v1.RandomGraphResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.RandomGraph is not implemented"))
 }
+
+func (UnimplementedGraphServiceHandler) LoadGraph(context.Context, *connect.Request[v1.LoadGraphRequest]) (*connect.Response[v1.LoadGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.LoadGraph is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) ExportGraph(context.Context, *connect.Request[v1.ExportGraphRequest]) (*connect.Response[v1.ExportGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.ExportGraph is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) CreateGraph(context.Context, *connect.Request[v1.CreateGraphRequest]) (*connect.Response[v1.CreateGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.CreateGraph is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) RunWalks(context.Context, *connect.Request[v1.RunWalksRequest]) (*connect.Response[v1.RunWalksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.RunWalks is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) Relayout(context.Context, *connect.Request[v1.RelayoutRequest]) (*connect.Response[v1.RelayoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.Relayout is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) StreamLayout(context.Context, *connect.Request[v1.StreamLayoutRequest], *connect.ServerStream[v1.StreamLayoutResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.StreamLayout is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) ListGraphs(context.Context, *connect.Request[v1.ListGraphsRequest]) (*connect.Response[v1.ListGraphsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.ListGraphs is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) GetGraph(context.Context, *connect.Request[v1.GetGraphRequest]) (*connect.Response[v1.GetGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.GetGraph is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) DeleteGraph(context.Context, *connect.Request[v1.DeleteGraphRequest]) (*connect.Response[v1.DeleteGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.DeleteGraph is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) ComputeTrust(context.Context, *connect.Request[v1.ComputeTrustRequest]) (*connect.Response[v1.ComputeTrustResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.ComputeTrust is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) MaxFlow(context.Context, *connect.Request[v1.MaxFlowRequest]) (*connect.Response[v1.MaxFlowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.MaxFlow is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) ShortestPaths(context.Context, *connect.Request[v1.ShortestPathsRequest]) (*connect.Response[v1.ShortestPathsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.ShortestPaths is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) GraphStats(context.Context, *connect.Request[v1.GraphStatsRequest]) (*connect.Response[v1.GraphStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.GraphStats is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) RunExperiment(context.Context, *connect.Request[v1.RunExperimentRequest]) (*connect.Response[v1.RunExperimentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.RunExperiment is not implemented"))
+}
+
+func (UnimplementedGraphServiceHandler) Replay(context.Context, *connect.Request[v1.ReplayRequest]) (*connect.Response[v1.RandomGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internal.rpc.v1.GraphService.Replay is not implemented"))
+}
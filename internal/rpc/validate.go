@@ -0,0 +1,333 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// validator collects the fields of a request that violate their constraints. Like protovalidate, every
+// violation names the path of the field, the rule it broke and a message.
+type validator struct {
+	cfg        Config
+	violations []*rpcv1.FieldViolation
+}
+
+// validate checks the request against the rules declared in rpc.proto, runs the checks that depend on the
+// caps of the server or on several fields, and returns the error for their violations.
+func (s g) validate(req proto.Message, check func(v *validator)) error {
+	v := &validator{cfg: s.cfg}
+	v.rules("", req.ProtoReflect())
+	check(v)
+	return v.err()
+}
+
+// violate records that the field at the path broke the rule.
+func (v *validator) violate(path, rule, format string, args ...any) {
+	violation := &rpcv1.FieldViolation{}
+	violation.SetFieldPath(path)
+	violation.SetRuleId(rule)
+	violation.SetMessage(fmt.Sprintf(format, args...))
+	v.violations = append(v.violations, violation)
+}
+
+// err returns nil if there are no violations, or an invalid argument error that lists them and carries
+// them as a Violations detail.
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(v.violations))
+	for _, violation := range v.violations {
+		if violation.GetFieldPath() == "" {
+			msgs = append(msgs, violation.GetMessage())
+			continue
+		}
+		msgs = append(msgs, violation.GetFieldPath()+": "+violation.GetMessage())
+	}
+
+	cerr := connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request: "+strings.Join(msgs, "; ")))
+	details := &rpcv1.Violations{}
+	details.SetViolations(v.violations)
+	if detail, err := connect.NewErrorDetail(details); err == nil {
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}
+
+// atMost checks that x doesn't exceed the cap of the server.
+func (v *validator) atMost(path string, x, limit int64, rule string) {
+	if x > limit {
+		v.violate(path, rule, "value must be less than or equal to %d", limit)
+	}
+}
+
+// field returns the path of a field within the message at the prefix.
+func field(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// graph checks the fields of the request that configure the graph itself: the generator, the Sybil
+// region, the orientation and the edge weights. It returns the number of nodes of the graph including its
+// Sybil region, which is 0 for stored graphs.
+func (v *validator) graph(prefix string, req *rpcv1.RandomGraphRequest) int64 {
+	maxNodes := int64(v.cfg.MaxNodes)
+
+	var numNodes int64
+	switch req.WhichGenerator() {
+	case rpcv1.RandomGraphRequest_WattsStrogatz_case:
+		p := req.GetWattsStrogatz()
+		numNodes = v.wattsStrogatz(field(prefix, "watts_strogatz"), p.GetNumNodes(), p.GetInitialConnected())
+	case rpcv1.RandomGraphRequest_ErdosRenyi_case:
+		numNodes = req.GetErdosRenyi().GetNumNodes()
+		v.atMost(field(prefix, "erdos_renyi.num_nodes"), numNodes, maxNodes, "trustd.max_nodes")
+	case rpcv1.RandomGraphRequest_BarabasiAlbert_case:
+		p, path := req.GetBarabasiAlbert(), field(prefix, "barabasi_albert")
+		numNodes = p.GetNumNodes()
+		v.atMost(field(path, "num_nodes"), numNodes, maxNodes, "trustd.max_nodes")
+		if numNodes >= 2 && p.GetEdgesPerNode() >= numNodes {
+			v.violate(field(path, "edges_per_node"), "trustd.lt_num_nodes", "value must be less than num_nodes")
+		}
+	case rpcv1.RandomGraphRequest_StochasticBlock_case:
+		p, path := req.GetStochasticBlock(), field(prefix, "stochastic_block")
+		for i, size := range p.GetBlockSizes() {
+			v.atMost(fmt.Sprintf("%s[%d]", field(path, "block_sizes"), i), size, maxNodes, "trustd.max_nodes")
+			numNodes += max(0, min(size, maxNodes+1))
+		}
+		if numNodes > maxNodes {
+			v.violate(field(path, "block_sizes"), "trustd.max_nodes", "blocks must have at most %d nodes in total", maxNodes)
+		}
+	case rpcv1.RandomGraphRequest_RandomRegular_case:
+		p, path := req.GetRandomRegular(), field(prefix, "random_regular")
+		numNodes = p.GetNumNodes()
+		v.atMost(field(path, "num_nodes"), numNodes, maxNodes, "trustd.max_nodes")
+		if p.GetDegree() > 0 && p.GetDegree() >= numNodes {
+			v.violate(field(path, "degree"), "trustd.lt_num_nodes", "value must be less than num_nodes")
+		}
+		if numNodes*p.GetDegree()%2 != 0 {
+			v.violate(field(path, "degree"), "trustd.even_degree_sum", "num_nodes times degree must be even")
+		}
+	case rpcv1.RandomGraphRequest_Lattice_case:
+		p, path := req.GetLattice(), field(prefix, "lattice")
+		v.atMost(field(path, "rows"), p.GetRows(), maxNodes, "trustd.max_nodes")
+		v.atMost(field(path, "columns"), p.GetColumns(), maxNodes, "trustd.max_nodes")
+		if p.GetRows() > 0 && p.GetColumns() > 0 && p.GetRows()*p.GetColumns() > maxNodes {
+			v.violate(path, "trustd.max_nodes", "rows times columns must be at most %d", maxNodes)
+		}
+		numNodes = max(0, p.GetRows()) * max(0, p.GetColumns())
+	case rpcv1.RandomGraphRequest_GraphId_case:
+	case rpcv1.RandomGraphRequest_Generator_not_set_case:
+		numNodes = v.wattsStrogatz(prefix, req.GetNumNodes(), req.GetInitialConnected())
+	}
+
+	v.overlay(prefix, req, numNodes)
+	return numNodes + max(0, req.GetSybilRegion().GetNumNodes())
+}
+
+// overlay checks the fields of the request that apply on top of the generated graph with the given number
+// of nodes: the Sybil region and the edge weights.
+func (v *validator) overlay(prefix string, req *rpcv1.RandomGraphRequest, numNodes int64) {
+	maxNodes := int64(v.cfg.MaxNodes)
+
+	sybil, path := req.GetSybilRegion(), field(prefix, "sybil_region")
+	if sybil.GetNumNodes() != 0 {
+		sybilNodes := v.wattsStrogatz(path, sybil.GetNumNodes(), sybil.GetInitialConnected())
+		if numNodes <= maxNodes && sybilNodes <= maxNodes && numNodes+sybilNodes > maxNodes {
+			v.violate(field(path, "num_nodes"), "trustd.max_nodes",
+				"the graph and the Sybil region must have at most %d nodes in total", maxNodes)
+		}
+	}
+	v.atMost(field(path, "attack_edges"), sybil.GetAttackEdges(), maxNodes, "trustd.max_nodes")
+
+	v.edgeWeights(field(prefix, "edge_weights"), req.GetEdgeWeights())
+}
+
+// wattsStrogatz checks the size of a Watts–Strogatz graph, and returns its number of nodes. Every node
+// needs a node to connect to that isn't already a neighbor, so there must be more nodes than ring
+// neighbors.
+func (v *validator) wattsStrogatz(path string, numNodes, initialConnected int64) int64 {
+	v.atMost(field(path, "num_nodes"), numNodes, int64(v.cfg.MaxNodes), "trustd.max_nodes")
+	if initialConnected > 0 && initialConnected >= numNodes {
+		v.violate(field(path, "initial_connected"), "trustd.lt_num_nodes", "value must be less than num_nodes")
+	}
+	return numNodes
+}
+
+// edgeWeights checks that the bounds of uniform weights are in order, max defaults to 1.
+func (v *validator) edgeWeights(path string, p *rpcv1.EdgeWeights) {
+	if p.GetDistribution() != rpcv1.WeightDistribution_WEIGHT_DISTRIBUTION_UNIFORM {
+		return
+	}
+	if p.HasMax() {
+		if p.GetMax() < p.GetMin() {
+			v.violate(field(path, "max"), "trustd.gte_min", "value must be greater than or equal to min")
+		}
+	} else if p.GetMin() > 1 {
+		v.violate(field(path, "min"), "trustd.lte_max", "value must be less than or equal to max, which defaults to 1")
+	}
+}
+
+// layout checks the fields of the request that configure the layout.
+func (v *validator) layout(prefix string, req *rpcv1.RandomGraphRequest) {
+	switch req.WhichLayout() {
+	case rpcv1.RandomGraphRequest_ForceDirected_case:
+		p := req.GetForceDirected()
+		v.forceDirected(field(prefix, "force_directed"), "", p.GetIterations(), p.GetArea())
+	case rpcv1.RandomGraphRequest_KamadaKawai_case:
+		v.atMost(field(prefix, "kamada_kawai.iterations"), req.GetKamadaKawai().GetIterations(),
+			int64(v.cfg.MaxIterations), "trustd.max_iterations")
+	case rpcv1.RandomGraphRequest_Circular_case, rpcv1.RandomGraphRequest_Spectral_case,
+		rpcv1.RandomGraphRequest_Hierarchical_case:
+	case rpcv1.RandomGraphRequest_Layout_not_set_case:
+		v.forceDirected(prefix, "layout_", req.GetLayoutIterations(), req.GetLayoutArea())
+	}
+}
+
+// layoutSize checks that a graph with the given number of nodes isn't too large for the layout of the
+// request. The Kamada–Kawai layout needs the distance between every pair of nodes, so it is capped.
+func (v *validator) layoutSize(prefix string, req *rpcv1.RandomGraphRequest, numNodes int64) {
+	if req.WhichLayout() == rpcv1.RandomGraphRequest_KamadaKawai_case && numNodes > int64(v.cfg.MaxKamadaKawaiNodes) {
+		v.violate(field(prefix, "kamada_kawai"), "trustd.max_kamada_kawai_nodes",
+			"the layout supports graphs of at most %d nodes", v.cfg.MaxKamadaKawaiNodes)
+	}
+}
+
+// checkLayoutSize fails with a failed precondition error if the graph is too large for the layout of the
+// request, like layoutSize does for graphs that are generated. It guards stored graphs, whose size isn't
+// known when the request is validated.
+func (s g) checkLayoutSize(req *rpcv1.RandomGraphRequest, graph *rpcv1.RandomGraphResponse) error {
+	numNodes := len(graph.GetNodes())
+	if req.WhichLayout() == rpcv1.RandomGraphRequest_KamadaKawai_case && numNodes > s.cfg.MaxKamadaKawaiNodes {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf(
+			"the graph has %d nodes, the Kamada–Kawai layout supports at most %d", numNodes, s.cfg.MaxKamadaKawaiNodes))
+	}
+	return nil
+}
+
+// forceDirected checks the parameters of the force-directed layout, their names start with the given
+// infix. The ideal edge length follows from the area, so the area must be set for the layout to run.
+func (v *validator) forceDirected(path, infix string, iterations int64, area float64) {
+	v.atMost(field(path, infix+"iterations"), iterations, int64(v.cfg.MaxIterations), "trustd.max_iterations")
+	if iterations > 0 && area == 0 {
+		v.violate(field(path, infix+"area"), "trustd.area_required", "value is required when there are iterations")
+	}
+}
+
+// walks checks the fields of the request that configure the walks, the total number of steps of Bob's
+// and Ada's walks together is capped.
+func (v *validator) walks(prefix string, req *rpcv1.RandomGraphRequest) {
+	maxSteps := int64(v.cfg.MaxWalkSteps)
+	v.atMost(field(prefix, "walk_length"), req.GetWalkLength(), maxSteps, "trustd.max_walk_steps")
+	v.atMost(field(prefix, "num_walks"), req.GetNumWalks(), maxSteps, "trustd.max_walk_steps")
+	if req.GetWalkLength() > 0 && req.GetNumWalks() > 0 && req.GetWalkLength() <= maxSteps &&
+		req.GetNumWalks() <= maxSteps && 2*req.GetWalkLength()*req.GetNumWalks() > maxSteps {
+		v.violate(field(prefix, "num_walks"), "trustd.max_walk_steps",
+			"the walks of both parties must take at most %d steps in total", maxSteps)
+	}
+}
+
+// source checks the request of a graph that is generated on the fly.
+func (v *validator) source(prefix string, src *rpcv1.GraphSource) {
+	if src.WhichSource() == rpcv1.GraphSource_Generate_case {
+		path := field(prefix, "generate")
+		v.layoutSize(path, src.GetGenerate(), v.graph(path, src.GetGenerate()))
+		v.layout(path, src.GetGenerate())
+		v.walks(path, src.GetGenerate())
+	}
+}
+
+// manifest checks the requests of a manifest that is replayed.
+func (v *validator) manifest(prefix string, manifest *rpcv1.GraphManifest) {
+	path := field(prefix, "request")
+	numNodes := v.graph(path, manifest.GetRequest())
+	v.layout(path, manifest.GetRequest())
+	v.layoutSize(path, manifest.GetRequest(), numNodes)
+	v.walks(path, manifest.GetRequest())
+	if manifest.HasRelayout() {
+		v.layout(field(prefix, "relayout"), manifest.GetRelayout())
+		v.layoutSize(field(prefix, "relayout"), manifest.GetRelayout(), numNodes)
+	}
+}
+
+// computeTrust checks the parameters of the selected trust metric against the caps of the server.
+func (v *validator) computeTrust(req *rpcv1.ComputeTrustRequest) {
+	maxIterations := int64(v.cfg.MaxIterations)
+
+	v.source("source", req.GetSource())
+	switch req.WhichMetric() {
+	case rpcv1.ComputeTrustRequest_PersonalizedPageRank_case:
+		p := req.GetPersonalizedPageRank()
+		v.atMost("personalized_page_rank.max_iterations", p.GetMaxIterations(), maxIterations, "trustd.max_iterations")
+		v.atMost("personalized_page_rank.num_walks", p.GetNumWalks(), int64(v.cfg.MaxWalkSteps), "trustd.max_walk_steps")
+	case rpcv1.ComputeTrustRequest_EigenTrust_case:
+		v.atMost("eigen_trust.max_iterations", req.GetEigenTrust().GetMaxIterations(), maxIterations,
+			"trustd.max_iterations")
+	case rpcv1.ComputeTrustRequest_Advogato_case:
+		for i, capacity := range req.GetAdvogato().GetCapacities() {
+			v.atMost(fmt.Sprintf("advogato.capacities[%d]", i), capacity, int64(v.cfg.MaxNodes), "trustd.max_nodes")
+		}
+	case rpcv1.ComputeTrustRequest_TidalTrust_case, rpcv1.ComputeTrustRequest_Metric_not_set_case:
+	}
+}
+
+// experiment checks the sweeps of the experiment, and caps the number of its runs. The swept parameters
+// are checked by the bounds of their sweeps, so the largest values are checked against each other.
+func (v *validator) experiment(req *rpcv1.RunExperimentRequest) {
+	exp := newExperiment(req)
+	maxNodes, maxSteps := int64(v.cfg.MaxNodes), int64(v.cfg.MaxWalkSteps)
+
+	runs := float64(exp.rewiringProbability.count())
+	intSweep := func(s sweep[int64], limit int64, rule string) {
+		v.atMost(s.field("start"), s.start, limit, rule)
+		if s.swept && s.step > 0 {
+			if s.stop < s.start {
+				v.violate(s.field("stop"), "trustd.gte_start", "value must be greater than or equal to start")
+			}
+			v.atMost(s.field("stop"), s.stop, limit, rule)
+		}
+		runs *= float64(s.count())
+	}
+
+	intSweep(exp.numNodes, maxNodes, "trustd.max_nodes")
+	intSweep(exp.initialConnected, maxNodes, "trustd.max_nodes")
+	intSweep(exp.walkLength, maxSteps, "trustd.max_walk_steps")
+	intSweep(exp.numWalks, maxSteps, "trustd.max_walk_steps")
+	intSweep(exp.seeds, math.MaxInt64, "")
+
+	// the rules of a sweep don't know which parameter it sweeps, so the rewiring probability is checked here.
+	if beta := exp.rewiringProbability; beta.swept {
+		if beta.start > 1 {
+			v.violate(beta.field("start"), "trustd.probability", "value must be less than or equal to 1")
+		}
+		if beta.step > 0 && beta.stop < beta.start {
+			v.violate(beta.field("stop"), "trustd.gte_start", "value must be greater than or equal to start")
+		} else if beta.step > 0 && beta.stop > 1 {
+			v.violate(beta.field("stop"), "trustd.probability", "value must be less than or equal to 1")
+		}
+	}
+
+	if k := exp.initialConnected.last(); k > 0 && k >= exp.numNodes.start {
+		v.violate(exp.initialConnected.field("stop"), "trustd.lt_num_nodes",
+			"value must be less than the smallest num_nodes")
+	}
+	if length, num := exp.walkLength.last(), exp.numWalks.last(); length <= maxSteps && num <= maxSteps &&
+		2*length*num > maxSteps {
+		v.violate(exp.numWalks.field("stop"), "trustd.max_walk_steps",
+			"the walks of both parties must take at most %d steps in total", maxSteps)
+	}
+	if runs > float64(v.cfg.MaxExperimentRuns) {
+		v.violate("", "trustd.max_experiment_runs",
+			"the experiment must have at most %d runs over all configurations and seeds", v.cfg.MaxExperimentRuns)
+	}
+
+	v.overlay("base", req.GetBase(), exp.numNodes.last())
+}
@@ -0,0 +1,81 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// violations returns the violations of the error as "path rule" strings.
+func violations(t *testing.T, err error) []string {
+	t.Helper()
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) || cerr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("expected an invalid argument error, got %v", err)
+	}
+	var got []string
+	for _, detail := range cerr.Details() {
+		msg, err := detail.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if details, ok := msg.(*rpcv1.Violations); ok {
+			for _, violation := range details.GetViolations() {
+				got = append(got, violation.GetFieldPath()+" "+violation.GetRuleId())
+			}
+		}
+	}
+	return got
+}
+
+func TestRandomGraphViolations(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		change func(req *rpcv1.RandomGraphRequest)
+		want   []string
+	}{
+		{"negative count", func(req *rpcv1.RandomGraphRequest) {
+			req.SetWalkLength(-1)
+		}, []string{"walk_length int64.gte"}},
+		{"probability out of range", func(req *rpcv1.RandomGraphRequest) {
+			req.SetRewiringProbability(1.5)
+		}, []string{"rewiring_probability double.gte_lte"}},
+		{"infinite area", func(req *rpcv1.RandomGraphRequest) {
+			req.SetLayoutArea(math.Inf(1))
+		}, []string{"layout_area double.finite"}},
+		{"zero iterations", func(req *rpcv1.RandomGraphRequest) {
+			req.SetLayoutIterations(0)
+		}, []string{"layout_iterations int64.gt"}},
+		{"nested message", func(req *rpcv1.RandomGraphRequest) {
+			p := &rpcv1.StochasticBlockParams{}
+			p.SetBlockSizes([]int64{10, -1})
+			p.SetPIn(0.5)
+			req.SetStochasticBlock(p)
+		}, []string{"stochastic_block.block_sizes[1] int64.gte"}},
+		{"required field", func(req *rpcv1.RandomGraphRequest) {
+			p := &rpcv1.BarabasiAlbertParams{}
+			p.SetNumNodes(10)
+			req.SetBarabasiAlbert(p)
+		}, []string{"barabasi_albert.edges_per_node required"}},
+		{"server cap", func(req *rpcv1.RandomGraphRequest) {
+			req.SetNumNodes(1000000)
+		}, []string{"num_nodes trustd.max_nodes"}},
+		{"several fields", func(req *rpcv1.RandomGraphRequest) {
+			req.SetInitialConnected(200)
+		}, []string{"initial_connected trustd.lt_num_nodes"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := walkedRequest(1)
+			tt.change(req)
+			_, err := newService().RandomGraph(context.Background(), connect.NewRequest(req))
+			if got := violations(t, err); !slices.Equal(got, tt.want) {
+				t.Fatalf("got violations %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
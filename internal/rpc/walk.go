@@ -0,0 +1,268 @@
+package rpc
+
+import (
+	"context"
+	"math/rand/v2"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// graphIndex provides quick lookups over the nodes and edges of a graph.
+type graphIndex struct {
+	nodes   []*rpcv1.Node
+	nodeMap map[string]*rpcv1.Node
+	// adjacency lists the neighbors a walk can move to, for a directed graph these are the targets of
+	// the outgoing edges.
+	adjacency map[string][]string
+	// incoming lists the neighbors a walk can arrive from, for an undirected graph it is adjacency.
+	incoming map[string][]string
+	edgeMap  map[[2]string]*rpcv1.Edge
+	directed bool
+	// bobID and adaID are the parties, walks leave their types as they are.
+	bobID, adaID string
+}
+
+// newGraphIndex indexes the graph. Neighbors are listed in the order of the edges in the graph, which
+// keeps walks over the index deterministic for a given random source.
+func newGraphIndex(resp *rpcv1.RandomGraphResponse) *graphIndex {
+	nodes, edges := resp.GetNodes(), resp.GetEdges()
+	idx := &graphIndex{
+		nodes:     nodes,
+		nodeMap:   make(map[string]*rpcv1.Node, len(nodes)),
+		adjacency: make(map[string][]string, len(nodes)),
+		edgeMap:   make(map[[2]string]*rpcv1.Edge, len(edges)),
+		directed:  resp.GetDirected(),
+	}
+	idx.bobID, idx.adaID = findParties(resp)
+
+	for _, nd := range nodes {
+		idx.nodeMap[nd.GetId()] = nd
+	}
+
+	idx.incoming = idx.adjacency
+	if idx.directed {
+		idx.incoming = make(map[string][]string, len(nodes))
+	}
+
+	for _, edge := range edges {
+		s, t := edge.GetSource(), edge.GetTarget()
+		idx.adjacency[s] = append(idx.adjacency[s], t)
+		idx.incoming[t] = append(idx.incoming[t], s)
+		idx.edgeMap[idx.edgeKey(s, t)] = edge
+	}
+
+	return idx
+}
+
+// edgeKey returns the key of the edge from a to b, which is unordered unless the graph is directed.
+func (idx *graphIndex) edgeKey(a, b string) [2]string {
+	if idx.directed {
+		return [2]string{a, b}
+	}
+	return edgeKey(a, b)
+}
+
+// edgeKey returns an unordered key for the edge between a and b, so edges are treated as undirected.
+func edgeKey(a, b string) [2]string {
+	if a < b {
+		return [2]string{a, b}
+	}
+	return [2]string{b, a}
+}
+
+// startNode returns the given node id if it exists in the graph, or falls back to the first node.
+func (idx *graphIndex) startNode(nodeID string) string {
+	if _, ok := idx.nodeMap[nodeID]; ok {
+		return nodeID
+	}
+	return idx.nodes[0].GetId()
+}
+
+// step records a step from current to next: the visited node and traversed edge are re-typed and
+// the traversed edge's id is returned. Empty types leave the node and edge as they are, and the nodes
+// of the parties are never re-typed.
+func (idx *graphIndex) step(current, next, newNodeType, newEdgeType string) (string, bool) {
+	if newNodeType != "" && next != idx.bobID && next != idx.adaID {
+		idx.nodeMap[next].SetType(newNodeType)
+	}
+	edge, ok := idx.edgeMap[idx.edgeKey(current, next)]
+	if !ok {
+		return "", false
+	}
+	if newEdgeType != "" {
+		edge.SetType(newEdgeType)
+	}
+	return edge.GetId(), true
+}
+
+// NonWeightedRandomWalk performs a random walk of `walkLength` steps starting
+// from the given node ID in the provided graph, picking neighbors uniformly at
+// random. Edges are followed in both directions, unless the graph is directed
+// in which case only outgoing edges are followed.
+//
+// - The nodes of Bob and Ada keep their original type.
+// - Every *other node* visited, including a revisited start node, is updated to newNodeType.
+// - Every *edge* traversed is updated to newEdgeType.
+//
+// It returns the ids of the visited nodes (including the start node) and the ids
+// of the traversed edges, both in walk order. The walk checks the context on
+// every step, and stops with its error once it is done.
+func NonWeightedRandomWalk(
+	ctx context.Context,
+	rng *rand.Rand,
+	resp *rpcv1.RandomGraphResponse,
+	walkLength int,
+	startNodeID string,
+	newNodeType string,
+	newEdgeType string,
+) (path, edgePath []string, err error) {
+	if resp == nil || len(resp.GetNodes()) == 0 {
+		return nil, nil, nil
+	}
+
+	return newGraphIndex(resp).randomWalk(ctx, rng, walkLength, startNodeID, newNodeType, newEdgeType)
+}
+
+// randomWalk performs the walk of NonWeightedRandomWalk over the index.
+func (idx *graphIndex) randomWalk(
+	ctx context.Context,
+	rng *rand.Rand,
+	walkLength int,
+	startNodeID string,
+	newNodeType string,
+	newEdgeType string,
+) (path, edgePath []string, err error) {
+	current := idx.startNode(startNodeID)
+
+	// the starting node keeps its type unless the walk returns to it, the parties always keep theirs.
+	path = make([]string, 0, walkLength+1)
+	edgePath = make([]string, 0, walkLength)
+	path = append(path, current)
+
+	for range walkLength {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		neighbors := idx.adjacency[current]
+		if len(neighbors) == 0 {
+			break
+		}
+		next := neighbors[rng.IntN(len(neighbors))]
+
+		if edgeID, ok := idx.step(current, next, newNodeType, newEdgeType); ok {
+			edgePath = append(edgePath, edgeID)
+		}
+
+		path = append(path, next)
+		current = next
+	}
+
+	return path, edgePath, nil
+}
+
+// WeightedRandomWalk performs a random walk like NonWeightedRandomWalk, but picks neighbors with a
+// probability proportional to the weight of the edge to them. Edges without a weight count as weight 1.
+// The walk is lazy if laziness is positive: on every step it stays at its node with that probability,
+// which is recorded as a repeated node and an empty edge id. The walk stops early at a node without
+// positively weighted edges. Like NonWeightedRandomWalk it stops once the context is done.
+func WeightedRandomWalk(
+	ctx context.Context,
+	rng *rand.Rand,
+	resp *rpcv1.RandomGraphResponse,
+	walkLength int,
+	startNodeID string,
+	laziness float64,
+	newNodeType string,
+	newEdgeType string,
+) (path, edgePath []string, err error) {
+	if resp == nil || len(resp.GetNodes()) == 0 {
+		return nil, nil, nil
+	}
+
+	idx := newGraphIndex(resp)
+	current := idx.startNode(startNodeID)
+
+	path = make([]string, 0, walkLength+1)
+	edgePath = make([]string, 0, walkLength)
+	path = append(path, current)
+
+	for range walkLength {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		if laziness > 0 && rng.Float64() < laziness {
+			path = append(path, current)
+			edgePath = append(edgePath, "")
+			continue
+		}
+
+		next, ok := idx.weightedNeighbor(rng, current)
+		if !ok {
+			break
+		}
+
+		if edgeID, ok := idx.step(current, next, newNodeType, newEdgeType); ok {
+			edgePath = append(edgePath, edgeID)
+		}
+
+		path = append(path, next)
+		current = next
+	}
+
+	return path, edgePath, nil
+}
+
+// weightedNeighbor picks a neighbor of the node with a probability proportional to the weight of the
+// edge to it. It returns false if the node has no positively weighted edges.
+func (idx *graphIndex) weightedNeighbor(rng *rand.Rand, nodeID string) (string, bool) {
+	neighbors := idx.adjacency[nodeID]
+	weights := make([]float64, len(neighbors))
+
+	var total float64
+	for i, neighbor := range neighbors {
+		weights[i] = max(0, idx.weight(nodeID, neighbor))
+		total += weights[i]
+	}
+	if total <= 0 {
+		return "", false
+	}
+
+	pick := rng.Float64() * total
+	for i, weight := range weights {
+		if pick < weight {
+			return neighbors[i], true
+		}
+		pick -= weight
+	}
+
+	// rounding may leave a tiny remainder, it belongs to the last positively weighted neighbor.
+	for i := len(neighbors) - 1; ; i-- {
+		if weights[i] > 0 {
+			return neighbors[i], true
+		}
+	}
+}
+
+// weight returns the weight of the edge between a and b, edges without a weight count as weight 1.
+func (idx *graphIndex) weight(a, b string) float64 {
+	edge := idx.edgeMap[idx.edgeKey(a, b)]
+	if !edge.HasWeight() {
+		return 1
+	}
+	return edge.GetWeight()
+}
+
+// newWalk wraps the result of a random walk into its protobuf representation.
+func newWalk(owner rpcv1.Party, instance int, path, edgePath []string) *rpcv1.Walk {
+	walk := &rpcv1.Walk{}
+	walk.SetOwner(owner)
+	walk.SetInstance(int64(instance))
+	if len(path) > 0 {
+		walk.SetStartNode(path[0])
+	}
+	walk.SetNodeIds(path)
+	walk.SetEdgeIds(edgePath)
+	return walk
+}
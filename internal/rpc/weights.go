@@ -0,0 +1,115 @@
+package rpc
+
+import (
+	"math"
+	"math/rand/v2"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// Distribution draws edge weights.
+type Distribution interface {
+	Sample(r *rand.Rand) float64
+}
+
+// newDistribution returns the weight distribution as configured, or nil if no weights are to be assigned.
+func newDistribution(p *rpcv1.EdgeWeights) Distribution {
+	// orUnset returns the value of a field, or def if it isn't set.
+	orUnset := func(has bool, v, def float64) float64 {
+		if !has {
+			return def
+		}
+		return v
+	}
+
+	switch p.GetDistribution() {
+	case rpcv1.WeightDistribution_WEIGHT_DISTRIBUTION_UNIFORM:
+		return Uniform{Min: p.GetMin(), Max: orUnset(p.HasMax(), p.GetMax(), 1)}
+	case rpcv1.WeightDistribution_WEIGHT_DISTRIBUTION_EXPONENTIAL:
+		return Exponential{Mean: orUnset(p.HasMean(), p.GetMean(), 1)}
+	case rpcv1.WeightDistribution_WEIGHT_DISTRIBUTION_LOG_NORMAL:
+		return LogNormal{Mu: p.GetMu(), Sigma: orUnset(p.HasSigma(), p.GetSigma(), 1)}
+	case rpcv1.WeightDistribution_WEIGHT_DISTRIBUTION_BETA:
+		return Beta{Alpha: orUnset(p.HasAlpha(), p.GetAlpha(), 1), Beta: orUnset(p.HasBeta(), p.GetBeta(), 1)}
+	case rpcv1.WeightDistribution_WEIGHT_DISTRIBUTION_UNSPECIFIED:
+	}
+	return nil
+}
+
+// AssignWeights sets a weight drawn from the distribution on every edge of the graph that doesn't have
+// a weight yet, in the order of the edges.
+func AssignWeights(r *rand.Rand, resp *rpcv1.RandomGraphResponse, dist Distribution) {
+	for _, edge := range resp.GetEdges() {
+		if !edge.HasWeight() {
+			edge.SetWeight(dist.Sample(r))
+		}
+	}
+}
+
+// Uniform draws weights uniformly from [Min, Max).
+type Uniform struct {
+	Min, Max float64
+}
+
+// Sample implements Distribution.
+func (d Uniform) Sample(r *rand.Rand) float64 {
+	return d.Min + r.Float64()*(d.Max-d.Min)
+}
+
+// Exponential draws weights from an exponential distribution.
+type Exponential struct {
+	Mean float64
+}
+
+// Sample implements Distribution.
+func (d Exponential) Sample(r *rand.Rand) float64 {
+	return r.ExpFloat64() * d.Mean
+}
+
+// LogNormal draws weights whose logarithm is normally distributed.
+type LogNormal struct {
+	Mu, Sigma float64
+}
+
+// Sample implements Distribution.
+func (d LogNormal) Sample(r *rand.Rand) float64 {
+	return math.Exp(d.Mu + d.Sigma*r.NormFloat64())
+}
+
+// Beta draws weights in [0, 1] from a beta distribution, as X/(X+Y) with X and Y gamma distributed.
+type Beta struct {
+	Alpha, Beta float64
+}
+
+// Sample implements Distribution.
+func (d Beta) Sample(r *rand.Rand) float64 {
+	x, y := sampleGamma(r, d.Alpha), sampleGamma(r, d.Beta)
+	if x+y == 0 {
+		return 0
+	}
+	return x / (x + y)
+}
+
+// sampleGamma draws from a gamma distribution with the given shape and a scale of 1, using the method
+// of Marsaglia and Tsang. Shapes below 1 are boosted to shape+1 and scaled back down.
+func sampleGamma(r *rand.Rand, shape float64) float64 {
+	if shape < 1 {
+		return sampleGamma(r, shape+1) * math.Pow(r.Float64(), 1/shape)
+	}
+
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := r.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+
+		v = v * v * v
+		u := r.Float64()
+		if math.Log(u) < 0.5*x*x+d-d*v+d*math.Log(v) {
+			return d * v
+		}
+	}
+}
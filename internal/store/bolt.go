@@ -0,0 +1,142 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// The records and their metadata are kept in separate buckets, so listing doesn't decode every graph.
+const (
+	recordsBucket  = "records"
+	metadataBucket = "metadata"
+)
+
+// openTimeout bounds how long opening waits for the lock on the database file, which is held by any
+// other process that has the file open.
+const openTimeout = time.Second
+
+// Bolt is a Store that keeps its records in a bbolt database file.
+type Bolt struct {
+	db *bolt.DB
+}
+
+// OpenBolt opens, or creates, the database file. It fails when another process, such as a running
+// daemon, keeps the file open.
+func OpenBolt(path string) (*Bolt, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: openTimeout})
+	if errors.Is(err, bolterrors.ErrTimeout) {
+		return nil, fmt.Errorf("failed to open store: %s is in use by another process, is a daemon running?", path)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open store: %w", err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, name := range []string{recordsBucket, metadataBucket} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return fmt.Errorf("failed to create bucket %q: %w", name, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Bolt{db: db}, nil
+}
+
+// Close closes the database file.
+func (b *Bolt) Close() error {
+	return b.db.Close()
+}
+
+// Put implements Store.
+func (b *Bolt) Put(_ context.Context, rec *rpcv1.GraphRecord) error {
+	data, err := proto.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	meta, err := proto.Marshal(rec.GetMetadata())
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata: %w", err)
+	}
+
+	key := []byte(rec.GetMetadata().GetGraphId())
+	return b.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket([]byte(recordsBucket)).Put(key, data); err != nil {
+			return fmt.Errorf("failed to put record: %w", err)
+		}
+		if err := tx.Bucket([]byte(metadataBucket)).Put(key, meta); err != nil {
+			return fmt.Errorf("failed to put metadata: %w", err)
+		}
+		return nil
+	})
+}
+
+// Get implements Store.
+func (b *Bolt) Get(_ context.Context, id string) (*rpcv1.GraphRecord, error) {
+	rec := &rpcv1.GraphRecord{}
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(recordsBucket)).Get([]byte(id))
+		if data == nil {
+			return ErrNotFound
+		}
+		// the data is only valid during the transaction, unmarshalling copies it.
+		if err := proto.Unmarshal(data, rec); err != nil {
+			return fmt.Errorf("failed to decode record: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// List implements Store.
+func (b *Bolt) List(context.Context) ([]*rpcv1.GraphMetadata, error) {
+	var list []*rpcv1.GraphMetadata
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(metadataBucket)).ForEach(func(_, data []byte) error {
+			meta := &rpcv1.GraphMetadata{}
+			if err := proto.Unmarshal(data, meta); err != nil {
+				return fmt.Errorf("failed to decode metadata: %w", err)
+			}
+			list = append(list, meta)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	sortNewestFirst(list)
+	return list, nil
+}
+
+// Delete implements Store.
+func (b *Bolt) Delete(_ context.Context, id string) error {
+	key := []byte(id)
+	return b.db.Update(func(tx *bolt.Tx) error {
+		records := tx.Bucket([]byte(recordsBucket))
+		if records.Get(key) == nil {
+			return ErrNotFound
+		}
+		if err := records.Delete(key); err != nil {
+			return fmt.Errorf("failed to delete record: %w", err)
+		}
+		if err := tx.Bucket([]byte(metadataBucket)).Delete(key); err != nil {
+			return fmt.Errorf("failed to delete metadata: %w", err)
+		}
+		return nil
+	})
+}
+
+// Persistent implements Store.
+func (b *Bolt) Persistent() bool {
+	return true
+}
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Memory is a Store that keeps its records in memory, they are lost when the process exits.
+type Memory struct {
+	mu      sync.Mutex
+	records map[string]*rpcv1.GraphRecord
+}
+
+// NewMemory inits an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{records: map[string]*rpcv1.GraphRecord{}}
+}
+
+// Put implements Store.
+func (m *Memory) Put(_ context.Context, rec *rpcv1.GraphRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[rec.GetMetadata().GetGraphId()] = proto.Clone(rec).(*rpcv1.GraphRecord) //nolint:forcetypeassert
+	return nil
+}
+
+// Get implements Store.
+func (m *Memory) Get(_ context.Context, id string) (*rpcv1.GraphRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec, ok := m.records[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(rec).(*rpcv1.GraphRecord), nil //nolint:forcetypeassert
+}
+
+// List implements Store.
+func (m *Memory) List(context.Context) ([]*rpcv1.GraphMetadata, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	list := make([]*rpcv1.GraphMetadata, 0, len(m.records))
+	for _, rec := range m.records {
+		list = append(list, proto.Clone(rec.GetMetadata()).(*rpcv1.GraphMetadata)) //nolint:forcetypeassert
+	}
+	sortNewestFirst(list)
+	return list, nil
+}
+
+// Delete implements Store.
+func (m *Memory) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.records[id]; !ok {
+		return ErrNotFound
+	}
+	delete(m.records, id)
+	return nil
+}
+
+// Persistent implements Store, records in memory are lost when the process exits.
+func (m *Memory) Persistent() bool {
+	return false
+}
+
+// sortNewestFirst sorts the metadata by decreasing creation time, ties are broken by id so listings are
+// stable.
+func sortNewestFirst(list []*rpcv1.GraphMetadata) {
+	slices.SortFunc(list, func(a, b *rpcv1.GraphMetadata) int {
+		return cmp.Or(cmp.Compare(b.GetCreatedAt(), a.GetCreatedAt()), cmp.Compare(a.GetGraphId(), b.GetGraphId()))
+	})
+}
@@ -0,0 +1,70 @@
+// Package store persists graphs and the results of experiments, so past runs can be revisited after the
+// daemon restarts.
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/advdv/stdgo/stdfx"
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"go.uber.org/fx"
+)
+
+// ErrNotFound is returned when there is no record with the requested id.
+var ErrNotFound = errors.New("record not found")
+
+// Store keeps records by the graph id of their metadata. Records are copied on the way in and out, so
+// callers are free to modify them.
+type Store interface {
+	// Put creates the record, or replaces the record with the same id.
+	Put(ctx context.Context, rec *rpcv1.GraphRecord) error
+	// Get returns the record with the id, or ErrNotFound.
+	Get(ctx context.Context, id string) (*rpcv1.GraphRecord, error)
+	// List returns the metadata of every record, most recently created first.
+	List(ctx context.Context) ([]*rpcv1.GraphMetadata, error)
+	// Delete removes the record with the id, or returns ErrNotFound.
+	Delete(ctx context.Context, id string) error
+	// Persistent reports whether the records outlive the process. Records of stores that don't are only
+	// kept while they are in use, so the store doesn't grow without bound.
+	Persistent() bool
+}
+
+// Config configures the package's components.
+type Config struct {
+	// Path is the file of the bbolt database. Without a path records are only kept in memory.
+	Path string `env:"PATH"`
+}
+
+// Params declares input components required for this package's components.
+type Params struct {
+	fx.In
+	Config    Config
+	Lifecycle fx.Lifecycle
+}
+
+// Result describes what the components produce for the rest of the system.
+type Result struct {
+	fx.Out
+	Store Store
+}
+
+// New inits the store as configured, a database is closed when the application stops.
+func New(params Params) (Result, error) {
+	if params.Config.Path == "" {
+		return Result{Store: NewMemory()}, nil
+	}
+
+	db, err := OpenBolt(params.Config.Path)
+	if err != nil {
+		return Result{}, err
+	}
+
+	params.Lifecycle.Append(fx.StopHook(db.Close))
+	return Result{Store: db}, nil
+}
+
+// Provide provides the package's components as an fx module.
+func Provide() fx.Option {
+	return stdfx.ZapEnvCfgModule[Config]("store", New)
+}
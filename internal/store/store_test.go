@@ -0,0 +1,168 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+	"github.com/advdv/trustd/internal/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// testRecord returns a record of a graph with a single node, created at the given time.
+func testRecord(id string, createdAt int64) *rpcv1.GraphRecord {
+	meta := &rpcv1.GraphMetadata{}
+	meta.SetGraphId(id)
+	meta.SetCreatedAt(createdAt)
+	meta.SetNumNodes(1)
+
+	node := &rpcv1.Node{}
+	node.SetId("n-0")
+	graph := &rpcv1.RandomGraphResponse{}
+	graph.SetNodes([]*rpcv1.Node{node})
+
+	rec := &rpcv1.GraphRecord{}
+	rec.SetMetadata(meta)
+	rec.SetBase(graph)
+	rec.SetGraph(proto.Clone(graph).(*rpcv1.RandomGraphResponse)) //nolint:forcetypeassert
+	return rec
+}
+
+// listIDs returns the ids of the listed records in order.
+func listIDs(ctx context.Context, t *testing.T, s store.Store) []string {
+	t.Helper()
+	list, err := s.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, meta := range list {
+		ids = append(ids, meta.GetGraphId())
+	}
+	return ids
+}
+
+// TestStores checks that every store implementation behaves as the Store interface describes.
+func TestStores(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		open func(t *testing.T) store.Store
+	}{
+		{"memory", func(*testing.T) store.Store { return store.NewMemory() }},
+		{"bolt", func(t *testing.T) store.Store {
+			t.Helper()
+			b, err := store.OpenBolt(t.TempDir() + "/db")
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { b.Close() })
+			return b
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("put and get", func(t *testing.T) {
+				ctx, s := context.Background(), tt.open(t)
+				rec := testRecord("a", 1)
+				if err := s.Put(ctx, rec); err != nil {
+					t.Fatal(err)
+				}
+				got, err := s.Get(ctx, "a")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !proto.Equal(got, rec) {
+					t.Fatalf("got record %v, want %v", got, rec)
+				}
+
+				// putting a record with the same id replaces it.
+				rec.GetMetadata().SetNumNodes(2)
+				if err := s.Put(ctx, rec); err != nil {
+					t.Fatal(err)
+				}
+				if got, err = s.Get(ctx, "a"); err != nil {
+					t.Fatal(err)
+				}
+				if got.GetMetadata().GetNumNodes() != 2 {
+					t.Fatalf("got %d nodes, the record wasn't replaced", got.GetMetadata().GetNumNodes())
+				}
+			})
+
+			t.Run("list newest first", func(t *testing.T) {
+				ctx, s := context.Background(), tt.open(t)
+				if ids := listIDs(ctx, t, s); len(ids) != 0 {
+					t.Fatalf("got records %v in an empty store", ids)
+				}
+				// c and b were created at the same time, the tie is broken by id.
+				for _, rec := range []*rpcv1.GraphRecord{
+					testRecord("a", 1), testRecord("c", 3), testRecord("d", 2), testRecord("b", 3),
+				} {
+					if err := s.Put(ctx, rec); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if ids, want := listIDs(ctx, t, s), []string{"b", "c", "d", "a"}; !slices.Equal(ids, want) {
+					t.Fatalf("got records %v, want %v", ids, want)
+				}
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				ctx, s := context.Background(), tt.open(t)
+				for _, id := range []string{"a", "b"} {
+					if err := s.Put(ctx, testRecord(id, 1)); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err := s.Delete(ctx, "a"); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.Get(ctx, "a"); !errors.Is(err, store.ErrNotFound) {
+					t.Fatalf("got error %v for a deleted record, want %v", err, store.ErrNotFound)
+				}
+				if ids, want := listIDs(ctx, t, s), []string{"b"}; !slices.Equal(ids, want) {
+					t.Fatalf("got records %v, want %v", ids, want)
+				}
+			})
+
+			t.Run("not found", func(t *testing.T) {
+				ctx, s := context.Background(), tt.open(t)
+				if _, err := s.Get(ctx, "missing"); !errors.Is(err, store.ErrNotFound) {
+					t.Fatalf("got error %v from get, want %v", err, store.ErrNotFound)
+				}
+				if err := s.Delete(ctx, "missing"); !errors.Is(err, store.ErrNotFound) {
+					t.Fatalf("got error %v from delete, want %v", err, store.ErrNotFound)
+				}
+			})
+
+			t.Run("records are copies", func(t *testing.T) {
+				ctx, s := context.Background(), tt.open(t)
+				rec := testRecord("a", 1)
+				if err := s.Put(ctx, rec); err != nil {
+					t.Fatal(err)
+				}
+				rec.GetMetadata().SetNumNodes(10)
+				rec.GetGraph().GetNodes()[0].SetId("put")
+
+				got, err := s.Get(ctx, "a")
+				if err != nil {
+					t.Fatal(err)
+				}
+				got.GetMetadata().SetNumNodes(20)
+				got.GetGraph().GetNodes()[0].SetId("got")
+				list, err := s.List(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				list[0].SetNumNodes(30)
+
+				if got, err = s.Get(ctx, "a"); err != nil {
+					t.Fatal(err)
+				}
+				if want := testRecord("a", 1); !proto.Equal(got, want) {
+					t.Fatalf("got record %v after changing copies, want %v", got, want)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package trustmetric
+
+import (
+	"context"
+	"math"
+
+	"github.com/advdv/trustd/internal/maxflow"
+)
+
+// DefaultAdvogatoCapacities are the node capacities by distance from the seed, as used on advogato.org.
+var DefaultAdvogatoCapacities = []int{800, 200, 50, 12, 4, 2, 1}
+
+// Advogato is the attack resistant group metric of Levien. Every node gets a capacity that decreases
+// with its distance from the seed, and is split into a node that keeps one unit of flow for itself
+// and passes the rest on to the nodes it certifies. The nodes whose unit reaches the supersink in a
+// maximum flow from the seed are accepted and score 1, the others score 0. Ratings are read as
+// certificates, their weights don't matter, but ratings of 0 or less are ignored.
+type Advogato struct {
+	// Capacities holds the capacity of the nodes at every distance from the seed, nodes beyond its end
+	// have the capacity of its last element.
+	Capacities []int
+}
+
+// Compute implements Metric.
+func (m Advogato) Compute(ctx context.Context, g *Graph, source int) (Result, error) {
+	n := g.Len()
+	scores := make([]float64, n)
+	if n == 0 {
+		return Result{Scores: scores}, nil
+	}
+
+	capacities := m.Capacities
+	if len(capacities) == 0 {
+		capacities = DefaultAdvogatoCapacities
+	}
+
+	// Node v becomes v⁻ = 2v and v⁺ = 2v+1, the supersink is 2n. Distances are counted over the
+	// certificates only, the same arcs that carry flow.
+	dist, _ := g.distances(source, certifies)
+	network := maxflow.New(2*n + 1)
+	sink := 2 * n
+	keeps := make([]int, n)
+	for v := range n {
+		if dist[v] < 0 {
+			keeps[v] = -1
+			continue
+		}
+
+		capacity := capacities[min(dist[v], len(capacities)-1)]
+		keeps[v] = network.AddEdge(2*v, sink, 1)
+		network.AddEdge(2*v, 2*v+1, float64(max(capacity-1, 0)))
+		for _, arc := range g.Out(v) {
+			if certifies(arc) {
+				network.AddEdge(2*v+1, 2*arc.To, math.Inf(1))
+			}
+		}
+	}
+
+	if _, err := network.MaxFlow(ctx, 2*source, sink); err != nil {
+		return Result{}, err
+	}
+	for v, edge := range keeps {
+		if edge >= 0 && network.Saturated(edge) {
+			scores[v] = 1
+		}
+	}
+	return Result{Scores: scores}, nil
+}
+
+// certifies reports whether the rating is read as a certificate.
+func certifies(arc Arc) bool {
+	return arc.Weight > 0
+}
@@ -0,0 +1,83 @@
+package trustmetric
+
+import (
+	"context"
+	"math"
+)
+
+// EigenTrust is the global metric of Kamvar, Schlosser and Garcia-Molina. Every node normalizes its
+// positive ratings into local trust, and the global trust is the left principal eigenvector of that
+// matrix, mixed with the pre-trusted distribution: t' = (1-a)·Cᵀt + a·p. The source is the pre-trusted
+// node unless UniformPreTrust is set, which makes the scores independent of the source. Nodes that
+// trust nobody pass their trust on to the pre-trusted distribution.
+type EigenTrust struct {
+	// PreTrustWeight is a, the weight of the pre-trusted distribution in every iteration.
+	PreTrustWeight float64
+	// UniformPreTrust spreads the pre-trust over all nodes instead of the source alone.
+	UniformPreTrust bool
+	// MaxIterations and Tolerance bound the power iteration.
+	MaxIterations int
+	Tolerance     float64
+}
+
+// Compute implements Metric.
+func (m EigenTrust) Compute(ctx context.Context, g *Graph, source int) (Result, error) {
+	n := g.Len()
+	if n == 0 {
+		return Result{Scores: []float64{}}, nil
+	}
+
+	preTrust := make([]float64, n)
+	if m.UniformPreTrust {
+		for i := range preTrust {
+			preTrust[i] = 1 / float64(n)
+		}
+	} else {
+		preTrust[source] = 1
+	}
+
+	// sums holds the total positive rating given by every node, to normalize the local trust.
+	sums := make([]float64, n)
+	for i := range n {
+		for _, arc := range g.Out(i) {
+			sums[i] += max(arc.Weight, 0)
+		}
+	}
+
+	scores := make([]float64, n)
+	copy(scores, preTrust)
+	next := make([]float64, n)
+
+	iterations := 0
+	for iterations < m.MaxIterations {
+		if err := ctx.Err(); err != nil {
+			return Result{}, err
+		}
+		iterations++
+
+		var dangling float64
+		clear(next)
+		for i := range n {
+			if sums[i] == 0 {
+				dangling += scores[i]
+				continue
+			}
+
+			for _, arc := range g.Out(i) {
+				next[arc.To] += (1 - m.PreTrustWeight) * scores[i] * max(arc.Weight, 0) / sums[i]
+			}
+		}
+
+		var change float64
+		for i := range next {
+			next[i] += (m.PreTrustWeight + (1-m.PreTrustWeight)*dangling) * preTrust[i]
+			change += math.Abs(next[i] - scores[i])
+		}
+
+		scores, next = next, scores
+		if change < m.Tolerance {
+			break
+		}
+	}
+	return Result{Scores: scores, Iterations: iterations}, nil
+}
@@ -0,0 +1,75 @@
+package trustmetric
+
+import (
+	"context"
+	"math"
+)
+
+// TidalTrust is the local metric of Golbeck. The trust in a sink is inferred over the shortest paths
+// from the source only. It uses the ratings along the strongest of those paths, the one whose weakest
+// rating is highest, as a threshold. Every node then averages what its neighbors towards the sink
+// infer, weighted by its rating of them, and ignores neighbors it rates below the threshold. Nodes that
+// rate the sink directly use that rating. The source itself and unreachable nodes score 0.
+type TidalTrust struct{}
+
+// Compute implements Metric.
+func (TidalTrust) Compute(ctx context.Context, g *Graph, source int) (Result, error) {
+	scores := make([]float64, g.Len())
+	if g.Len() == 0 {
+		return Result{Scores: scores}, nil
+	}
+
+	dist, order := g.distances(source, nil)
+
+	// strength holds, for every node, the highest minimum rating over the shortest paths from the source.
+	strength := make([]float64, g.Len())
+	strength[source] = math.Inf(1)
+	for _, v := range order {
+		for _, arc := range g.Out(v) {
+			if dist[arc.To] == dist[v]+1 {
+				strength[arc.To] = max(strength[arc.To], min(strength[v], arc.Weight))
+			}
+		}
+	}
+
+	inferred := make([]float64, g.Len())
+	onPath := make([]bool, g.Len())
+	for _, sink := range order[1:] {
+		if err := ctx.Err(); err != nil {
+			return Result{}, err
+		}
+		threshold := strength[sink]
+
+		// Walk the breadth-first order backwards from the sink, so every node on a shortest path to the
+		// sink is visited after the neighbors it relies on.
+		clear(onPath)
+		onPath[sink] = true
+		for k := len(order) - 1; k >= 0; k-- {
+			v := order[k]
+			if dist[v] >= dist[sink] {
+				continue
+			}
+
+			var num, den float64
+			for _, arc := range g.Out(v) {
+				if dist[arc.To] != dist[v]+1 || !onPath[arc.To] || arc.Weight < threshold {
+					continue
+				}
+
+				if arc.To == sink {
+					num, den = arc.Weight, 1
+					break
+				}
+				num += arc.Weight * inferred[arc.To]
+				den += arc.Weight
+			}
+
+			if den > 0 {
+				onPath[v] = true
+				inferred[v] = num / den
+			}
+		}
+		scores[sink] = inferred[source]
+	}
+	return Result{Scores: scores}, nil
+}
@@ -0,0 +1,117 @@
+// Package trustmetric implements trust metrics from the literature, so they can be compared with the
+// random walk based approach on the same generated graphs. Edge weights are read as trust ratings, edges
+// without a weight rate 1. Undirected edges rate trust in both directions.
+package trustmetric
+
+import (
+	"context"
+
+	rpcv1 "github.com/advdv/trustd/internal/rpc/v1"
+)
+
+// Metric computes how much the source node trusts every node of the graph. It stops with the error of
+// the context once it is done.
+type Metric interface {
+	Compute(ctx context.Context, g *Graph, source int) (Result, error)
+}
+
+// Result holds the outcome of a metric.
+type Result struct {
+	// Scores holds the trust in every node, by node index.
+	Scores []float64
+	// Iterations is the number of iterations the metric needed, if it is iterative.
+	Iterations int
+}
+
+// Arc is a trust rating from one node to another.
+type Arc struct {
+	To     int
+	Weight float64
+}
+
+// Graph is the view of a graph that the metrics operate on, with nodes identified by their index.
+type Graph struct {
+	ids   []string
+	index map[string]int
+	out   [][]Arc
+}
+
+// NewGraph indexes the nodes and edges of a graph. Edges to unknown nodes and self-loops are skipped.
+func NewGraph(resp *rpcv1.RandomGraphResponse) *Graph {
+	g := &Graph{
+		ids:   make([]string, len(resp.GetNodes())),
+		index: make(map[string]int, len(resp.GetNodes())),
+		out:   make([][]Arc, len(resp.GetNodes())),
+	}
+	for i, node := range resp.GetNodes() {
+		g.ids[i] = node.GetId()
+		g.index[node.GetId()] = i
+	}
+
+	for _, edge := range resp.GetEdges() {
+		source, okSource := g.index[edge.GetSource()]
+		target, okTarget := g.index[edge.GetTarget()]
+		if !okSource || !okTarget || source == target {
+			continue
+		}
+
+		weight := 1.0
+		if edge.HasWeight() {
+			weight = edge.GetWeight()
+		}
+
+		g.out[source] = append(g.out[source], Arc{To: target, Weight: weight})
+		if !resp.GetDirected() {
+			g.out[target] = append(g.out[target], Arc{To: source, Weight: weight})
+		}
+	}
+	return g
+}
+
+// Len returns the number of nodes.
+func (g *Graph) Len() int {
+	return len(g.ids)
+}
+
+// Index returns the index of the node with the given id.
+func (g *Graph) Index(id string) (int, bool) {
+	i, ok := g.index[id]
+	return i, ok
+}
+
+// Out returns the trust ratings that a node has given.
+func (g *Graph) Out(node int) []Arc {
+	return g.out[node]
+}
+
+// ScoresByID returns the scores keyed by node id.
+func (g *Graph) ScoresByID(scores []float64) map[string]float64 {
+	byID := make(map[string]float64, len(scores))
+	for i, score := range scores {
+		byID[g.ids[i]] = score
+	}
+	return byID
+}
+
+// distances returns the number of hops from the source to every node, or -1 for unreachable nodes,
+// together with the reachable nodes in breadth-first order. Only the arcs that follow accepts are
+// traversed, or all of them if it is nil.
+func (g *Graph) distances(source int, follow func(Arc) bool) (dist []int, order []int) {
+	dist = make([]int, g.Len())
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[source] = 0
+
+	order = []int{source}
+	for head := 0; head < len(order); head++ {
+		v := order[head]
+		for _, arc := range g.out[v] {
+			if dist[arc.To] < 0 && (follow == nil || follow(arc)) {
+				dist[arc.To] = dist[v] + 1
+				order = append(order, arc.To)
+			}
+		}
+	}
+	return dist, order
+}
@@ -2,10 +2,14 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 
+	"connectrpc.com/connect"
 	"github.com/advdv/stdgo/stdfx"
 	gui "github.com/advdv/trustd/gui"
+	"github.com/advdv/trustd/internal/graphio"
+	"github.com/advdv/trustd/internal/rpc"
 	"github.com/rs/cors"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -18,6 +22,7 @@ type Config struct{}
 type Params struct {
 	fx.In
 	RPCHandler http.Handler `name:"rpc"`
+	Graphs     *rpc.Graphs
 	Logger     *zap.Logger
 }
 
@@ -28,12 +33,45 @@ func New(params Params) (http.Handler, error) {
 
 	// serve the rpc and the files.
 	mux.Handle("/rpc/", http.StripPrefix("/rpc", params.RPCHandler))
+	mux.Handle("GET /export/{id}", exportHandler(params))
 	mux.Handle("/", fsrv)
 
 	// for now, allow all for CORS.
 	return cors.AllowAll().Handler(mux), nil
 }
 
+// exportHandler serves stored graphs as a file download, the format is selected through the "format"
+// query parameter and defaults to GraphML.
+func exportHandler(params Params) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format := graphio.FormatGraphML
+		if name := r.URL.Query().Get("format"); name != "" {
+			format = graphio.ParseFormat(name)
+		}
+
+		if format == graphio.FormatUnknown {
+			http.Error(w, "unsupported format", http.StatusBadRequest)
+			return
+		}
+
+		graph, err := params.Graphs.Get(r.Context(), r.PathValue("id"))
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			http.NotFound(w, r)
+			return
+		} else if err != nil {
+			params.Logger.Error("failed to load graph", zap.Error(err))
+			http.Error(w, "failed to load graph", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="graph%s"`, format.Extension()))
+		if err := graphio.Write(w, graph, format); err != nil {
+			params.Logger.Error("failed to export graph", zap.Error(err))
+		}
+	})
+}
+
 // Provide provides the package's components as an fx module.
 func Provide() fx.Option {
 	return stdfx.ZapEnvCfgModule[Config]("web", New)